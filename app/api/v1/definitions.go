@@ -12,11 +12,259 @@ var OpenAPIFS embed.FS
 const (
 	ErrCodeUnauthenticated = "unauthenticated"
 	ErrCodeAccessDenied    = "access_denied"
+	ErrCodeCooldownActive  = "cooldown_active"
+	ErrCodeNotFound        = "not_found"
+	ErrCodeCanvasArchived  = "canvas_archived"
 )
 
+const (
+	MaxCanvasSize  = 2048
+	MaxPaletteSize = 256
+
+	MaxRegionSize  = 256
+	MaxImageScale  = 16
+	MaxImageLength = 4096
+
+	MaxPixelHistory = 100
+	MaxAuditEntries = 1000
+)
+
+var JWTAuth = JWTSecurity("jwt", func() {
+	Description("Bearer token whose subject identifies the user.")
+	Scope("canvas:place", "Place pixels on a canvas")
+	Scope("canvas:manage", "Create, archive and moderate canvases")
+})
+
+// CanvasID declares the ID of the canvas that a method is scoped to.
+func CanvasID(tag any) {
+	Field(tag, "id", String, "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+}
+
+var CooldownError = Type("CooldownError", func() {
+	Description("The user placed a pixel too recently and must wait before placing another.")
+	Field(1, "message", String)
+	Field(2, "retry_after", Int32, "Number of seconds to wait before placing another pixel.")
+	Required("message", "retry_after")
+})
+
 var Canvas = ResultType("application/vnd.pikcel.canvas`", "Canvas", func() {
 	Field(1, "id", String)
 	Field(2, "width", Int32)
 	Field(3, "height", Int32)
-	Required("id", "width", "height")
+	Field(4, "palette", ArrayOf(String, func() {
+		Pattern("^#[0-9A-F]{6}$")
+	}), "Ordered list of colors, indexed by the color of each pixel.")
+	Field(5, "created_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Field(6, "archived_at", String, "Set once the canvas is archived, after which no more pixels can be placed on it.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "version", Int32, "Incremented each time the canvas is resized.")
+	Field(8, "protected_regions", ArrayOf(ProtectedRegion), "Regions of the canvas in which no pixels can be placed, in the order they were protected.")
+	Required("id", "width", "height", "palette", "created_at", "version", "protected_regions")
+})
+
+var ProtectedRegion = ResultType("application/vnd.pikcel.protected-region", "ProtectedRegion", func() {
+	Field(1, "id", String)
+	Field(2, "x", Int32)
+	Field(3, "y", Int32)
+	Field(4, "width", Int32)
+	Field(5, "height", Int32)
+	Field(6, "reason", String)
+	Field(7, "created_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Field(8, "expires_at", String, "Set if the region is only protected until then.", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "x", "y", "width", "height", "reason", "created_at")
+})
+
+var Rollback = ResultType("application/vnd.pikcel.rollback", "Rollback", func() {
+	Field(1, "reverted", Int32, "Number of pixels reverted.")
+	Required("reverted")
+})
+
+var AuditLog = ResultType("application/vnd.pikcel.audit-log", "AuditLog", func() {
+	Field(1, "entries", ArrayOf(AuditEntry), "Latest actions taken by moderators on the canvas, most recent first.")
+	Required("entries")
+})
+
+var AuditEntry = Type("AuditEntry", func() {
+	Description("An action taken by a moderator on a canvas.")
+	Field(1, "action", String, func() {
+		Enum("rollback")
+	})
+	Field(2, "user_id", String, "ID of the moderator who took the action.")
+	Field(3, "details", String)
+	Field(4, "created_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Required("action", "user_id", "details", "created_at")
+})
+
+var Canvases = ResultType("application/vnd.pikcel.canvases", "Canvases", func() {
+	Field(1, "canvases", ArrayOf(Canvas), "Canvases in the order they were created.")
+	Required("canvases")
+})
+
+var Pixel = ResultType("application/vnd.pikcel.pixel", "Pixel", func() {
+	Field(1, "x", Int32)
+	Field(2, "y", Int32)
+	Field(3, "color", Int32)
+	Required("x", "y", "color")
+})
+
+var PixelInfo = ResultType("application/vnd.pikcel.pixel-info", "PixelInfo", func() {
+	Field(1, "x", Int32)
+	Field(2, "y", Int32)
+	Field(3, "placements", ArrayOf(PixelHistoryEntry), "Latest placements at the coordinate, most recent first.")
+	Required("x", "y", "placements")
+})
+
+var PixelHistoryEntry = Type("PixelHistoryEntry", func() {
+	Description("A past placement at a coordinate.")
+	Field(1, "user_id", String, "ID of the user who placed the pixel.")
+	Field(2, "color", Int32)
+	Field(3, "placed_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Field(4, "seq", Int64, "Sequence number of the placement on the canvas.")
+	Required("user_id", "color", "placed_at", "seq")
+})
+
+var PixelEvent = Type("PixelEvent", func() {
+	Description("A pixel placement accepted on the canvas.")
+	Field(1, "x", Int32, func() {
+		Meta("struct:tag:json", "x")
+	})
+	Field(2, "y", Int32, func() {
+		Meta("struct:tag:json", "y")
+	})
+	Field(3, "color", Int32, func() {
+		Meta("struct:tag:json", "color")
+	})
+	Field(4, "user_id", String, "ID of the user who placed the pixel.", func() {
+		Meta("struct:tag:json", "user_id")
+	})
+	Field(5, "placed_at", String, func() {
+		Format(FormatDateTime)
+		Meta("struct:tag:json", "placed_at")
+	})
+	Field(6, "seq", Int64, "Sequence number of the placement on the canvas.", func() {
+		Meta("struct:tag:json", "seq")
+	})
+	Required("x", "y", "color", "user_id", "placed_at", "seq")
+})
+
+var CanvasEvent = Type("CanvasEvent", func() {
+	Description("An event sent to canvas subscribers. Exactly one of pixel and snapshot is set, according to its type.")
+	Field(1, "id", String, "Sequence number the canvas is at once the event is applied, used to resume the stream.", func() {
+		Meta("struct:tag:json", "id")
+	})
+	Field(2, "type", String, func() {
+		Enum("pixel", "snapshot")
+		Meta("struct:tag:json", "type")
+	})
+	Field(3, "pixel", PixelEvent, func() {
+		Meta("struct:tag:json", "pixel,omitempty")
+	})
+	Field(4, "snapshot", CanvasSnapshot, "Sent instead of replaying missed placements when there are too many of them, or when the canvas has been resized.", func() {
+		Meta("struct:tag:json", "snapshot,omitempty")
+	})
+	Required("id", "type")
+})
+
+var CanvasSnapshot = Type("CanvasSnapshot", func() {
+	Description("Every pixel on the canvas as of a sequence number.")
+	Field(1, "seq", Int64, func() {
+		Meta("struct:tag:json", "seq")
+	})
+	Field(2, "width", Int32, func() {
+		Meta("struct:tag:json", "width")
+	})
+	Field(3, "height", Int32, func() {
+		Meta("struct:tag:json", "height")
+	})
+	Field(4, "pixels", Bytes, "Row-major palette indices, one byte per pixel.", func() {
+		Meta("struct:tag:json", "pixels")
+	})
+	Required("seq", "width", "height", "pixels")
+})
+
+var PixelPlacement = Type("PixelPlacement", func() {
+	Description("A pixel placement sent by a client over a canvas session.")
+	Field(1, "x", Int32, func() {
+		Minimum(0)
+	})
+	Field(2, "y", Int32, func() {
+		Minimum(0)
+	})
+	Field(3, "color", Int32, func() {
+		Minimum(0)
+		Maximum(255)
+	})
+	Required("x", "y", "color")
+})
+
+var SessionEvent = Type("SessionEvent", func() {
+	Description("An event sent to a client over a canvas session. Exactly one of its fields is set.")
+	Field(1, "canvas", Canvas, "The canvas the session is for, sent when the session starts and again whenever the canvas is resized.")
+	Field(2, "pixel", PixelEvent, "A pixel placed on the canvas by any user.")
+	Field(3, "rejection", PlacementRejection, "A placement sent by the client that was rejected.")
+})
+
+var PlacementRejection = Type("PlacementRejection", func() {
+	Description("Why a placement sent over a canvas session was rejected.")
+	Field(1, "x", Int32, "X coordinate of the placement, if it could be decoded.")
+	Field(2, "y", Int32, "Y coordinate of the placement, if it could be decoded.")
+	Field(3, "name", String, "Name of the error, e.g. cooldown_active.")
+	Field(4, "message", String)
+	Field(5, "retry_after", Int32, "Number of seconds to wait before placing another pixel, if on cooldown.")
+	Required("name", "message")
+})
+
+var CanvasPixels = ResultType("application/vnd.pikcel.canvas-pixels", "CanvasPixels", func() {
+	Field(1, "width", Int32)
+	Field(2, "height", Int32)
+	Field(3, "pixels", Bytes, "Row-major palette indices, one byte per pixel.")
+	Required("width", "height", "pixels")
+})
+
+var CanvasChunks = ResultType("application/vnd.pikcel.canvas-chunks", "CanvasChunks", func() {
+	Field(1, "version", Int64, "Sequence number of the latest placement on the canvas, to pass as since to fetch only later changes.")
+	Field(2, "chunk_size", Int32, "Width and height of each chunk, except those on the right and bottom edges of the canvas.")
+	Field(3, "chunks", ArrayOf(CanvasChunk), "Chunks modified after the requested version.")
+	Required("version", "chunk_size", "chunks")
+})
+
+var CanvasChunk = Type("CanvasChunk", func() {
+	Description("The pixels in one chunk of a canvas.")
+	Field(1, "x", Int32, "X coordinate of the chunk, in chunks.")
+	Field(2, "y", Int32, "Y coordinate of the chunk, in chunks.")
+	Field(3, "width", Int32)
+	Field(4, "height", Int32)
+	Field(5, "version", Int64, "Sequence number of the latest placement in the chunk.")
+	Field(6, "pixels", Bytes, "Row-major palette indices, one byte per pixel.")
+	Required("x", "y", "width", "height", "version", "pixels")
+})
+
+var CanvasTile = Type("CanvasTile", func() {
+	Attribute("status", String, "Whether the tile was rendered or the client's copy is still current.", func() {
+		Enum("ok", "not_modified")
+	})
+	Attribute("etag", String)
+	Attribute("cache_control", String)
+	Attribute("image", Bytes, "PNG-encoded tile, omitted if not modified.")
+	Required("status", "etag", "cache_control")
+})
+
+var CanvasRegion = ResultType("application/vnd.pikcel.canvas-region", "CanvasRegion", func() {
+	Field(1, "x", Int32)
+	Field(2, "y", Int32)
+	Field(3, "width", Int32)
+	Field(4, "height", Int32)
+	Field(5, "pixels", Bytes, "Row-major palette indices, one byte per pixel.")
+	Required("x", "y", "width", "height", "pixels")
 })
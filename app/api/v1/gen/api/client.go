@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api client
 //
@@ -15,26 +15,336 @@ import (
 
 // Client is the "api" service client.
 type Client struct {
-	CanvasGetEndpoint goa.Endpoint
+	CanvasCreateEndpoint          goa.Endpoint
+	CanvasListEndpoint            goa.Endpoint
+	CanvasGetEndpoint             goa.Endpoint
+	CanvasArchiveEndpoint         goa.Endpoint
+	CanvasResizeEndpoint          goa.Endpoint
+	ProtectedRegionCreateEndpoint goa.Endpoint
+	ProtectedRegionDeleteEndpoint goa.Endpoint
+	CanvasRollbackEndpoint        goa.Endpoint
+	AuditLogGetEndpoint           goa.Endpoint
+	CanvasPixelsGetEndpoint       goa.Endpoint
+	CanvasChunksGetEndpoint       goa.Endpoint
+	CanvasRegionGetEndpoint       goa.Endpoint
+	CanvasImageGetEndpoint        goa.Endpoint
+	CanvasRegionImageGetEndpoint  goa.Endpoint
+	CanvasTileGetEndpoint         goa.Endpoint
+	CanvasSubscribeEndpoint       goa.Endpoint
+	CanvasSessionEndpoint         goa.Endpoint
+	PixelPlaceEndpoint            goa.Endpoint
+	PixelInfoGetEndpoint          goa.Endpoint
 }
 
 // NewClient initializes a "api" service client given the endpoints.
-func NewClient(canvasGet goa.Endpoint) *Client {
+func NewClient(canvasCreate, canvasList, canvasGet, canvasArchive, canvasResize, protectedRegionCreate, protectedRegionDelete, canvasRollback, auditLogGet, canvasPixelsGet, canvasChunksGet, canvasRegionGet, canvasImageGet, canvasRegionImageGet, canvasTileGet, canvasSubscribe, canvasSession, pixelPlace, pixelInfoGet goa.Endpoint) *Client {
 	return &Client{
-		CanvasGetEndpoint: canvasGet,
+		CanvasCreateEndpoint:          canvasCreate,
+		CanvasListEndpoint:            canvasList,
+		CanvasGetEndpoint:             canvasGet,
+		CanvasArchiveEndpoint:         canvasArchive,
+		CanvasResizeEndpoint:          canvasResize,
+		ProtectedRegionCreateEndpoint: protectedRegionCreate,
+		ProtectedRegionDeleteEndpoint: protectedRegionDelete,
+		CanvasRollbackEndpoint:        canvasRollback,
+		AuditLogGetEndpoint:           auditLogGet,
+		CanvasPixelsGetEndpoint:       canvasPixelsGet,
+		CanvasChunksGetEndpoint:       canvasChunksGet,
+		CanvasRegionGetEndpoint:       canvasRegionGet,
+		CanvasImageGetEndpoint:        canvasImageGet,
+		CanvasRegionImageGetEndpoint:  canvasRegionImageGet,
+		CanvasTileGetEndpoint:         canvasTileGet,
+		CanvasSubscribeEndpoint:       canvasSubscribe,
+		CanvasSessionEndpoint:         canvasSession,
+		PixelPlaceEndpoint:            pixelPlace,
+		PixelInfoGetEndpoint:          pixelInfoGet,
 	}
 }
 
+// CanvasCreate calls the "CanvasCreate" endpoint of the "api" service.
+// CanvasCreate may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasCreate(ctx context.Context, p *CanvasCreatePayload) (res *Canvas, err error) {
+	var ires any
+	ires, err = c.CanvasCreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Canvas), nil
+}
+
+// CanvasList calls the "CanvasList" endpoint of the "api" service.
+// CanvasList may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasList(ctx context.Context, p *CanvasListPayload) (res *Canvases, err error) {
+	var ires any
+	ires, err = c.CanvasListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Canvases), nil
+}
+
 // CanvasGet calls the "CanvasGet" endpoint of the "api" service.
 // CanvasGet may return the following errors:
 //   - "unauthenticated" (type *goa.ServiceError)
 //   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
 //   - error: internal error
-func (c *Client) CanvasGet(ctx context.Context) (res *Canvas, err error) {
+func (c *Client) CanvasGet(ctx context.Context, p *CanvasGetPayload) (res *Canvas, err error) {
 	var ires any
-	ires, err = c.CanvasGetEndpoint(ctx, nil)
+	ires, err = c.CanvasGetEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
 	return ires.(*Canvas), nil
 }
+
+// CanvasArchive calls the "CanvasArchive" endpoint of the "api" service.
+// CanvasArchive may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasArchive(ctx context.Context, p *CanvasArchivePayload) (res *Canvas, err error) {
+	var ires any
+	ires, err = c.CanvasArchiveEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Canvas), nil
+}
+
+// CanvasResize calls the "CanvasResize" endpoint of the "api" service.
+// CanvasResize may return the following errors:
+//   - "canvas_archived" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasResize(ctx context.Context, p *CanvasResizePayload) (res *Canvas, err error) {
+	var ires any
+	ires, err = c.CanvasResizeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Canvas), nil
+}
+
+// ProtectedRegionCreate calls the "ProtectedRegionCreate" endpoint of the
+// "api" service.
+// ProtectedRegionCreate may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ProtectedRegionCreate(ctx context.Context, p *ProtectedRegionCreatePayload) (res *ProtectedRegion, err error) {
+	var ires any
+	ires, err = c.ProtectedRegionCreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProtectedRegion), nil
+}
+
+// ProtectedRegionDelete calls the "ProtectedRegionDelete" endpoint of the
+// "api" service.
+// ProtectedRegionDelete may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ProtectedRegionDelete(ctx context.Context, p *ProtectedRegionDeletePayload) (err error) {
+	_, err = c.ProtectedRegionDeleteEndpoint(ctx, p)
+	return
+}
+
+// CanvasRollback calls the "CanvasRollback" endpoint of the "api" service.
+// CanvasRollback may return the following errors:
+//   - "canvas_archived" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasRollback(ctx context.Context, p *CanvasRollbackPayload) (res *Rollback, err error) {
+	var ires any
+	ires, err = c.CanvasRollbackEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Rollback), nil
+}
+
+// AuditLogGet calls the "AuditLogGet" endpoint of the "api" service.
+// AuditLogGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) AuditLogGet(ctx context.Context, p *AuditLogGetPayload) (res *AuditLog, err error) {
+	var ires any
+	ires, err = c.AuditLogGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AuditLog), nil
+}
+
+// CanvasPixelsGet calls the "CanvasPixelsGet" endpoint of the "api" service.
+// CanvasPixelsGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasPixelsGet(ctx context.Context, p *CanvasPixelsGetPayload) (res *CanvasPixels, err error) {
+	var ires any
+	ires, err = c.CanvasPixelsGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CanvasPixels), nil
+}
+
+// CanvasChunksGet calls the "CanvasChunksGet" endpoint of the "api" service.
+// CanvasChunksGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasChunksGet(ctx context.Context, p *CanvasChunksGetPayload) (res *CanvasChunks, err error) {
+	var ires any
+	ires, err = c.CanvasChunksGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CanvasChunks), nil
+}
+
+// CanvasRegionGet calls the "CanvasRegionGet" endpoint of the "api" service.
+// CanvasRegionGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasRegionGet(ctx context.Context, p *CanvasRegionGetPayload) (res *CanvasRegion, err error) {
+	var ires any
+	ires, err = c.CanvasRegionGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CanvasRegion), nil
+}
+
+// CanvasImageGet calls the "CanvasImageGet" endpoint of the "api" service.
+// CanvasImageGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasImageGet(ctx context.Context, p *CanvasImageGetPayload) (res []byte, err error) {
+	var ires any
+	ires, err = c.CanvasImageGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]byte), nil
+}
+
+// CanvasRegionImageGet calls the "CanvasRegionImageGet" endpoint of the "api"
+// service.
+// CanvasRegionImageGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasRegionImageGet(ctx context.Context, p *CanvasRegionImageGetPayload) (res []byte, err error) {
+	var ires any
+	ires, err = c.CanvasRegionImageGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]byte), nil
+}
+
+// CanvasTileGet calls the "CanvasTileGet" endpoint of the "api" service.
+// CanvasTileGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasTileGet(ctx context.Context, p *CanvasTileGetPayload) (res *CanvasTile, err error) {
+	var ires any
+	ires, err = c.CanvasTileGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CanvasTile), nil
+}
+
+// CanvasSubscribe calls the "CanvasSubscribe" endpoint of the "api" service.
+// CanvasSubscribe may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasSubscribe(ctx context.Context, p *CanvasSubscribePayload) (res CanvasSubscribeClientStream, err error) {
+	var ires any
+	ires, err = c.CanvasSubscribeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(CanvasSubscribeClientStream), nil
+}
+
+// CanvasSession calls the "CanvasSession" endpoint of the "api" service.
+// CanvasSession may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) CanvasSession(ctx context.Context, p *CanvasSessionPayload) (res CanvasSessionClientStream, err error) {
+	var ires any
+	ires, err = c.CanvasSessionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(CanvasSessionClientStream), nil
+}
+
+// PixelPlace calls the "PixelPlace" endpoint of the "api" service.
+// PixelPlace may return the following errors:
+//   - "cooldown_active" (type *CooldownError)
+//   - "canvas_archived" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) PixelPlace(ctx context.Context, p *PixelPlacePayload) (res *Pixel, err error) {
+	var ires any
+	ires, err = c.PixelPlaceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Pixel), nil
+}
+
+// PixelInfoGet calls the "PixelInfoGet" endpoint of the "api" service.
+// PixelInfoGet may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "access_denied" (type *goa.ServiceError)
+//   - "not_found" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) PixelInfoGet(ctx context.Context, p *PixelInfoGetPayload) (res *PixelInfo, err error) {
+	var ires any
+	ires, err = c.PixelInfoGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PixelInfo), nil
+}
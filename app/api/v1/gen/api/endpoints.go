@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api endpoints
 //
@@ -11,30 +11,193 @@ import (
 	"context"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Endpoints wraps the "api" service endpoints.
 type Endpoints struct {
-	CanvasGet goa.Endpoint
+	CanvasCreate          goa.Endpoint
+	CanvasList            goa.Endpoint
+	CanvasGet             goa.Endpoint
+	CanvasArchive         goa.Endpoint
+	CanvasResize          goa.Endpoint
+	ProtectedRegionCreate goa.Endpoint
+	ProtectedRegionDelete goa.Endpoint
+	CanvasRollback        goa.Endpoint
+	AuditLogGet           goa.Endpoint
+	CanvasPixelsGet       goa.Endpoint
+	CanvasChunksGet       goa.Endpoint
+	CanvasRegionGet       goa.Endpoint
+	CanvasImageGet        goa.Endpoint
+	CanvasRegionImageGet  goa.Endpoint
+	CanvasTileGet         goa.Endpoint
+	CanvasSubscribe       goa.Endpoint
+	CanvasSession         goa.Endpoint
+	PixelPlace            goa.Endpoint
+	PixelInfoGet          goa.Endpoint
+}
+
+// CanvasSubscribeEndpointInput holds both the payload and the server stream of
+// the "CanvasSubscribe" method.
+type CanvasSubscribeEndpointInput struct {
+	// Payload is the method payload.
+	Payload *CanvasSubscribePayload
+	// Stream is the server stream used by the "CanvasSubscribe" method to send
+	// data.
+	Stream CanvasSubscribeServerStream
+}
+
+// CanvasSessionEndpointInput holds both the payload and the server stream of
+// the "CanvasSession" method.
+type CanvasSessionEndpointInput struct {
+	// Payload is the method payload.
+	Payload *CanvasSessionPayload
+	// Stream is the server stream used by the "CanvasSession" method to send data.
+	Stream CanvasSessionServerStream
 }
 
 // NewEndpoints wraps the methods of the "api" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
 	return &Endpoints{
-		CanvasGet: NewCanvasGetEndpoint(s),
+		CanvasCreate:          NewCanvasCreateEndpoint(s, a.JWTAuth),
+		CanvasList:            NewCanvasListEndpoint(s),
+		CanvasGet:             NewCanvasGetEndpoint(s),
+		CanvasArchive:         NewCanvasArchiveEndpoint(s, a.JWTAuth),
+		CanvasResize:          NewCanvasResizeEndpoint(s, a.JWTAuth),
+		ProtectedRegionCreate: NewProtectedRegionCreateEndpoint(s, a.JWTAuth),
+		ProtectedRegionDelete: NewProtectedRegionDeleteEndpoint(s, a.JWTAuth),
+		CanvasRollback:        NewCanvasRollbackEndpoint(s, a.JWTAuth),
+		AuditLogGet:           NewAuditLogGetEndpoint(s, a.JWTAuth),
+		CanvasPixelsGet:       NewCanvasPixelsGetEndpoint(s),
+		CanvasChunksGet:       NewCanvasChunksGetEndpoint(s),
+		CanvasRegionGet:       NewCanvasRegionGetEndpoint(s),
+		CanvasImageGet:        NewCanvasImageGetEndpoint(s),
+		CanvasRegionImageGet:  NewCanvasRegionImageGetEndpoint(s),
+		CanvasTileGet:         NewCanvasTileGetEndpoint(s),
+		CanvasSubscribe:       NewCanvasSubscribeEndpoint(s),
+		CanvasSession:         NewCanvasSessionEndpoint(s, a.JWTAuth),
+		PixelPlace:            NewPixelPlaceEndpoint(s, a.JWTAuth),
+		PixelInfoGet:          NewPixelInfoGetEndpoint(s),
 	}
 }
 
 // Use applies the given middleware to all the "api" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.CanvasCreate = m(e.CanvasCreate)
+	e.CanvasList = m(e.CanvasList)
 	e.CanvasGet = m(e.CanvasGet)
+	e.CanvasArchive = m(e.CanvasArchive)
+	e.CanvasResize = m(e.CanvasResize)
+	e.ProtectedRegionCreate = m(e.ProtectedRegionCreate)
+	e.ProtectedRegionDelete = m(e.ProtectedRegionDelete)
+	e.CanvasRollback = m(e.CanvasRollback)
+	e.AuditLogGet = m(e.AuditLogGet)
+	e.CanvasPixelsGet = m(e.CanvasPixelsGet)
+	e.CanvasChunksGet = m(e.CanvasChunksGet)
+	e.CanvasRegionGet = m(e.CanvasRegionGet)
+	e.CanvasImageGet = m(e.CanvasImageGet)
+	e.CanvasRegionImageGet = m(e.CanvasRegionImageGet)
+	e.CanvasTileGet = m(e.CanvasTileGet)
+	e.CanvasSubscribe = m(e.CanvasSubscribe)
+	e.CanvasSession = m(e.CanvasSession)
+	e.PixelPlace = m(e.PixelPlace)
+	e.PixelInfoGet = m(e.PixelInfoGet)
+}
+
+// NewCanvasCreateEndpoint returns an endpoint function that calls the method
+// "CanvasCreate" of service "api".
+func NewCanvasCreateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasCreatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.CanvasCreate(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCanvas(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCanvasListEndpoint returns an endpoint function that calls the method
+// "CanvasList" of service "api".
+func NewCanvasListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasListPayload)
+		res, err := s.CanvasList(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCanvases(res, "default")
+		return vres, nil
+	}
 }
 
 // NewCanvasGetEndpoint returns an endpoint function that calls the method
 // "CanvasGet" of service "api".
 func NewCanvasGetEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		res, err := s.CanvasGet(ctx)
+		p := req.(*CanvasGetPayload)
+		res, err := s.CanvasGet(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCanvas(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCanvasArchiveEndpoint returns an endpoint function that calls the method
+// "CanvasArchive" of service "api".
+func NewCanvasArchiveEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasArchivePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.CanvasArchive(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCanvas(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCanvasResizeEndpoint returns an endpoint function that calls the method
+// "CanvasResize" of service "api".
+func NewCanvasResizeEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasResizePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.CanvasResize(ctx, p)
 		if err != nil {
 			return nil, err
 		}
@@ -42,3 +205,229 @@ func NewCanvasGetEndpoint(s Service) goa.Endpoint {
 		return vres, nil
 	}
 }
+
+// NewProtectedRegionCreateEndpoint returns an endpoint function that calls the
+// method "ProtectedRegionCreate" of service "api".
+func NewProtectedRegionCreateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ProtectedRegionCreatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.ProtectedRegionCreate(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedProtectedRegion(res, "default")
+		return vres, nil
+	}
+}
+
+// NewProtectedRegionDeleteEndpoint returns an endpoint function that calls the
+// method "ProtectedRegionDelete" of service "api".
+func NewProtectedRegionDeleteEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ProtectedRegionDeletePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.ProtectedRegionDelete(ctx, p)
+	}
+}
+
+// NewCanvasRollbackEndpoint returns an endpoint function that calls the method
+// "CanvasRollback" of service "api".
+func NewCanvasRollbackEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasRollbackPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.CanvasRollback(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedRollback(res, "default")
+		return vres, nil
+	}
+}
+
+// NewAuditLogGetEndpoint returns an endpoint function that calls the method
+// "AuditLogGet" of service "api".
+func NewAuditLogGetEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AuditLogGetPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.AuditLogGet(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedAuditLog(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCanvasPixelsGetEndpoint returns an endpoint function that calls the
+// method "CanvasPixelsGet" of service "api".
+func NewCanvasPixelsGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasPixelsGetPayload)
+		res, err := s.CanvasPixelsGet(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCanvasPixels(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCanvasChunksGetEndpoint returns an endpoint function that calls the
+// method "CanvasChunksGet" of service "api".
+func NewCanvasChunksGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasChunksGetPayload)
+		res, err := s.CanvasChunksGet(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCanvasChunks(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCanvasRegionGetEndpoint returns an endpoint function that calls the
+// method "CanvasRegionGet" of service "api".
+func NewCanvasRegionGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasRegionGetPayload)
+		res, err := s.CanvasRegionGet(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCanvasRegion(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCanvasImageGetEndpoint returns an endpoint function that calls the method
+// "CanvasImageGet" of service "api".
+func NewCanvasImageGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasImageGetPayload)
+		return s.CanvasImageGet(ctx, p)
+	}
+}
+
+// NewCanvasRegionImageGetEndpoint returns an endpoint function that calls the
+// method "CanvasRegionImageGet" of service "api".
+func NewCanvasRegionImageGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasRegionImageGetPayload)
+		return s.CanvasRegionImageGet(ctx, p)
+	}
+}
+
+// NewCanvasTileGetEndpoint returns an endpoint function that calls the method
+// "CanvasTileGet" of service "api".
+func NewCanvasTileGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CanvasTileGetPayload)
+		return s.CanvasTileGet(ctx, p)
+	}
+}
+
+// NewCanvasSubscribeEndpoint returns an endpoint function that calls the
+// method "CanvasSubscribe" of service "api".
+func NewCanvasSubscribeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*CanvasSubscribeEndpointInput)
+		return nil, s.CanvasSubscribe(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewCanvasSessionEndpoint returns an endpoint function that calls the method
+// "CanvasSession" of service "api".
+func NewCanvasSessionEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*CanvasSessionEndpointInput)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:place"},
+		}
+		ctx, err = authJWTFn(ctx, ep.Payload.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.CanvasSession(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewPixelPlaceEndpoint returns an endpoint function that calls the method
+// "PixelPlace" of service "api".
+func NewPixelPlaceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PixelPlacePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"canvas:place", "canvas:manage"},
+			RequiredScopes: []string{"canvas:place"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.PixelPlace(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedPixel(res, "default")
+		return vres, nil
+	}
+}
+
+// NewPixelInfoGetEndpoint returns an endpoint function that calls the method
+// "PixelInfoGet" of service "api".
+func NewPixelInfoGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PixelInfoGetPayload)
+		res, err := s.PixelInfoGet(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedPixelInfo(res, "default")
+		return vres, nil
+	}
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api service
 //
@@ -12,12 +12,64 @@ import (
 
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Service is the api service interface.
 type Service interface {
+	// CanvasCreate implements CanvasCreate.
+	CanvasCreate(context.Context, *CanvasCreatePayload) (res *Canvas, err error)
+	// CanvasList implements CanvasList.
+	CanvasList(context.Context, *CanvasListPayload) (res *Canvases, err error)
 	// CanvasGet implements CanvasGet.
-	CanvasGet(context.Context) (res *Canvas, err error)
+	CanvasGet(context.Context, *CanvasGetPayload) (res *Canvas, err error)
+	// Archive a canvas, after which it can still be viewed but no more pixels can
+	// be placed on it.
+	CanvasArchive(context.Context, *CanvasArchivePayload) (res *Canvas, err error)
+	// Grow a canvas by a number of pixels on each side. Existing pixels keep their
+	// colors and history, but move right and down by the number of pixels added on
+	// the left and top.
+	CanvasResize(context.Context, *CanvasResizePayload) (res *Canvas, err error)
+	// Protect a region of a canvas, after which no pixels can be placed in it
+	// until it expires or is deleted.
+	ProtectedRegionCreate(context.Context, *ProtectedRegionCreatePayload) (res *ProtectedRegion, err error)
+	// Delete a protected region of a canvas, after which pixels can be placed in
+	// it again.
+	ProtectedRegionDelete(context.Context, *ProtectedRegionDeletePayload) (err error)
+	// Revert the pixels last placed by a user, or within a region and time window,
+	// to their previous colors. Every filter that is set must match, and at least
+	// a user or a region must be set. Only placements since the canvas was last
+	// resized are reverted.
+	CanvasRollback(context.Context, *CanvasRollbackPayload) (res *Rollback, err error)
+	// List the latest actions taken by moderators on a canvas.
+	AuditLogGet(context.Context, *AuditLogGetPayload) (res *AuditLog, err error)
+	// CanvasPixelsGet implements CanvasPixelsGet.
+	CanvasPixelsGet(context.Context, *CanvasPixelsGetPayload) (res *CanvasPixels, err error)
+	// Get the chunks of a canvas modified since a version, so that clients can
+	// refresh only what changed.
+	CanvasChunksGet(context.Context, *CanvasChunksGetPayload) (res *CanvasChunks, err error)
+	// CanvasRegionGet implements CanvasRegionGet.
+	CanvasRegionGet(context.Context, *CanvasRegionGetPayload) (res *CanvasRegion, err error)
+	// CanvasImageGet implements CanvasImageGet.
+	CanvasImageGet(context.Context, *CanvasImageGetPayload) (res []byte, err error)
+	// CanvasRegionImageGet implements CanvasRegionImageGet.
+	CanvasRegionImageGet(context.Context, *CanvasRegionImageGetPayload) (res []byte, err error)
+	// CanvasTileGet implements CanvasTileGet.
+	CanvasTileGet(context.Context, *CanvasTileGetPayload) (res *CanvasTile, err error)
+	// CanvasSubscribe implements CanvasSubscribe.
+	CanvasSubscribe(context.Context, *CanvasSubscribePayload, CanvasSubscribeServerStream) (err error)
+	// CanvasSession implements CanvasSession.
+	CanvasSession(context.Context, *CanvasSessionPayload, CanvasSessionServerStream) (err error)
+	// PixelPlace implements PixelPlace.
+	PixelPlace(context.Context, *PixelPlacePayload) (res *Pixel, err error)
+	// PixelInfoGet implements PixelInfoGet.
+	PixelInfoGet(context.Context, *PixelInfoGetPayload) (res *PixelInfo, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -34,13 +86,493 @@ const ServiceName = "api"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [1]string{"CanvasGet"}
+var MethodNames = [19]string{"CanvasCreate", "CanvasList", "CanvasGet", "CanvasArchive", "CanvasResize", "ProtectedRegionCreate", "ProtectedRegionDelete", "CanvasRollback", "AuditLogGet", "CanvasPixelsGet", "CanvasChunksGet", "CanvasRegionGet", "CanvasImageGet", "CanvasRegionImageGet", "CanvasTileGet", "CanvasSubscribe", "CanvasSession", "PixelPlace", "PixelInfoGet"}
+
+// CanvasSubscribeServerStream allows streaming instances of *CanvasEvent to
+// the client.
+type CanvasSubscribeServerStream interface {
+	// Send streams instances of "CanvasEvent".
+	Send(*CanvasEvent) error
+	// SendWithContext streams instances of "CanvasEvent" with context.
+	SendWithContext(context.Context, *CanvasEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// CanvasSubscribeClientStream allows streaming instances of *CanvasEvent to
+// the client.
+type CanvasSubscribeClientStream interface {
+	// Recv reads instances of "CanvasEvent" from the stream.
+	Recv() (*CanvasEvent, error)
+	// RecvWithContext reads instances of "CanvasEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*CanvasEvent, error)
+}
 
-// Canvas is the result type of the api service CanvasGet method.
+// CanvasSessionServerStream allows streaming instances of *SessionEvent to the
+// client.
+type CanvasSessionServerStream interface {
+	// Send streams instances of "SessionEvent".
+	Send(*SessionEvent) error
+	// SendWithContext streams instances of "SessionEvent" with context.
+	SendWithContext(context.Context, *SessionEvent) error
+	// Recv reads instances of "PixelPlacement" from the stream.
+	Recv() (*PixelPlacement, error)
+	// RecvWithContext reads instances of "PixelPlacement" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*PixelPlacement, error)
+	// Close closes the stream.
+	Close() error
+}
+
+// CanvasSessionClientStream allows streaming instances of *PixelPlacement to
+// the client.
+type CanvasSessionClientStream interface {
+	// Send streams instances of "PixelPlacement".
+	Send(*PixelPlacement) error
+	// SendWithContext streams instances of "PixelPlacement" with context.
+	SendWithContext(context.Context, *PixelPlacement) error
+	// Recv reads instances of "SessionEvent" from the stream.
+	Recv() (*SessionEvent, error)
+	// RecvWithContext reads instances of "SessionEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*SessionEvent, error)
+	// Close closes the stream.
+	Close() error
+}
+
+// An action taken by a moderator on a canvas.
+type AuditEntry struct {
+	Action string
+	// ID of the moderator who took the action.
+	UserID    string
+	Details   string
+	CreatedAt string
+}
+
+// AuditLog is the result type of the api service AuditLogGet method.
+type AuditLog struct {
+	// Latest actions taken by moderators on the canvas, most recent first.
+	Entries []*AuditEntry
+}
+
+// AuditLogGetPayload is the payload type of the api service AuditLogGet method.
+type AuditLogGetPayload struct {
+	Token string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+	// Maximum number of entries to return.
+	Limit int32
+}
+
+// Canvas is the result type of the api service CanvasCreate method.
 type Canvas struct {
 	ID     string
 	Width  int32
 	Height int32
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string
+	CreatedAt string
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string
+	// Incremented each time the canvas is resized.
+	Version int32
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegion
+}
+
+// CanvasArchivePayload is the payload type of the api service CanvasArchive
+// method.
+type CanvasArchivePayload struct {
+	Token string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// The pixels in one chunk of a canvas.
+type CanvasChunk struct {
+	// X coordinate of the chunk, in chunks.
+	X int32
+	// Y coordinate of the chunk, in chunks.
+	Y      int32
+	Width  int32
+	Height int32
+	// Sequence number of the latest placement in the chunk.
+	Version int64
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte
+}
+
+// CanvasChunks is the result type of the api service CanvasChunksGet method.
+type CanvasChunks struct {
+	// Sequence number of the latest placement on the canvas, to pass as since to
+	// fetch only later changes.
+	Version int64
+	// Width and height of each chunk, except those on the right and bottom edges
+	// of the canvas.
+	ChunkSize int32
+	// Chunks modified after the requested version.
+	Chunks []*CanvasChunk
+}
+
+// CanvasChunksGetPayload is the payload type of the api service
+// CanvasChunksGet method.
+type CanvasChunksGetPayload struct {
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+	// Version of the canvas the client already has, or 0 for every chunk with
+	// pixels placed.
+	Since int64
+}
+
+// CanvasCreatePayload is the payload type of the api service CanvasCreate
+// method.
+type CanvasCreatePayload struct {
+	Token  string
+	Width  int32
+	Height int32
+	// Ordered list of colors available on the canvas, defaults to the standard
+	// palette if empty.
+	Palette []string
+}
+
+// CanvasEvent is the result type of the api service CanvasSubscribe method.
+type CanvasEvent struct {
+	// Sequence number the canvas is at once the event is applied, used to resume
+	// the stream.
+	ID    string      `json:"id"`
+	Type  string      `json:"type"`
+	Pixel *PixelEvent `json:"pixel,omitempty"`
+	// Sent instead of replaying missed placements when there are too many of them,
+	// or when the canvas has been resized.
+	Snapshot *CanvasSnapshot `json:"snapshot,omitempty"`
+}
+
+// CanvasGetPayload is the payload type of the api service CanvasGet method.
+type CanvasGetPayload struct {
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// CanvasImageGetPayload is the payload type of the api service CanvasImageGet
+// method.
+type CanvasImageGetPayload struct {
+	// Number of image pixels per canvas pixel.
+	Scale int32
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// CanvasListPayload is the payload type of the api service CanvasList method.
+type CanvasListPayload struct {
+	// Whether to include archived canvases.
+	IncludeArchived bool
+}
+
+// CanvasPixels is the result type of the api service CanvasPixelsGet method.
+type CanvasPixels struct {
+	Width  int32
+	Height int32
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte
+}
+
+// CanvasPixelsGetPayload is the payload type of the api service
+// CanvasPixelsGet method.
+type CanvasPixelsGetPayload struct {
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// CanvasRegion is the result type of the api service CanvasRegionGet method.
+type CanvasRegion struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte
+}
+
+// CanvasRegionGetPayload is the payload type of the api service
+// CanvasRegionGet method.
+type CanvasRegionGetPayload struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// CanvasRegionImageGetPayload is the payload type of the api service
+// CanvasRegionImageGet method.
+type CanvasRegionImageGetPayload struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+	// Number of image pixels per canvas pixel.
+	Scale int32
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// CanvasResizePayload is the payload type of the api service CanvasResize
+// method.
+type CanvasResizePayload struct {
+	Token string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID     string
+	Left   int32
+	Top    int32
+	Right  int32
+	Bottom int32
+	// Color of the pixels added to the canvas.
+	Fill int32
+}
+
+// CanvasRollbackPayload is the payload type of the api service CanvasRollback
+// method.
+type CanvasRollbackPayload struct {
+	Token string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+	// Revert pixels placed by this user.
+	UserID *string
+	X      *int32
+	Y      *int32
+	// Revert pixels within this region, along with x, y and height.
+	Width  *int32
+	Height *int32
+	// Revert pixels placed at or after this time.
+	From *string
+	// Revert pixels placed before this time.
+	To *string
+}
+
+// CanvasSessionPayload is the payload type of the api service CanvasSession
+// method.
+type CanvasSessionPayload struct {
+	Token string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// Every pixel on the canvas as of a sequence number.
+type CanvasSnapshot struct {
+	Seq    int64 `json:"seq"`
+	Width  int32 `json:"width"`
+	Height int32 `json:"height"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte `json:"pixels"`
+}
+
+// CanvasSubscribePayload is the payload type of the api service
+// CanvasSubscribe method.
+type CanvasSubscribePayload struct {
+	// Sequence number of the last event received. Placements made after it are
+	// replayed before live events.
+	Since *int64
+	// Set from the Last-Event-ID header by reconnecting SSE clients, in place of
+	// since.
+	LastEventID *string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// CanvasTile is the result type of the api service CanvasTileGet method.
+type CanvasTile struct {
+	// Whether the tile was rendered or the client's copy is still current.
+	Status       string
+	Etag         string
+	CacheControl string
+	// PNG-encoded tile, omitted if not modified.
+	Image []byte
+}
+
+// CanvasTileGetPayload is the payload type of the api service CanvasTileGet
+// method.
+type CanvasTileGetPayload struct {
+	// Zoom level, from 0 where a single tile covers the whole canvas up to the
+	// level where each tile pixel is one canvas pixel.
+	Z int32
+	// X coordinate of the tile, in tiles.
+	X int32
+	// Y coordinate of the tile, in tiles.
+	Y           int32
+	IfNoneMatch *string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// Canvases is the result type of the api service CanvasList method.
+type Canvases struct {
+	// Canvases in the order they were created.
+	Canvases []*Canvas
+}
+
+// The user placed a pixel too recently and must wait before placing another.
+type CooldownError struct {
+	Message string
+	// Number of seconds to wait before placing another pixel.
+	RetryAfter int32
+}
+
+// Pixel is the result type of the api service PixelPlace method.
+type Pixel struct {
+	X     int32
+	Y     int32
+	Color int32
+}
+
+// A pixel placement accepted on the canvas.
+type PixelEvent struct {
+	X     int32 `json:"x"`
+	Y     int32 `json:"y"`
+	Color int32 `json:"color"`
+	// ID of the user who placed the pixel.
+	UserID   string `json:"user_id"`
+	PlacedAt string `json:"placed_at"`
+	// Sequence number of the placement on the canvas.
+	Seq int64 `json:"seq"`
+}
+
+// A past placement at a coordinate.
+type PixelHistoryEntry struct {
+	// ID of the user who placed the pixel.
+	UserID   string
+	Color    int32
+	PlacedAt string
+	// Sequence number of the placement on the canvas.
+	Seq int64
+}
+
+// PixelInfo is the result type of the api service PixelInfoGet method.
+type PixelInfo struct {
+	X int32
+	Y int32
+	// Latest placements at the coordinate, most recent first.
+	Placements []*PixelHistoryEntry
+}
+
+// PixelInfoGetPayload is the payload type of the api service PixelInfoGet
+// method.
+type PixelInfoGetPayload struct {
+	X int32
+	Y int32
+	// Maximum number of placements to return.
+	Limit int32
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// PixelPlacePayload is the payload type of the api service PixelPlace method.
+type PixelPlacePayload struct {
+	Token string
+	X     int32
+	Y     int32
+	Color int32
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// PixelPlacement is the streaming payload type of the api service
+// CanvasSession method.
+type PixelPlacement struct {
+	X     int32
+	Y     int32
+	Color int32
+}
+
+// Why a placement sent over a canvas session was rejected.
+type PlacementRejection struct {
+	// X coordinate of the placement, if it could be decoded.
+	X *int32
+	// Y coordinate of the placement, if it could be decoded.
+	Y *int32
+	// Name of the error, e.g. cooldown_active.
+	Name    string
+	Message string
+	// Number of seconds to wait before placing another pixel, if on cooldown.
+	RetryAfter *int32
+}
+
+// ProtectedRegion is the result type of the api service ProtectedRegionCreate
+// method.
+type ProtectedRegion struct {
+	ID        string
+	X         int32
+	Y         int32
+	Width     int32
+	Height    int32
+	Reason    string
+	CreatedAt string
+	// Set if the region is only protected until then.
+	ExpiresAt *string
+}
+
+// ProtectedRegionCreatePayload is the payload type of the api service
+// ProtectedRegionCreate method.
+type ProtectedRegionCreatePayload struct {
+	Token  string
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+	// Why the region is protected, e.g. a sponsor's logo.
+	Reason *string
+	// Number of seconds to protect the region for. The region is protected until
+	// deleted if unset.
+	Duration *int32
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+}
+
+// ProtectedRegionDeletePayload is the payload type of the api service
+// ProtectedRegionDelete method.
+type ProtectedRegionDeletePayload struct {
+	Token string
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	ID string
+	// ID of the protected region, e.g. rgn_01h455vb4pex5vsknk084sn02q.
+	RegionID string
+}
+
+// Rollback is the result type of the api service CanvasRollback method.
+type Rollback struct {
+	// Number of pixels reverted.
+	Reverted int32
+}
+
+// SessionEvent is the result type of the api service CanvasSession method.
+type SessionEvent struct {
+	// The canvas the session is for, sent when the session starts and again
+	// whenever the canvas is resized.
+	Canvas *Canvas
+	// A pixel placed on the canvas by any user.
+	Pixel *PixelEvent
+	// A placement sent by the client that was rejected.
+	Rejection *PlacementRejection
+}
+
+// Error returns an error description.
+func (e *CooldownError) Error() string {
+	return "The user placed a pixel too recently and must wait before placing another."
+}
+
+// ErrorName returns "CooldownError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *CooldownError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "CooldownError".
+func (e *CooldownError) GoaErrorName() string {
+	return "cooldown_active"
 }
 
 // MakeUnauthenticated builds a goa.ServiceError from an error.
@@ -53,6 +585,16 @@ func MakeAccessDenied(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "access_denied", false, false, false)
 }
 
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeCanvasArchived builds a goa.ServiceError from an error.
+func MakeCanvasArchived(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "canvas_archived", false, false, false)
+}
+
 // NewCanvas initializes result type Canvas from viewed result type Canvas.
 func NewCanvas(vres *apiviews.Canvas) *Canvas {
 	return newCanvas(vres.Projected)
@@ -65,9 +607,127 @@ func NewViewedCanvas(res *Canvas, view string) *apiviews.Canvas {
 	return &apiviews.Canvas{Projected: p, View: "default"}
 }
 
+// NewCanvases initializes result type Canvases from viewed result type
+// Canvases.
+func NewCanvases(vres *apiviews.Canvases) *Canvases {
+	return newCanvases(vres.Projected)
+}
+
+// NewViewedCanvases initializes viewed result type Canvases from result type
+// Canvases using the given view.
+func NewViewedCanvases(res *Canvases, view string) *apiviews.Canvases {
+	p := newCanvasesView(res)
+	return &apiviews.Canvases{Projected: p, View: "default"}
+}
+
+// NewProtectedRegion initializes result type ProtectedRegion from viewed
+// result type ProtectedRegion.
+func NewProtectedRegion(vres *apiviews.ProtectedRegion) *ProtectedRegion {
+	return newProtectedRegion(vres.Projected)
+}
+
+// NewViewedProtectedRegion initializes viewed result type ProtectedRegion from
+// result type ProtectedRegion using the given view.
+func NewViewedProtectedRegion(res *ProtectedRegion, view string) *apiviews.ProtectedRegion {
+	p := newProtectedRegionView(res)
+	return &apiviews.ProtectedRegion{Projected: p, View: "default"}
+}
+
+// NewRollback initializes result type Rollback from viewed result type
+// Rollback.
+func NewRollback(vres *apiviews.Rollback) *Rollback {
+	return newRollback(vres.Projected)
+}
+
+// NewViewedRollback initializes viewed result type Rollback from result type
+// Rollback using the given view.
+func NewViewedRollback(res *Rollback, view string) *apiviews.Rollback {
+	p := newRollbackView(res)
+	return &apiviews.Rollback{Projected: p, View: "default"}
+}
+
+// NewAuditLog initializes result type AuditLog from viewed result type
+// AuditLog.
+func NewAuditLog(vres *apiviews.AuditLog) *AuditLog {
+	return newAuditLog(vres.Projected)
+}
+
+// NewViewedAuditLog initializes viewed result type AuditLog from result type
+// AuditLog using the given view.
+func NewViewedAuditLog(res *AuditLog, view string) *apiviews.AuditLog {
+	p := newAuditLogView(res)
+	return &apiviews.AuditLog{Projected: p, View: "default"}
+}
+
+// NewCanvasPixels initializes result type CanvasPixels from viewed result type
+// CanvasPixels.
+func NewCanvasPixels(vres *apiviews.CanvasPixels) *CanvasPixels {
+	return newCanvasPixels(vres.Projected)
+}
+
+// NewViewedCanvasPixels initializes viewed result type CanvasPixels from
+// result type CanvasPixels using the given view.
+func NewViewedCanvasPixels(res *CanvasPixels, view string) *apiviews.CanvasPixels {
+	p := newCanvasPixelsView(res)
+	return &apiviews.CanvasPixels{Projected: p, View: "default"}
+}
+
+// NewCanvasChunks initializes result type CanvasChunks from viewed result type
+// CanvasChunks.
+func NewCanvasChunks(vres *apiviews.CanvasChunks) *CanvasChunks {
+	return newCanvasChunks(vres.Projected)
+}
+
+// NewViewedCanvasChunks initializes viewed result type CanvasChunks from
+// result type CanvasChunks using the given view.
+func NewViewedCanvasChunks(res *CanvasChunks, view string) *apiviews.CanvasChunks {
+	p := newCanvasChunksView(res)
+	return &apiviews.CanvasChunks{Projected: p, View: "default"}
+}
+
+// NewCanvasRegion initializes result type CanvasRegion from viewed result type
+// CanvasRegion.
+func NewCanvasRegion(vres *apiviews.CanvasRegion) *CanvasRegion {
+	return newCanvasRegion(vres.Projected)
+}
+
+// NewViewedCanvasRegion initializes viewed result type CanvasRegion from
+// result type CanvasRegion using the given view.
+func NewViewedCanvasRegion(res *CanvasRegion, view string) *apiviews.CanvasRegion {
+	p := newCanvasRegionView(res)
+	return &apiviews.CanvasRegion{Projected: p, View: "default"}
+}
+
+// NewPixel initializes result type Pixel from viewed result type Pixel.
+func NewPixel(vres *apiviews.Pixel) *Pixel {
+	return newPixel(vres.Projected)
+}
+
+// NewViewedPixel initializes viewed result type Pixel from result type Pixel
+// using the given view.
+func NewViewedPixel(res *Pixel, view string) *apiviews.Pixel {
+	p := newPixelView(res)
+	return &apiviews.Pixel{Projected: p, View: "default"}
+}
+
+// NewPixelInfo initializes result type PixelInfo from viewed result type
+// PixelInfo.
+func NewPixelInfo(vres *apiviews.PixelInfo) *PixelInfo {
+	return newPixelInfo(vres.Projected)
+}
+
+// NewViewedPixelInfo initializes viewed result type PixelInfo from result type
+// PixelInfo using the given view.
+func NewViewedPixelInfo(res *PixelInfo, view string) *apiviews.PixelInfo {
+	p := newPixelInfoView(res)
+	return &apiviews.PixelInfo{Projected: p, View: "default"}
+}
+
 // newCanvas converts projected type Canvas to service type Canvas.
 func newCanvas(vres *apiviews.CanvasView) *Canvas {
-	res := &Canvas{}
+	res := &Canvas{
+		ArchivedAt: vres.ArchivedAt,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -77,6 +737,24 @@ func newCanvas(vres *apiviews.CanvasView) *Canvas {
 	if vres.Height != nil {
 		res.Height = *vres.Height
 	}
+	if vres.CreatedAt != nil {
+		res.CreatedAt = *vres.CreatedAt
+	}
+	if vres.Version != nil {
+		res.Version = *vres.Version
+	}
+	if vres.Palette != nil {
+		res.Palette = make([]string, len(vres.Palette))
+		for i, val := range vres.Palette {
+			res.Palette[i] = val
+		}
+	}
+	if vres.ProtectedRegions != nil {
+		res.ProtectedRegions = make([]*ProtectedRegion, len(vres.ProtectedRegions))
+		for i, val := range vres.ProtectedRegions {
+			res.ProtectedRegions[i] = transformApiviewsProtectedRegionViewToProtectedRegion(val)
+		}
+	}
 	return res
 }
 
@@ -84,9 +762,498 @@ func newCanvas(vres *apiviews.CanvasView) *Canvas {
 // the "default" view.
 func newCanvasView(res *Canvas) *apiviews.CanvasView {
 	vres := &apiviews.CanvasView{
-		ID:     &res.ID,
+		ID:         &res.ID,
+		Width:      &res.Width,
+		Height:     &res.Height,
+		CreatedAt:  &res.CreatedAt,
+		ArchivedAt: res.ArchivedAt,
+		Version:    &res.Version,
+	}
+	if res.Palette != nil {
+		vres.Palette = make([]string, len(res.Palette))
+		for i, val := range res.Palette {
+			vres.Palette[i] = val
+		}
+	} else {
+		vres.Palette = []string{}
+	}
+	if res.ProtectedRegions != nil {
+		vres.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(res.ProtectedRegions))
+		for i, val := range res.ProtectedRegions {
+			vres.ProtectedRegions[i] = transformProtectedRegionToApiviewsProtectedRegionView(val)
+		}
+	} else {
+		vres.ProtectedRegions = []*apiviews.ProtectedRegionView{}
+	}
+	return vres
+}
+
+// newProtectedRegion converts projected type ProtectedRegion to service type
+// ProtectedRegion.
+func newProtectedRegion(vres *apiviews.ProtectedRegionView) *ProtectedRegion {
+	res := &ProtectedRegion{
+		ExpiresAt: vres.ExpiresAt,
+	}
+	if vres.ID != nil {
+		res.ID = *vres.ID
+	}
+	if vres.X != nil {
+		res.X = *vres.X
+	}
+	if vres.Y != nil {
+		res.Y = *vres.Y
+	}
+	if vres.Width != nil {
+		res.Width = *vres.Width
+	}
+	if vres.Height != nil {
+		res.Height = *vres.Height
+	}
+	if vres.Reason != nil {
+		res.Reason = *vres.Reason
+	}
+	if vres.CreatedAt != nil {
+		res.CreatedAt = *vres.CreatedAt
+	}
+	return res
+}
+
+// newProtectedRegionView projects result type ProtectedRegion to projected
+// type ProtectedRegionView using the "default" view.
+func newProtectedRegionView(res *ProtectedRegion) *apiviews.ProtectedRegionView {
+	vres := &apiviews.ProtectedRegionView{
+		ID:        &res.ID,
+		X:         &res.X,
+		Y:         &res.Y,
+		Width:     &res.Width,
+		Height:    &res.Height,
+		Reason:    &res.Reason,
+		CreatedAt: &res.CreatedAt,
+		ExpiresAt: res.ExpiresAt,
+	}
+	return vres
+}
+
+// newCanvases converts projected type Canvases to service type Canvases.
+func newCanvases(vres *apiviews.CanvasesView) *Canvases {
+	res := &Canvases{}
+	if vres.Canvases != nil {
+		res.Canvases = make([]*Canvas, len(vres.Canvases))
+		for i, val := range vres.Canvases {
+			res.Canvases[i] = transformApiviewsCanvasViewToCanvas(val)
+		}
+	}
+	return res
+}
+
+// newCanvasesView projects result type Canvases to projected type CanvasesView
+// using the "default" view.
+func newCanvasesView(res *Canvases) *apiviews.CanvasesView {
+	vres := &apiviews.CanvasesView{}
+	if res.Canvases != nil {
+		vres.Canvases = make([]*apiviews.CanvasView, len(res.Canvases))
+		for i, val := range res.Canvases {
+			vres.Canvases[i] = transformCanvasToApiviewsCanvasView(val)
+		}
+	} else {
+		vres.Canvases = []*apiviews.CanvasView{}
+	}
+	return vres
+}
+
+// newRollback converts projected type Rollback to service type Rollback.
+func newRollback(vres *apiviews.RollbackView) *Rollback {
+	res := &Rollback{}
+	if vres.Reverted != nil {
+		res.Reverted = *vres.Reverted
+	}
+	return res
+}
+
+// newRollbackView projects result type Rollback to projected type RollbackView
+// using the "default" view.
+func newRollbackView(res *Rollback) *apiviews.RollbackView {
+	vres := &apiviews.RollbackView{
+		Reverted: &res.Reverted,
+	}
+	return vres
+}
+
+// newAuditLog converts projected type AuditLog to service type AuditLog.
+func newAuditLog(vres *apiviews.AuditLogView) *AuditLog {
+	res := &AuditLog{}
+	if vres.Entries != nil {
+		res.Entries = make([]*AuditEntry, len(vres.Entries))
+		for i, val := range vres.Entries {
+			res.Entries[i] = transformApiviewsAuditEntryViewToAuditEntry(val)
+		}
+	}
+	return res
+}
+
+// newAuditLogView projects result type AuditLog to projected type AuditLogView
+// using the "default" view.
+func newAuditLogView(res *AuditLog) *apiviews.AuditLogView {
+	vres := &apiviews.AuditLogView{}
+	if res.Entries != nil {
+		vres.Entries = make([]*apiviews.AuditEntryView, len(res.Entries))
+		for i, val := range res.Entries {
+			vres.Entries[i] = transformAuditEntryToApiviewsAuditEntryView(val)
+		}
+	} else {
+		vres.Entries = []*apiviews.AuditEntryView{}
+	}
+	return vres
+}
+
+// newCanvasPixels converts projected type CanvasPixels to service type
+// CanvasPixels.
+func newCanvasPixels(vres *apiviews.CanvasPixelsView) *CanvasPixels {
+	res := &CanvasPixels{
+		Pixels: vres.Pixels,
+	}
+	if vres.Width != nil {
+		res.Width = *vres.Width
+	}
+	if vres.Height != nil {
+		res.Height = *vres.Height
+	}
+	return res
+}
+
+// newCanvasPixelsView projects result type CanvasPixels to projected type
+// CanvasPixelsView using the "default" view.
+func newCanvasPixelsView(res *CanvasPixels) *apiviews.CanvasPixelsView {
+	vres := &apiviews.CanvasPixelsView{
+		Width:  &res.Width,
+		Height: &res.Height,
+		Pixels: res.Pixels,
+	}
+	return vres
+}
+
+// newCanvasChunks converts projected type CanvasChunks to service type
+// CanvasChunks.
+func newCanvasChunks(vres *apiviews.CanvasChunksView) *CanvasChunks {
+	res := &CanvasChunks{}
+	if vres.Version != nil {
+		res.Version = *vres.Version
+	}
+	if vres.ChunkSize != nil {
+		res.ChunkSize = *vres.ChunkSize
+	}
+	if vres.Chunks != nil {
+		res.Chunks = make([]*CanvasChunk, len(vres.Chunks))
+		for i, val := range vres.Chunks {
+			res.Chunks[i] = transformApiviewsCanvasChunkViewToCanvasChunk(val)
+		}
+	}
+	return res
+}
+
+// newCanvasChunksView projects result type CanvasChunks to projected type
+// CanvasChunksView using the "default" view.
+func newCanvasChunksView(res *CanvasChunks) *apiviews.CanvasChunksView {
+	vres := &apiviews.CanvasChunksView{
+		Version:   &res.Version,
+		ChunkSize: &res.ChunkSize,
+	}
+	if res.Chunks != nil {
+		vres.Chunks = make([]*apiviews.CanvasChunkView, len(res.Chunks))
+		for i, val := range res.Chunks {
+			vres.Chunks[i] = transformCanvasChunkToApiviewsCanvasChunkView(val)
+		}
+	} else {
+		vres.Chunks = []*apiviews.CanvasChunkView{}
+	}
+	return vres
+}
+
+// newCanvasRegion converts projected type CanvasRegion to service type
+// CanvasRegion.
+func newCanvasRegion(vres *apiviews.CanvasRegionView) *CanvasRegion {
+	res := &CanvasRegion{
+		Pixels: vres.Pixels,
+	}
+	if vres.X != nil {
+		res.X = *vres.X
+	}
+	if vres.Y != nil {
+		res.Y = *vres.Y
+	}
+	if vres.Width != nil {
+		res.Width = *vres.Width
+	}
+	if vres.Height != nil {
+		res.Height = *vres.Height
+	}
+	return res
+}
+
+// newCanvasRegionView projects result type CanvasRegion to projected type
+// CanvasRegionView using the "default" view.
+func newCanvasRegionView(res *CanvasRegion) *apiviews.CanvasRegionView {
+	vres := &apiviews.CanvasRegionView{
+		X:      &res.X,
+		Y:      &res.Y,
 		Width:  &res.Width,
 		Height: &res.Height,
+		Pixels: res.Pixels,
 	}
 	return vres
 }
+
+// newPixel converts projected type Pixel to service type Pixel.
+func newPixel(vres *apiviews.PixelView) *Pixel {
+	res := &Pixel{}
+	if vres.X != nil {
+		res.X = *vres.X
+	}
+	if vres.Y != nil {
+		res.Y = *vres.Y
+	}
+	if vres.Color != nil {
+		res.Color = *vres.Color
+	}
+	return res
+}
+
+// newPixelView projects result type Pixel to projected type PixelView using
+// the "default" view.
+func newPixelView(res *Pixel) *apiviews.PixelView {
+	vres := &apiviews.PixelView{
+		X:     &res.X,
+		Y:     &res.Y,
+		Color: &res.Color,
+	}
+	return vres
+}
+
+// newPixelInfo converts projected type PixelInfo to service type PixelInfo.
+func newPixelInfo(vres *apiviews.PixelInfoView) *PixelInfo {
+	res := &PixelInfo{}
+	if vres.X != nil {
+		res.X = *vres.X
+	}
+	if vres.Y != nil {
+		res.Y = *vres.Y
+	}
+	if vres.Placements != nil {
+		res.Placements = make([]*PixelHistoryEntry, len(vres.Placements))
+		for i, val := range vres.Placements {
+			res.Placements[i] = transformApiviewsPixelHistoryEntryViewToPixelHistoryEntry(val)
+		}
+	}
+	return res
+}
+
+// newPixelInfoView projects result type PixelInfo to projected type
+// PixelInfoView using the "default" view.
+func newPixelInfoView(res *PixelInfo) *apiviews.PixelInfoView {
+	vres := &apiviews.PixelInfoView{
+		X: &res.X,
+		Y: &res.Y,
+	}
+	if res.Placements != nil {
+		vres.Placements = make([]*apiviews.PixelHistoryEntryView, len(res.Placements))
+		for i, val := range res.Placements {
+			vres.Placements[i] = transformPixelHistoryEntryToApiviewsPixelHistoryEntryView(val)
+		}
+	} else {
+		vres.Placements = []*apiviews.PixelHistoryEntryView{}
+	}
+	return vres
+}
+
+// transformApiviewsProtectedRegionViewToProtectedRegion builds a value of type
+// *ProtectedRegion from a value of type *apiviews.ProtectedRegionView.
+func transformApiviewsProtectedRegionViewToProtectedRegion(v *apiviews.ProtectedRegionView) *ProtectedRegion {
+	if v == nil {
+		return nil
+	}
+	res := &ProtectedRegion{
+		ID:        *v.ID,
+		X:         *v.X,
+		Y:         *v.Y,
+		Width:     *v.Width,
+		Height:    *v.Height,
+		Reason:    *v.Reason,
+		CreatedAt: *v.CreatedAt,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// transformProtectedRegionToApiviewsProtectedRegionView builds a value of type
+// *apiviews.ProtectedRegionView from a value of type *ProtectedRegion.
+func transformProtectedRegionToApiviewsProtectedRegionView(v *ProtectedRegion) *apiviews.ProtectedRegionView {
+	res := &apiviews.ProtectedRegionView{
+		ID:        &v.ID,
+		X:         &v.X,
+		Y:         &v.Y,
+		Width:     &v.Width,
+		Height:    &v.Height,
+		Reason:    &v.Reason,
+		CreatedAt: &v.CreatedAt,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// transformApiviewsCanvasViewToCanvas builds a value of type *Canvas from a
+// value of type *apiviews.CanvasView.
+func transformApiviewsCanvasViewToCanvas(v *apiviews.CanvasView) *Canvas {
+	if v == nil {
+		return nil
+	}
+	res := &Canvas{
+		ID:         *v.ID,
+		Width:      *v.Width,
+		Height:     *v.Height,
+		CreatedAt:  *v.CreatedAt,
+		ArchivedAt: v.ArchivedAt,
+		Version:    *v.Version,
+	}
+	if v.Palette != nil {
+		res.Palette = make([]string, len(v.Palette))
+		for i, val := range v.Palette {
+			res.Palette[i] = val
+		}
+	} else {
+		res.Palette = []string{}
+	}
+	if v.ProtectedRegions != nil {
+		res.ProtectedRegions = make([]*ProtectedRegion, len(v.ProtectedRegions))
+		for i, val := range v.ProtectedRegions {
+			res.ProtectedRegions[i] = transformApiviewsProtectedRegionViewToProtectedRegion(val)
+		}
+	} else {
+		res.ProtectedRegions = []*ProtectedRegion{}
+	}
+
+	return res
+}
+
+// transformCanvasToApiviewsCanvasView builds a value of type
+// *apiviews.CanvasView from a value of type *Canvas.
+func transformCanvasToApiviewsCanvasView(v *Canvas) *apiviews.CanvasView {
+	res := &apiviews.CanvasView{
+		ID:         &v.ID,
+		Width:      &v.Width,
+		Height:     &v.Height,
+		CreatedAt:  &v.CreatedAt,
+		ArchivedAt: v.ArchivedAt,
+		Version:    &v.Version,
+	}
+	if v.Palette != nil {
+		res.Palette = make([]string, len(v.Palette))
+		for i, val := range v.Palette {
+			res.Palette[i] = val
+		}
+	} else {
+		res.Palette = []string{}
+	}
+	if v.ProtectedRegions != nil {
+		res.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(v.ProtectedRegions))
+		for i, val := range v.ProtectedRegions {
+			res.ProtectedRegions[i] = transformProtectedRegionToApiviewsProtectedRegionView(val)
+		}
+	} else {
+		res.ProtectedRegions = []*apiviews.ProtectedRegionView{}
+	}
+
+	return res
+}
+
+// transformApiviewsAuditEntryViewToAuditEntry builds a value of type
+// *AuditEntry from a value of type *apiviews.AuditEntryView.
+func transformApiviewsAuditEntryViewToAuditEntry(v *apiviews.AuditEntryView) *AuditEntry {
+	if v == nil {
+		return nil
+	}
+	res := &AuditEntry{
+		Action:    *v.Action,
+		UserID:    *v.UserID,
+		Details:   *v.Details,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// transformAuditEntryToApiviewsAuditEntryView builds a value of type
+// *apiviews.AuditEntryView from a value of type *AuditEntry.
+func transformAuditEntryToApiviewsAuditEntryView(v *AuditEntry) *apiviews.AuditEntryView {
+	res := &apiviews.AuditEntryView{
+		Action:    &v.Action,
+		UserID:    &v.UserID,
+		Details:   &v.Details,
+		CreatedAt: &v.CreatedAt,
+	}
+
+	return res
+}
+
+// transformApiviewsCanvasChunkViewToCanvasChunk builds a value of type
+// *CanvasChunk from a value of type *apiviews.CanvasChunkView.
+func transformApiviewsCanvasChunkViewToCanvasChunk(v *apiviews.CanvasChunkView) *CanvasChunk {
+	if v == nil {
+		return nil
+	}
+	res := &CanvasChunk{
+		X:       *v.X,
+		Y:       *v.Y,
+		Width:   *v.Width,
+		Height:  *v.Height,
+		Version: *v.Version,
+		Pixels:  v.Pixels,
+	}
+
+	return res
+}
+
+// transformCanvasChunkToApiviewsCanvasChunkView builds a value of type
+// *apiviews.CanvasChunkView from a value of type *CanvasChunk.
+func transformCanvasChunkToApiviewsCanvasChunkView(v *CanvasChunk) *apiviews.CanvasChunkView {
+	res := &apiviews.CanvasChunkView{
+		X:       &v.X,
+		Y:       &v.Y,
+		Width:   &v.Width,
+		Height:  &v.Height,
+		Version: &v.Version,
+		Pixels:  v.Pixels,
+	}
+
+	return res
+}
+
+// transformApiviewsPixelHistoryEntryViewToPixelHistoryEntry builds a value of
+// type *PixelHistoryEntry from a value of type *apiviews.PixelHistoryEntryView.
+func transformApiviewsPixelHistoryEntryViewToPixelHistoryEntry(v *apiviews.PixelHistoryEntryView) *PixelHistoryEntry {
+	if v == nil {
+		return nil
+	}
+	res := &PixelHistoryEntry{
+		UserID:   *v.UserID,
+		Color:    *v.Color,
+		PlacedAt: *v.PlacedAt,
+		Seq:      *v.Seq,
+	}
+
+	return res
+}
+
+// transformPixelHistoryEntryToApiviewsPixelHistoryEntryView builds a value of
+// type *apiviews.PixelHistoryEntryView from a value of type *PixelHistoryEntry.
+func transformPixelHistoryEntryToApiviewsPixelHistoryEntryView(v *PixelHistoryEntry) *apiviews.PixelHistoryEntryView {
+	res := &apiviews.PixelHistoryEntryView{
+		UserID:   &v.UserID,
+		Color:    &v.Color,
+		PlacedAt: &v.PlacedAt,
+		Seq:      &v.Seq,
+	}
+
+	return res
+}
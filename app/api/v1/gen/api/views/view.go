@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api views
 //
@@ -19,11 +19,239 @@ type Canvas struct {
 	View string
 }
 
+// Canvases is the viewed result type that is projected based on a view.
+type Canvases struct {
+	// Type to project
+	Projected *CanvasesView
+	// View to render
+	View string
+}
+
+// ProtectedRegion is the viewed result type that is projected based on a view.
+type ProtectedRegion struct {
+	// Type to project
+	Projected *ProtectedRegionView
+	// View to render
+	View string
+}
+
+// Rollback is the viewed result type that is projected based on a view.
+type Rollback struct {
+	// Type to project
+	Projected *RollbackView
+	// View to render
+	View string
+}
+
+// AuditLog is the viewed result type that is projected based on a view.
+type AuditLog struct {
+	// Type to project
+	Projected *AuditLogView
+	// View to render
+	View string
+}
+
+// CanvasPixels is the viewed result type that is projected based on a view.
+type CanvasPixels struct {
+	// Type to project
+	Projected *CanvasPixelsView
+	// View to render
+	View string
+}
+
+// CanvasChunks is the viewed result type that is projected based on a view.
+type CanvasChunks struct {
+	// Type to project
+	Projected *CanvasChunksView
+	// View to render
+	View string
+}
+
+// CanvasRegion is the viewed result type that is projected based on a view.
+type CanvasRegion struct {
+	// Type to project
+	Projected *CanvasRegionView
+	// View to render
+	View string
+}
+
+// Pixel is the viewed result type that is projected based on a view.
+type Pixel struct {
+	// Type to project
+	Projected *PixelView
+	// View to render
+	View string
+}
+
+// PixelInfo is the viewed result type that is projected based on a view.
+type PixelInfo struct {
+	// Type to project
+	Projected *PixelInfoView
+	// View to render
+	View string
+}
+
 // CanvasView is a type that runs validations on a projected type.
 type CanvasView struct {
 	ID     *string
 	Width  *int32
 	Height *int32
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string
+	CreatedAt *string
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string
+	// Incremented each time the canvas is resized.
+	Version *int32
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionView
+}
+
+// ProtectedRegionView is a type that runs validations on a projected type.
+type ProtectedRegionView struct {
+	ID        *string
+	X         *int32
+	Y         *int32
+	Width     *int32
+	Height    *int32
+	Reason    *string
+	CreatedAt *string
+	// Set if the region is only protected until then.
+	ExpiresAt *string
+}
+
+// CanvasesView is a type that runs validations on a projected type.
+type CanvasesView struct {
+	// Canvases in the order they were created.
+	Canvases []*CanvasView
+}
+
+// RollbackView is a type that runs validations on a projected type.
+type RollbackView struct {
+	// Number of pixels reverted.
+	Reverted *int32
+}
+
+// AuditLogView is a type that runs validations on a projected type.
+type AuditLogView struct {
+	// Latest actions taken by moderators on the canvas, most recent first.
+	Entries []*AuditEntryView
+}
+
+// AuditEntryView is a type that runs validations on a projected type.
+type AuditEntryView struct {
+	Action *string
+	// ID of the moderator who took the action.
+	UserID    *string
+	Details   *string
+	CreatedAt *string
+}
+
+// CanvasPixelsView is a type that runs validations on a projected type.
+type CanvasPixelsView struct {
+	Width  *int32
+	Height *int32
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte
+}
+
+// CanvasChunksView is a type that runs validations on a projected type.
+type CanvasChunksView struct {
+	// Sequence number of the latest placement on the canvas, to pass as since to
+	// fetch only later changes.
+	Version *int64
+	// Width and height of each chunk, except those on the right and bottom edges
+	// of the canvas.
+	ChunkSize *int32
+	// Chunks modified after the requested version.
+	Chunks []*CanvasChunkView
+}
+
+// CanvasChunkView is a type that runs validations on a projected type.
+type CanvasChunkView struct {
+	// X coordinate of the chunk, in chunks.
+	X *int32
+	// Y coordinate of the chunk, in chunks.
+	Y      *int32
+	Width  *int32
+	Height *int32
+	// Sequence number of the latest placement in the chunk.
+	Version *int64
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte
+}
+
+// CanvasRegionView is a type that runs validations on a projected type.
+type CanvasRegionView struct {
+	X      *int32
+	Y      *int32
+	Width  *int32
+	Height *int32
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte
+}
+
+// SessionEventView is a type that runs validations on a projected type.
+type SessionEventView struct {
+	// The canvas the session is for, sent when the session starts and again
+	// whenever the canvas is resized.
+	Canvas *CanvasView
+	// A pixel placed on the canvas by any user.
+	Pixel *PixelEventView
+	// A placement sent by the client that was rejected.
+	Rejection *PlacementRejectionView
+}
+
+// PixelEventView is a type that runs validations on a projected type.
+type PixelEventView struct {
+	X     *int32 `json:"x"`
+	Y     *int32 `json:"y"`
+	Color *int32 `json:"color"`
+	// ID of the user who placed the pixel.
+	UserID   *string `json:"user_id"`
+	PlacedAt *string `json:"placed_at"`
+	// Sequence number of the placement on the canvas.
+	Seq *int64 `json:"seq"`
+}
+
+// PlacementRejectionView is a type that runs validations on a projected type.
+type PlacementRejectionView struct {
+	// X coordinate of the placement, if it could be decoded.
+	X *int32
+	// Y coordinate of the placement, if it could be decoded.
+	Y *int32
+	// Name of the error, e.g. cooldown_active.
+	Name    *string
+	Message *string
+	// Number of seconds to wait before placing another pixel, if on cooldown.
+	RetryAfter *int32
+}
+
+// PixelView is a type that runs validations on a projected type.
+type PixelView struct {
+	X     *int32
+	Y     *int32
+	Color *int32
+}
+
+// PixelInfoView is a type that runs validations on a projected type.
+type PixelInfoView struct {
+	X *int32
+	Y *int32
+	// Latest placements at the coordinate, most recent first.
+	Placements []*PixelHistoryEntryView
+}
+
+// PixelHistoryEntryView is a type that runs validations on a projected type.
+type PixelHistoryEntryView struct {
+	// ID of the user who placed the pixel.
+	UserID   *string
+	Color    *int32
+	PlacedAt *string
+	// Sequence number of the placement on the canvas.
+	Seq *int64
 }
 
 var (
@@ -33,6 +261,88 @@ var (
 			"id",
 			"width",
 			"height",
+			"palette",
+			"created_at",
+			"archived_at",
+			"version",
+			"protected_regions",
+		},
+	}
+	// CanvasesMap is a map indexing the attribute names of Canvases by view name.
+	CanvasesMap = map[string][]string{
+		"default": {
+			"canvases",
+		},
+	}
+	// ProtectedRegionMap is a map indexing the attribute names of ProtectedRegion
+	// by view name.
+	ProtectedRegionMap = map[string][]string{
+		"default": {
+			"id",
+			"x",
+			"y",
+			"width",
+			"height",
+			"reason",
+			"created_at",
+			"expires_at",
+		},
+	}
+	// RollbackMap is a map indexing the attribute names of Rollback by view name.
+	RollbackMap = map[string][]string{
+		"default": {
+			"reverted",
+		},
+	}
+	// AuditLogMap is a map indexing the attribute names of AuditLog by view name.
+	AuditLogMap = map[string][]string{
+		"default": {
+			"entries",
+		},
+	}
+	// CanvasPixelsMap is a map indexing the attribute names of CanvasPixels by
+	// view name.
+	CanvasPixelsMap = map[string][]string{
+		"default": {
+			"width",
+			"height",
+			"pixels",
+		},
+	}
+	// CanvasChunksMap is a map indexing the attribute names of CanvasChunks by
+	// view name.
+	CanvasChunksMap = map[string][]string{
+		"default": {
+			"version",
+			"chunk_size",
+			"chunks",
+		},
+	}
+	// CanvasRegionMap is a map indexing the attribute names of CanvasRegion by
+	// view name.
+	CanvasRegionMap = map[string][]string{
+		"default": {
+			"x",
+			"y",
+			"width",
+			"height",
+			"pixels",
+		},
+	}
+	// PixelMap is a map indexing the attribute names of Pixel by view name.
+	PixelMap = map[string][]string{
+		"default": {
+			"x",
+			"y",
+			"color",
+		},
+	}
+	// PixelInfoMap is a map indexing the attribute names of PixelInfo by view name.
+	PixelInfoMap = map[string][]string{
+		"default": {
+			"x",
+			"y",
+			"placements",
 		},
 	}
 )
@@ -48,6 +358,113 @@ func ValidateCanvas(result *Canvas) (err error) {
 	return
 }
 
+// ValidateCanvases runs the validations defined on the viewed result type
+// Canvases.
+func ValidateCanvases(result *Canvases) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateCanvasesView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateProtectedRegion runs the validations defined on the viewed result
+// type ProtectedRegion.
+func ValidateProtectedRegion(result *ProtectedRegion) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateProtectedRegionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateRollback runs the validations defined on the viewed result type
+// Rollback.
+func ValidateRollback(result *Rollback) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateRollbackView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateAuditLog runs the validations defined on the viewed result type
+// AuditLog.
+func ValidateAuditLog(result *AuditLog) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateAuditLogView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateCanvasPixels runs the validations defined on the viewed result type
+// CanvasPixels.
+func ValidateCanvasPixels(result *CanvasPixels) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateCanvasPixelsView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateCanvasChunks runs the validations defined on the viewed result type
+// CanvasChunks.
+func ValidateCanvasChunks(result *CanvasChunks) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateCanvasChunksView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateCanvasRegion runs the validations defined on the viewed result type
+// CanvasRegion.
+func ValidateCanvasRegion(result *CanvasRegion) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateCanvasRegionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidatePixel runs the validations defined on the viewed result type Pixel.
+func ValidatePixel(result *Pixel) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidatePixelView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidatePixelInfo runs the validations defined on the viewed result type
+// PixelInfo.
+func ValidatePixelInfo(result *PixelInfo) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidatePixelInfoView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
 // ValidateCanvasView runs the validations defined on CanvasView using the
 // "default" view.
 func ValidateCanvasView(result *CanvasView) (err error) {
@@ -60,5 +477,329 @@ func ValidateCanvasView(result *CanvasView) (err error) {
 	if result.Height == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("height", "result"))
 	}
+	if result.Palette == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("palette", "result"))
+	}
+	if result.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
+	}
+	if result.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "result"))
+	}
+	if result.ProtectedRegions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protected_regions", "result"))
+	}
+	for _, e := range result.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("result.palette[*]", e, "^#[0-9A-F]{6}$"))
+	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
+	}
+	if result.ArchivedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.archived_at", *result.ArchivedAt, goa.FormatDateTime))
+	}
+	for _, e := range result.ProtectedRegions {
+		if e != nil {
+			if err2 := ValidateProtectedRegionView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateProtectedRegionView runs the validations defined on
+// ProtectedRegionView using the "default" view.
+func ValidateProtectedRegionView(result *ProtectedRegionView) (err error) {
+	if result.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "result"))
+	}
+	if result.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "result"))
+	}
+	if result.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "result"))
+	}
+	if result.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "result"))
+	}
+	if result.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "result"))
+	}
+	if result.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "result"))
+	}
+	if result.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
+	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
+	}
+	if result.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.expires_at", *result.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCanvasesView runs the validations defined on CanvasesView using the
+// "default" view.
+func ValidateCanvasesView(result *CanvasesView) (err error) {
+	if result.Canvases == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("canvases", "result"))
+	}
+	for _, e := range result.Canvases {
+		if e != nil {
+			if err2 := ValidateCanvasView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRollbackView runs the validations defined on RollbackView using the
+// "default" view.
+func ValidateRollbackView(result *RollbackView) (err error) {
+	if result.Reverted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reverted", "result"))
+	}
+	return
+}
+
+// ValidateAuditLogView runs the validations defined on AuditLogView using the
+// "default" view.
+func ValidateAuditLogView(result *AuditLogView) (err error) {
+	if result.Entries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entries", "result"))
+	}
+	for _, e := range result.Entries {
+		if e != nil {
+			if err2 := ValidateAuditEntryView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAuditEntryView runs the validations defined on AuditEntryView.
+func ValidateAuditEntryView(result *AuditEntryView) (err error) {
+	if result.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "result"))
+	}
+	if result.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "result"))
+	}
+	if result.Details == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("details", "result"))
+	}
+	if result.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
+	}
+	if result.Action != nil {
+		if !(*result.Action == "rollback") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.action", *result.Action, []any{"rollback"}))
+		}
+	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCanvasPixelsView runs the validations defined on CanvasPixelsView
+// using the "default" view.
+func ValidateCanvasPixelsView(result *CanvasPixelsView) (err error) {
+	if result.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "result"))
+	}
+	if result.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "result"))
+	}
+	if result.Pixels == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pixels", "result"))
+	}
+	return
+}
+
+// ValidateCanvasChunksView runs the validations defined on CanvasChunksView
+// using the "default" view.
+func ValidateCanvasChunksView(result *CanvasChunksView) (err error) {
+	if result.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "result"))
+	}
+	if result.ChunkSize == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("chunk_size", "result"))
+	}
+	if result.Chunks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("chunks", "result"))
+	}
+	for _, e := range result.Chunks {
+		if e != nil {
+			if err2 := ValidateCanvasChunkView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCanvasChunkView runs the validations defined on CanvasChunkView.
+func ValidateCanvasChunkView(result *CanvasChunkView) (err error) {
+	if result.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "result"))
+	}
+	if result.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "result"))
+	}
+	if result.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "result"))
+	}
+	if result.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "result"))
+	}
+	if result.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "result"))
+	}
+	if result.Pixels == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pixels", "result"))
+	}
+	return
+}
+
+// ValidateCanvasRegionView runs the validations defined on CanvasRegionView
+// using the "default" view.
+func ValidateCanvasRegionView(result *CanvasRegionView) (err error) {
+	if result.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "result"))
+	}
+	if result.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "result"))
+	}
+	if result.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "result"))
+	}
+	if result.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "result"))
+	}
+	if result.Pixels == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pixels", "result"))
+	}
+	return
+}
+
+// ValidateSessionEventView runs the validations defined on SessionEventView.
+func ValidateSessionEventView(result *SessionEventView) (err error) {
+	if result.Canvas != nil {
+		if err2 := ValidateCanvasView(result.Canvas); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Pixel != nil {
+		if err2 := ValidatePixelEventView(result.Pixel); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Rejection != nil {
+		if err2 := ValidatePlacementRejectionView(result.Rejection); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePixelEventView runs the validations defined on PixelEventView.
+func ValidatePixelEventView(result *PixelEventView) (err error) {
+	if result.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "result"))
+	}
+	if result.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "result"))
+	}
+	if result.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "result"))
+	}
+	if result.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "result"))
+	}
+	if result.PlacedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_at", "result"))
+	}
+	if result.Seq == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("seq", "result"))
+	}
+	if result.PlacedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.placed_at", *result.PlacedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidatePlacementRejectionView runs the validations defined on
+// PlacementRejectionView.
+func ValidatePlacementRejectionView(result *PlacementRejectionView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "result"))
+	}
+	return
+}
+
+// ValidatePixelView runs the validations defined on PixelView using the
+// "default" view.
+func ValidatePixelView(result *PixelView) (err error) {
+	if result.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "result"))
+	}
+	if result.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "result"))
+	}
+	if result.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "result"))
+	}
+	return
+}
+
+// ValidatePixelInfoView runs the validations defined on PixelInfoView using
+// the "default" view.
+func ValidatePixelInfoView(result *PixelInfoView) (err error) {
+	if result.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "result"))
+	}
+	if result.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "result"))
+	}
+	if result.Placements == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placements", "result"))
+	}
+	for _, e := range result.Placements {
+		if e != nil {
+			if err2 := ValidatePixelHistoryEntryView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePixelHistoryEntryView runs the validations defined on
+// PixelHistoryEntryView.
+func ValidatePixelHistoryEntryView(result *PixelHistoryEntryView) (err error) {
+	if result.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "result"))
+	}
+	if result.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "result"))
+	}
+	if result.PlacedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_at", "result"))
+	}
+	if result.Seq == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("seq", "result"))
+	}
+	if result.PlacedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.placed_at", *result.PlacedAt, goa.FormatDateTime))
+	}
 	return
 }
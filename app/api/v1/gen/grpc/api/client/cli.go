@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api gRPC client CLI support package
 //
@@ -6,3 +6,435 @@
 // $ goa gen github.com/jace-ys/pikcel/api/v1 -o api/v1
 
 package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
+	apipb "github.com/jace-ys/pikcel/api/v1/gen/grpc/api/pb"
+)
+
+// BuildCanvasCreatePayload builds the payload for the api CanvasCreate
+// endpoint from CLI flags.
+func BuildCanvasCreatePayload(apiCanvasCreateMessage string, apiCanvasCreateToken string) (*api.CanvasCreatePayload, error) {
+	var err error
+	var message apipb.CanvasCreateRequest
+	{
+		if apiCanvasCreateMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasCreateMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"height\": 1237,\n      \"palette\": [\n         \"#a567d4\",\n         \"#9e76C0\",\n         \"#AfbBC5\"\n      ],\n      \"width\": 1514\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiCanvasCreateToken
+	}
+	v := &api.CanvasCreatePayload{
+		Width:  message.Width,
+		Height: message.Height,
+	}
+	if message.Palette != nil {
+		v.Palette = make([]string, len(message.Palette))
+		for i, val := range message.Palette {
+			v.Palette[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasListPayload builds the payload for the api CanvasList endpoint
+// from CLI flags.
+func BuildCanvasListPayload(apiCanvasListMessage string) (*api.CanvasListPayload, error) {
+	var err error
+	var message apipb.CanvasListRequest
+	{
+		if apiCanvasListMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasListMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"include_archived\": true\n   }'")
+			}
+		}
+	}
+	v := &api.CanvasListPayload{}
+	if message.IncludeArchived != nil {
+		v.IncludeArchived = *message.IncludeArchived
+	}
+	if message.IncludeArchived == nil {
+		v.IncludeArchived = false
+	}
+
+	return v, nil
+}
+
+// BuildCanvasGetPayload builds the payload for the api CanvasGet endpoint from
+// CLI flags.
+func BuildCanvasGetPayload(apiCanvasGetMessage string) (*api.CanvasGetPayload, error) {
+	var err error
+	var message apipb.CanvasGetRequest
+	{
+		if apiCanvasGetMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasGetMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Fugiat fuga ducimus ex ad voluptatem optio.\"\n   }'")
+			}
+		}
+	}
+	v := &api.CanvasGetPayload{
+		ID: message.Id,
+	}
+
+	return v, nil
+}
+
+// BuildCanvasArchivePayload builds the payload for the api CanvasArchive
+// endpoint from CLI flags.
+func BuildCanvasArchivePayload(apiCanvasArchiveMessage string, apiCanvasArchiveToken string) (*api.CanvasArchivePayload, error) {
+	var err error
+	var message apipb.CanvasArchiveRequest
+	{
+		if apiCanvasArchiveMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasArchiveMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Quo harum nostrum provident tenetur itaque.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiCanvasArchiveToken
+	}
+	v := &api.CanvasArchivePayload{
+		ID: message.Id,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasResizePayload builds the payload for the api CanvasResize
+// endpoint from CLI flags.
+func BuildCanvasResizePayload(apiCanvasResizeMessage string, apiCanvasResizeToken string) (*api.CanvasResizePayload, error) {
+	var err error
+	var message apipb.CanvasResizeRequest
+	{
+		if apiCanvasResizeMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasResizeMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"bottom\": 1552,\n      \"fill\": 137,\n      \"id\": \"Consequatur assumenda dolores.\",\n      \"left\": 1082,\n      \"right\": 1232,\n      \"top\": 725\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiCanvasResizeToken
+	}
+	v := &api.CanvasResizePayload{
+		ID: message.Id,
+	}
+	if message.Left != nil {
+		v.Left = *message.Left
+	}
+	if message.Top != nil {
+		v.Top = *message.Top
+	}
+	if message.Right != nil {
+		v.Right = *message.Right
+	}
+	if message.Bottom != nil {
+		v.Bottom = *message.Bottom
+	}
+	if message.Fill != nil {
+		v.Fill = *message.Fill
+	}
+	if message.Left == nil {
+		v.Left = 0
+	}
+	if message.Top == nil {
+		v.Top = 0
+	}
+	if message.Right == nil {
+		v.Right = 0
+	}
+	if message.Bottom == nil {
+		v.Bottom = 0
+	}
+	if message.Fill == nil {
+		v.Fill = 0
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildProtectedRegionCreatePayload builds the payload for the api
+// ProtectedRegionCreate endpoint from CLI flags.
+func BuildProtectedRegionCreatePayload(apiProtectedRegionCreateMessage string, apiProtectedRegionCreateToken string) (*api.ProtectedRegionCreatePayload, error) {
+	var err error
+	var message apipb.ProtectedRegionCreateRequest
+	{
+		if apiProtectedRegionCreateMessage != "" {
+			err = json.Unmarshal([]byte(apiProtectedRegionCreateMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"duration\": 958887467,\n      \"height\": 1522,\n      \"id\": \"Nulla accusantium.\",\n      \"reason\": \"mqu\",\n      \"width\": 1949,\n      \"x\": 929686738,\n      \"y\": 1753071713\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiProtectedRegionCreateToken
+	}
+	v := &api.ProtectedRegionCreatePayload{
+		X:        message.X,
+		Y:        message.Y,
+		Width:    message.Width,
+		Height:   message.Height,
+		Reason:   message.Reason,
+		Duration: message.Duration,
+		ID:       message.Id,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildProtectedRegionDeletePayload builds the payload for the api
+// ProtectedRegionDelete endpoint from CLI flags.
+func BuildProtectedRegionDeletePayload(apiProtectedRegionDeleteMessage string, apiProtectedRegionDeleteToken string) (*api.ProtectedRegionDeletePayload, error) {
+	var err error
+	var message apipb.ProtectedRegionDeleteRequest
+	{
+		if apiProtectedRegionDeleteMessage != "" {
+			err = json.Unmarshal([]byte(apiProtectedRegionDeleteMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Perspiciatis inventore accusamus ea neque et nam.\",\n      \"region_id\": \"Veritatis eum accusantium.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiProtectedRegionDeleteToken
+	}
+	v := &api.ProtectedRegionDeletePayload{
+		ID:       message.Id,
+		RegionID: message.RegionId,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasRollbackPayload builds the payload for the api CanvasRollback
+// endpoint from CLI flags.
+func BuildCanvasRollbackPayload(apiCanvasRollbackMessage string, apiCanvasRollbackToken string) (*api.CanvasRollbackPayload, error) {
+	var err error
+	var message apipb.CanvasRollbackRequest
+	{
+		if apiCanvasRollbackMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasRollbackMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"from\": \"2002-12-14T21:08:14Z\",\n      \"height\": 1423,\n      \"id\": \"Consequatur et ratione voluptas tenetur.\",\n      \"to\": \"2008-09-08T07:24:16Z\",\n      \"user_id\": \"Consequatur tempora et voluptatem culpa sint id.\",\n      \"width\": 4,\n      \"x\": 1684008707,\n      \"y\": 760536883\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiCanvasRollbackToken
+	}
+	v := &api.CanvasRollbackPayload{
+		ID:     message.Id,
+		UserID: message.UserId,
+		X:      message.X,
+		Y:      message.Y,
+		Width:  message.Width,
+		Height: message.Height,
+		From:   message.From,
+		To:     message.To,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAuditLogGetPayload builds the payload for the api AuditLogGet endpoint
+// from CLI flags.
+func BuildAuditLogGetPayload(apiAuditLogGetMessage string, apiAuditLogGetToken string) (*api.AuditLogGetPayload, error) {
+	var err error
+	var message apipb.AuditLogGetRequest
+	{
+		if apiAuditLogGetMessage != "" {
+			err = json.Unmarshal([]byte(apiAuditLogGetMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Ad ea minima et suscipit ipsam hic.\",\n      \"limit\": 210\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiAuditLogGetToken
+	}
+	v := &api.AuditLogGetPayload{
+		ID: message.Id,
+	}
+	if message.Limit != nil {
+		v.Limit = *message.Limit
+	}
+	if message.Limit == nil {
+		v.Limit = 50
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasPixelsGetPayload builds the payload for the api CanvasPixelsGet
+// endpoint from CLI flags.
+func BuildCanvasPixelsGetPayload(apiCanvasPixelsGetMessage string) (*api.CanvasPixelsGetPayload, error) {
+	var err error
+	var message apipb.CanvasPixelsGetRequest
+	{
+		if apiCanvasPixelsGetMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasPixelsGetMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Animi ea ipsa qui fugit.\"\n   }'")
+			}
+		}
+	}
+	v := &api.CanvasPixelsGetPayload{
+		ID: message.Id,
+	}
+
+	return v, nil
+}
+
+// BuildCanvasChunksGetPayload builds the payload for the api CanvasChunksGet
+// endpoint from CLI flags.
+func BuildCanvasChunksGetPayload(apiCanvasChunksGetMessage string) (*api.CanvasChunksGetPayload, error) {
+	var err error
+	var message apipb.CanvasChunksGetRequest
+	{
+		if apiCanvasChunksGetMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasChunksGetMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Quos quod aut repellendus ducimus omnis quos.\",\n      \"since\": 5583211389885068421\n   }'")
+			}
+		}
+	}
+	v := &api.CanvasChunksGetPayload{
+		ID: message.Id,
+	}
+	if message.Since != nil {
+		v.Since = *message.Since
+	}
+	if message.Since == nil {
+		v.Since = 0
+	}
+
+	return v, nil
+}
+
+// BuildCanvasRegionGetPayload builds the payload for the api CanvasRegionGet
+// endpoint from CLI flags.
+func BuildCanvasRegionGetPayload(apiCanvasRegionGetMessage string) (*api.CanvasRegionGetPayload, error) {
+	var err error
+	var message apipb.CanvasRegionGetRequest
+	{
+		if apiCanvasRegionGetMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasRegionGetMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"height\": 33,\n      \"id\": \"Dolore sint facere autem necessitatibus cupiditate accusamus.\",\n      \"width\": 182,\n      \"x\": 1437784298,\n      \"y\": 836809175\n   }'")
+			}
+		}
+	}
+	v := &api.CanvasRegionGetPayload{
+		X:      message.X,
+		Y:      message.Y,
+		Width:  message.Width,
+		Height: message.Height,
+		ID:     message.Id,
+	}
+
+	return v, nil
+}
+
+// BuildCanvasSubscribePayload builds the payload for the api CanvasSubscribe
+// endpoint from CLI flags.
+func BuildCanvasSubscribePayload(apiCanvasSubscribeMessage string) (*api.CanvasSubscribePayload, error) {
+	var err error
+	var message apipb.CanvasSubscribeRequest
+	{
+		if apiCanvasSubscribeMessage != "" {
+			err = json.Unmarshal([]byte(apiCanvasSubscribeMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Alias voluptas eos.\",\n      \"last_event_id\": \"Recusandae eaque esse hic.\",\n      \"since\": 8301777832450915402\n   }'")
+			}
+		}
+	}
+	v := &api.CanvasSubscribePayload{
+		ID:          message.Id,
+		Since:       message.Since,
+		LastEventID: message.LastEventId,
+	}
+
+	return v, nil
+}
+
+// BuildPixelPlacePayload builds the payload for the api PixelPlace endpoint
+// from CLI flags.
+func BuildPixelPlacePayload(apiPixelPlaceMessage string, apiPixelPlaceToken string) (*api.PixelPlacePayload, error) {
+	var err error
+	var message apipb.PixelPlaceRequest
+	{
+		if apiPixelPlaceMessage != "" {
+			err = json.Unmarshal([]byte(apiPixelPlaceMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"color\": 1,\n      \"id\": \"Voluptas veniam rerum nobis.\",\n      \"x\": 1374627332,\n      \"y\": 260654209\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = apiPixelPlaceToken
+	}
+	v := &api.PixelPlacePayload{
+		X:     message.X,
+		Y:     message.Y,
+		Color: message.Color,
+		ID:    message.Id,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPixelInfoGetPayload builds the payload for the api PixelInfoGet
+// endpoint from CLI flags.
+func BuildPixelInfoGetPayload(apiPixelInfoGetMessage string) (*api.PixelInfoGetPayload, error) {
+	var err error
+	var message apipb.PixelInfoGetRequest
+	{
+		if apiPixelInfoGetMessage != "" {
+			err = json.Unmarshal([]byte(apiPixelInfoGetMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Inventore dolore fuga non accusantium.\",\n      \"limit\": 57,\n      \"x\": 1135277294,\n      \"y\": 1483820484\n   }'")
+			}
+		}
+	}
+	v := &api.PixelInfoGetPayload{
+		X:  message.X,
+		Y:  message.Y,
+		ID: message.Id,
+	}
+	if message.Limit != nil {
+		v.Limit = *message.Limit
+	}
+	if message.Limit == nil {
+		v.Limit = 10
+	}
+
+	return v, nil
+}
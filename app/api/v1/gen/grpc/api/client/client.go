@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api gRPC client
 //
@@ -10,6 +10,7 @@ package client
 import (
 	"context"
 
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apipb "github.com/jace-ys/pikcel/api/v1/gen/grpc/api/pb"
 	goagrpc "goa.design/goa/v3/grpc"
 	goapb "goa.design/goa/v3/grpc/pb"
@@ -23,6 +24,12 @@ type Client struct {
 	opts    []grpc.CallOption
 }
 
+// CanvasSubscribeClientStream implements the api.CanvasSubscribeClientStream
+// interface.
+type CanvasSubscribeClientStream struct {
+	stream apipb.API_CanvasSubscribeClient
+}
+
 // NewClient instantiates gRPC client for all the api service servers.
 func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
 	return &Client{
@@ -31,12 +38,54 @@ func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
 	}
 }
 
+// CanvasCreate calls the "CanvasCreate" function in apipb.APIClient interface.
+func (c *Client) CanvasCreate() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasCreateFunc(c.grpccli, c.opts...),
+			EncodeCanvasCreateRequest,
+			DecodeCanvasCreateResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CanvasList calls the "CanvasList" function in apipb.APIClient interface.
+func (c *Client) CanvasList() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasListFunc(c.grpccli, c.opts...),
+			EncodeCanvasListRequest,
+			DecodeCanvasListResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
 // CanvasGet calls the "CanvasGet" function in apipb.APIClient interface.
 func (c *Client) CanvasGet() goa.Endpoint {
 	return func(ctx context.Context, v any) (any, error) {
 		inv := goagrpc.NewInvoker(
 			BuildCanvasGetFunc(c.grpccli, c.opts...),
-			nil,
+			EncodeCanvasGetRequest,
 			DecodeCanvasGetResponse)
 		res, err := inv.Invoke(ctx, v)
 		if err != nil {
@@ -51,3 +100,291 @@ func (c *Client) CanvasGet() goa.Endpoint {
 		return res, nil
 	}
 }
+
+// CanvasArchive calls the "CanvasArchive" function in apipb.APIClient
+// interface.
+func (c *Client) CanvasArchive() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasArchiveFunc(c.grpccli, c.opts...),
+			EncodeCanvasArchiveRequest,
+			DecodeCanvasArchiveResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CanvasResize calls the "CanvasResize" function in apipb.APIClient interface.
+func (c *Client) CanvasResize() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasResizeFunc(c.grpccli, c.opts...),
+			EncodeCanvasResizeRequest,
+			DecodeCanvasResizeResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ProtectedRegionCreate calls the "ProtectedRegionCreate" function in
+// apipb.APIClient interface.
+func (c *Client) ProtectedRegionCreate() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildProtectedRegionCreateFunc(c.grpccli, c.opts...),
+			EncodeProtectedRegionCreateRequest,
+			DecodeProtectedRegionCreateResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ProtectedRegionDelete calls the "ProtectedRegionDelete" function in
+// apipb.APIClient interface.
+func (c *Client) ProtectedRegionDelete() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildProtectedRegionDeleteFunc(c.grpccli, c.opts...),
+			EncodeProtectedRegionDeleteRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CanvasRollback calls the "CanvasRollback" function in apipb.APIClient
+// interface.
+func (c *Client) CanvasRollback() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasRollbackFunc(c.grpccli, c.opts...),
+			EncodeCanvasRollbackRequest,
+			DecodeCanvasRollbackResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// AuditLogGet calls the "AuditLogGet" function in apipb.APIClient interface.
+func (c *Client) AuditLogGet() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildAuditLogGetFunc(c.grpccli, c.opts...),
+			EncodeAuditLogGetRequest,
+			DecodeAuditLogGetResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CanvasPixelsGet calls the "CanvasPixelsGet" function in apipb.APIClient
+// interface.
+func (c *Client) CanvasPixelsGet() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasPixelsGetFunc(c.grpccli, c.opts...),
+			EncodeCanvasPixelsGetRequest,
+			DecodeCanvasPixelsGetResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CanvasChunksGet calls the "CanvasChunksGet" function in apipb.APIClient
+// interface.
+func (c *Client) CanvasChunksGet() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasChunksGetFunc(c.grpccli, c.opts...),
+			EncodeCanvasChunksGetRequest,
+			DecodeCanvasChunksGetResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CanvasRegionGet calls the "CanvasRegionGet" function in apipb.APIClient
+// interface.
+func (c *Client) CanvasRegionGet() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasRegionGetFunc(c.grpccli, c.opts...),
+			EncodeCanvasRegionGetRequest,
+			DecodeCanvasRegionGetResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CanvasSubscribe calls the "CanvasSubscribe" function in apipb.APIClient
+// interface.
+func (c *Client) CanvasSubscribe() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCanvasSubscribeFunc(c.grpccli, c.opts...),
+			EncodeCanvasSubscribeRequest,
+			DecodeCanvasSubscribeResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PixelPlace calls the "PixelPlace" function in apipb.APIClient interface.
+func (c *Client) PixelPlace() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPixelPlaceFunc(c.grpccli, c.opts...),
+			EncodePixelPlaceRequest,
+			DecodePixelPlaceResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *apipb.PixelPlaceCooldownActiveError:
+				return nil, NewPixelPlaceCooldownActiveError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PixelInfoGet calls the "PixelInfoGet" function in apipb.APIClient interface.
+func (c *Client) PixelInfoGet() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPixelInfoGetFunc(c.grpccli, c.opts...),
+			EncodePixelInfoGetRequest,
+			DecodePixelInfoGetResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Recv reads instances of "apipb.CanvasSubscribeResponse" from the
+// "CanvasSubscribe" endpoint gRPC stream.
+func (s *CanvasSubscribeClientStream) Recv() (*api.CanvasEvent, error) {
+	var res *api.CanvasEvent
+	v, err := s.stream.Recv()
+	if err != nil {
+		resp := goagrpc.DecodeError(err)
+		switch message := resp.(type) {
+		case *goapb.ErrorResponse:
+			return res, goagrpc.NewServiceError(message)
+		default:
+			return res, err
+		}
+	}
+	if err = ValidateCanvasSubscribeResponse(v); err != nil {
+		return res, err
+	}
+	return NewCanvasSubscribeResponseCanvasEvent(v), nil
+}
+
+// RecvWithContext reads instances of "apipb.CanvasSubscribeResponse" from the
+// "CanvasSubscribe" endpoint gRPC stream with context.
+func (s *CanvasSubscribeClientStream) RecvWithContext(ctx context.Context) (*api.CanvasEvent, error) {
+	return s.Recv()
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api gRPC client encoders and decoders
 //
@@ -18,6 +18,94 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// BuildCanvasCreateFunc builds the remote method to invoke for "api" service
+// "CanvasCreate" endpoint.
+func BuildCanvasCreateFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasCreate(ctx, reqpb.(*apipb.CanvasCreateRequest), opts...)
+		}
+		return grpccli.CanvasCreate(ctx, &apipb.CanvasCreateRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasCreateRequest encodes requests sent to api CanvasCreate endpoint.
+func EncodeCanvasCreateRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasCreatePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasCreate", "*api.CanvasCreatePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCanvasCreateRequest(payload), nil
+}
+
+// DecodeCanvasCreateResponse decodes responses from the api CanvasCreate
+// endpoint.
+func DecodeCanvasCreateResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasCreateResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasCreate", "*apipb.CanvasCreateResponse", v)
+	}
+	res := NewCanvasCreateResult(message)
+	vres := &apiviews.Canvas{Projected: res, View: view}
+	if err := apiviews.ValidateCanvas(vres); err != nil {
+		return nil, err
+	}
+	return api.NewCanvas(vres), nil
+}
+
+// BuildCanvasListFunc builds the remote method to invoke for "api" service
+// "CanvasList" endpoint.
+func BuildCanvasListFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasList(ctx, reqpb.(*apipb.CanvasListRequest), opts...)
+		}
+		return grpccli.CanvasList(ctx, &apipb.CanvasListRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasListRequest encodes requests sent to api CanvasList endpoint.
+func EncodeCanvasListRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasListPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasList", "*api.CanvasListPayload", v)
+	}
+	return NewProtoCanvasListRequest(payload), nil
+}
+
+// DecodeCanvasListResponse decodes responses from the api CanvasList endpoint.
+func DecodeCanvasListResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasListResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasList", "*apipb.CanvasListResponse", v)
+	}
+	res := NewCanvasListResult(message)
+	vres := &apiviews.Canvases{Projected: res, View: view}
+	if err := apiviews.ValidateCanvases(vres); err != nil {
+		return nil, err
+	}
+	return api.NewCanvases(vres), nil
+}
+
 // BuildCanvasGetFunc builds the remote method to invoke for "api" service
 // "CanvasGet" endpoint.
 func BuildCanvasGetFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
@@ -32,6 +120,15 @@ func BuildCanvasGetFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goa
 	}
 }
 
+// EncodeCanvasGetRequest encodes requests sent to api CanvasGet endpoint.
+func EncodeCanvasGetRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasGetPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasGet", "*api.CanvasGetPayload", v)
+	}
+	return NewProtoCanvasGetRequest(payload), nil
+}
+
 // DecodeCanvasGetResponse decodes responses from the api CanvasGet endpoint.
 func DecodeCanvasGetResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
 	var view string
@@ -51,3 +148,511 @@ func DecodeCanvasGetResponse(ctx context.Context, v any, hdr, trlr metadata.MD)
 	}
 	return api.NewCanvas(vres), nil
 }
+
+// BuildCanvasArchiveFunc builds the remote method to invoke for "api" service
+// "CanvasArchive" endpoint.
+func BuildCanvasArchiveFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasArchive(ctx, reqpb.(*apipb.CanvasArchiveRequest), opts...)
+		}
+		return grpccli.CanvasArchive(ctx, &apipb.CanvasArchiveRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasArchiveRequest encodes requests sent to api CanvasArchive
+// endpoint.
+func EncodeCanvasArchiveRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasArchivePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasArchive", "*api.CanvasArchivePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCanvasArchiveRequest(payload), nil
+}
+
+// DecodeCanvasArchiveResponse decodes responses from the api CanvasArchive
+// endpoint.
+func DecodeCanvasArchiveResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasArchiveResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasArchive", "*apipb.CanvasArchiveResponse", v)
+	}
+	res := NewCanvasArchiveResult(message)
+	vres := &apiviews.Canvas{Projected: res, View: view}
+	if err := apiviews.ValidateCanvas(vres); err != nil {
+		return nil, err
+	}
+	return api.NewCanvas(vres), nil
+}
+
+// BuildCanvasResizeFunc builds the remote method to invoke for "api" service
+// "CanvasResize" endpoint.
+func BuildCanvasResizeFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasResize(ctx, reqpb.(*apipb.CanvasResizeRequest), opts...)
+		}
+		return grpccli.CanvasResize(ctx, &apipb.CanvasResizeRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasResizeRequest encodes requests sent to api CanvasResize endpoint.
+func EncodeCanvasResizeRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasResizePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasResize", "*api.CanvasResizePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCanvasResizeRequest(payload), nil
+}
+
+// DecodeCanvasResizeResponse decodes responses from the api CanvasResize
+// endpoint.
+func DecodeCanvasResizeResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasResizeResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasResize", "*apipb.CanvasResizeResponse", v)
+	}
+	res := NewCanvasResizeResult(message)
+	vres := &apiviews.Canvas{Projected: res, View: view}
+	if err := apiviews.ValidateCanvas(vres); err != nil {
+		return nil, err
+	}
+	return api.NewCanvas(vres), nil
+}
+
+// BuildProtectedRegionCreateFunc builds the remote method to invoke for "api"
+// service "ProtectedRegionCreate" endpoint.
+func BuildProtectedRegionCreateFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ProtectedRegionCreate(ctx, reqpb.(*apipb.ProtectedRegionCreateRequest), opts...)
+		}
+		return grpccli.ProtectedRegionCreate(ctx, &apipb.ProtectedRegionCreateRequest{}, opts...)
+	}
+}
+
+// EncodeProtectedRegionCreateRequest encodes requests sent to api
+// ProtectedRegionCreate endpoint.
+func EncodeProtectedRegionCreateRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.ProtectedRegionCreatePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "ProtectedRegionCreate", "*api.ProtectedRegionCreatePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoProtectedRegionCreateRequest(payload), nil
+}
+
+// DecodeProtectedRegionCreateResponse decodes responses from the api
+// ProtectedRegionCreate endpoint.
+func DecodeProtectedRegionCreateResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.ProtectedRegionCreateResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "ProtectedRegionCreate", "*apipb.ProtectedRegionCreateResponse", v)
+	}
+	res := NewProtectedRegionCreateResult(message)
+	vres := &apiviews.ProtectedRegion{Projected: res, View: view}
+	if err := apiviews.ValidateProtectedRegion(vres); err != nil {
+		return nil, err
+	}
+	return api.NewProtectedRegion(vres), nil
+}
+
+// BuildProtectedRegionDeleteFunc builds the remote method to invoke for "api"
+// service "ProtectedRegionDelete" endpoint.
+func BuildProtectedRegionDeleteFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ProtectedRegionDelete(ctx, reqpb.(*apipb.ProtectedRegionDeleteRequest), opts...)
+		}
+		return grpccli.ProtectedRegionDelete(ctx, &apipb.ProtectedRegionDeleteRequest{}, opts...)
+	}
+}
+
+// EncodeProtectedRegionDeleteRequest encodes requests sent to api
+// ProtectedRegionDelete endpoint.
+func EncodeProtectedRegionDeleteRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.ProtectedRegionDeletePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "ProtectedRegionDelete", "*api.ProtectedRegionDeletePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoProtectedRegionDeleteRequest(payload), nil
+}
+
+// BuildCanvasRollbackFunc builds the remote method to invoke for "api" service
+// "CanvasRollback" endpoint.
+func BuildCanvasRollbackFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasRollback(ctx, reqpb.(*apipb.CanvasRollbackRequest), opts...)
+		}
+		return grpccli.CanvasRollback(ctx, &apipb.CanvasRollbackRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasRollbackRequest encodes requests sent to api CanvasRollback
+// endpoint.
+func EncodeCanvasRollbackRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasRollbackPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasRollback", "*api.CanvasRollbackPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCanvasRollbackRequest(payload), nil
+}
+
+// DecodeCanvasRollbackResponse decodes responses from the api CanvasRollback
+// endpoint.
+func DecodeCanvasRollbackResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasRollbackResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasRollback", "*apipb.CanvasRollbackResponse", v)
+	}
+	res := NewCanvasRollbackResult(message)
+	vres := &apiviews.Rollback{Projected: res, View: view}
+	if err := apiviews.ValidateRollback(vres); err != nil {
+		return nil, err
+	}
+	return api.NewRollback(vres), nil
+}
+
+// BuildAuditLogGetFunc builds the remote method to invoke for "api" service
+// "AuditLogGet" endpoint.
+func BuildAuditLogGetFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.AuditLogGet(ctx, reqpb.(*apipb.AuditLogGetRequest), opts...)
+		}
+		return grpccli.AuditLogGet(ctx, &apipb.AuditLogGetRequest{}, opts...)
+	}
+}
+
+// EncodeAuditLogGetRequest encodes requests sent to api AuditLogGet endpoint.
+func EncodeAuditLogGetRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.AuditLogGetPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "AuditLogGet", "*api.AuditLogGetPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoAuditLogGetRequest(payload), nil
+}
+
+// DecodeAuditLogGetResponse decodes responses from the api AuditLogGet
+// endpoint.
+func DecodeAuditLogGetResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.AuditLogGetResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "AuditLogGet", "*apipb.AuditLogGetResponse", v)
+	}
+	res := NewAuditLogGetResult(message)
+	vres := &apiviews.AuditLog{Projected: res, View: view}
+	if err := apiviews.ValidateAuditLog(vres); err != nil {
+		return nil, err
+	}
+	return api.NewAuditLog(vres), nil
+}
+
+// BuildCanvasPixelsGetFunc builds the remote method to invoke for "api"
+// service "CanvasPixelsGet" endpoint.
+func BuildCanvasPixelsGetFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasPixelsGet(ctx, reqpb.(*apipb.CanvasPixelsGetRequest), opts...)
+		}
+		return grpccli.CanvasPixelsGet(ctx, &apipb.CanvasPixelsGetRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasPixelsGetRequest encodes requests sent to api CanvasPixelsGet
+// endpoint.
+func EncodeCanvasPixelsGetRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasPixelsGetPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasPixelsGet", "*api.CanvasPixelsGetPayload", v)
+	}
+	return NewProtoCanvasPixelsGetRequest(payload), nil
+}
+
+// DecodeCanvasPixelsGetResponse decodes responses from the api CanvasPixelsGet
+// endpoint.
+func DecodeCanvasPixelsGetResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasPixelsGetResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasPixelsGet", "*apipb.CanvasPixelsGetResponse", v)
+	}
+	res := NewCanvasPixelsGetResult(message)
+	vres := &apiviews.CanvasPixels{Projected: res, View: view}
+	if err := apiviews.ValidateCanvasPixels(vres); err != nil {
+		return nil, err
+	}
+	return api.NewCanvasPixels(vres), nil
+}
+
+// BuildCanvasChunksGetFunc builds the remote method to invoke for "api"
+// service "CanvasChunksGet" endpoint.
+func BuildCanvasChunksGetFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasChunksGet(ctx, reqpb.(*apipb.CanvasChunksGetRequest), opts...)
+		}
+		return grpccli.CanvasChunksGet(ctx, &apipb.CanvasChunksGetRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasChunksGetRequest encodes requests sent to api CanvasChunksGet
+// endpoint.
+func EncodeCanvasChunksGetRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasChunksGetPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasChunksGet", "*api.CanvasChunksGetPayload", v)
+	}
+	return NewProtoCanvasChunksGetRequest(payload), nil
+}
+
+// DecodeCanvasChunksGetResponse decodes responses from the api CanvasChunksGet
+// endpoint.
+func DecodeCanvasChunksGetResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasChunksGetResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasChunksGet", "*apipb.CanvasChunksGetResponse", v)
+	}
+	res := NewCanvasChunksGetResult(message)
+	vres := &apiviews.CanvasChunks{Projected: res, View: view}
+	if err := apiviews.ValidateCanvasChunks(vres); err != nil {
+		return nil, err
+	}
+	return api.NewCanvasChunks(vres), nil
+}
+
+// BuildCanvasRegionGetFunc builds the remote method to invoke for "api"
+// service "CanvasRegionGet" endpoint.
+func BuildCanvasRegionGetFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasRegionGet(ctx, reqpb.(*apipb.CanvasRegionGetRequest), opts...)
+		}
+		return grpccli.CanvasRegionGet(ctx, &apipb.CanvasRegionGetRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasRegionGetRequest encodes requests sent to api CanvasRegionGet
+// endpoint.
+func EncodeCanvasRegionGetRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasRegionGetPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasRegionGet", "*api.CanvasRegionGetPayload", v)
+	}
+	return NewProtoCanvasRegionGetRequest(payload), nil
+}
+
+// DecodeCanvasRegionGetResponse decodes responses from the api CanvasRegionGet
+// endpoint.
+func DecodeCanvasRegionGetResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.CanvasRegionGetResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasRegionGet", "*apipb.CanvasRegionGetResponse", v)
+	}
+	res := NewCanvasRegionGetResult(message)
+	vres := &apiviews.CanvasRegion{Projected: res, View: view}
+	if err := apiviews.ValidateCanvasRegion(vres); err != nil {
+		return nil, err
+	}
+	return api.NewCanvasRegion(vres), nil
+}
+
+// BuildCanvasSubscribeFunc builds the remote method to invoke for "api"
+// service "CanvasSubscribe" endpoint.
+func BuildCanvasSubscribeFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CanvasSubscribe(ctx, reqpb.(*apipb.CanvasSubscribeRequest), opts...)
+		}
+		return grpccli.CanvasSubscribe(ctx, &apipb.CanvasSubscribeRequest{}, opts...)
+	}
+}
+
+// EncodeCanvasSubscribeRequest encodes requests sent to api CanvasSubscribe
+// endpoint.
+func EncodeCanvasSubscribeRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.CanvasSubscribePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasSubscribe", "*api.CanvasSubscribePayload", v)
+	}
+	return NewProtoCanvasSubscribeRequest(payload), nil
+}
+
+// DecodeCanvasSubscribeResponse decodes responses from the api CanvasSubscribe
+// endpoint.
+func DecodeCanvasSubscribeResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &CanvasSubscribeClientStream{
+		stream: v.(apipb.API_CanvasSubscribeClient),
+	}, nil
+}
+
+// BuildPixelPlaceFunc builds the remote method to invoke for "api" service
+// "PixelPlace" endpoint.
+func BuildPixelPlaceFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PixelPlace(ctx, reqpb.(*apipb.PixelPlaceRequest), opts...)
+		}
+		return grpccli.PixelPlace(ctx, &apipb.PixelPlaceRequest{}, opts...)
+	}
+}
+
+// EncodePixelPlaceRequest encodes requests sent to api PixelPlace endpoint.
+func EncodePixelPlaceRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.PixelPlacePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "PixelPlace", "*api.PixelPlacePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoPixelPlaceRequest(payload), nil
+}
+
+// DecodePixelPlaceResponse decodes responses from the api PixelPlace endpoint.
+func DecodePixelPlaceResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.PixelPlaceResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "PixelPlace", "*apipb.PixelPlaceResponse", v)
+	}
+	res := NewPixelPlaceResult(message)
+	vres := &apiviews.Pixel{Projected: res, View: view}
+	if err := apiviews.ValidatePixel(vres); err != nil {
+		return nil, err
+	}
+	return api.NewPixel(vres), nil
+}
+
+// BuildPixelInfoGetFunc builds the remote method to invoke for "api" service
+// "PixelInfoGet" endpoint.
+func BuildPixelInfoGetFunc(grpccli apipb.APIClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PixelInfoGet(ctx, reqpb.(*apipb.PixelInfoGetRequest), opts...)
+		}
+		return grpccli.PixelInfoGet(ctx, &apipb.PixelInfoGetRequest{}, opts...)
+	}
+}
+
+// EncodePixelInfoGetRequest encodes requests sent to api PixelInfoGet endpoint.
+func EncodePixelInfoGetRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*api.PixelInfoGetPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "PixelInfoGet", "*api.PixelInfoGetPayload", v)
+	}
+	return NewProtoPixelInfoGetRequest(payload), nil
+}
+
+// DecodePixelInfoGetResponse decodes responses from the api PixelInfoGet
+// endpoint.
+func DecodePixelInfoGetResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*apipb.PixelInfoGetResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "PixelInfoGet", "*apipb.PixelInfoGetResponse", v)
+	}
+	res := NewPixelInfoGetResult(message)
+	vres := &apiviews.PixelInfo{Projected: res, View: view}
+	if err := apiviews.ValidatePixelInfo(vres); err != nil {
+		return nil, err
+	}
+	return api.NewPixelInfo(vres), nil
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api gRPC client types
 //
@@ -8,14 +8,119 @@
 package client
 
 import (
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
 	apipb "github.com/jace-ys/pikcel/api/v1/gen/grpc/api/pb"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// NewProtoCanvasCreateRequest builds the gRPC request type from the payload of
+// the "CanvasCreate" endpoint of the "api" service.
+func NewProtoCanvasCreateRequest(payload *api.CanvasCreatePayload) *apipb.CanvasCreateRequest {
+	message := &apipb.CanvasCreateRequest{
+		Width:  payload.Width,
+		Height: payload.Height,
+	}
+	if payload.Palette != nil {
+		message.Palette = make([]string, len(payload.Palette))
+		for i, val := range payload.Palette {
+			message.Palette[i] = val
+		}
+	}
+	return message
+}
+
+// NewCanvasCreateResult builds the result type of the "CanvasCreate" endpoint
+// of the "api" service from the gRPC response type.
+func NewCanvasCreateResult(message *apipb.CanvasCreateResponse) *apiviews.CanvasView {
+	result := &apiviews.CanvasView{
+		ID:         &message.Id,
+		Width:      &message.Width,
+		Height:     &message.Height,
+		CreatedAt:  &message.CreatedAt,
+		ArchivedAt: message.ArchivedAt,
+		Version:    &message.Version,
+	}
+	if message.Palette != nil {
+		result.Palette = make([]string, len(message.Palette))
+		for i, val := range message.Palette {
+			result.Palette[i] = val
+		}
+	}
+	if message.ProtectedRegions != nil {
+		result.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(message.ProtectedRegions))
+		for i, val := range message.ProtectedRegions {
+			result.ProtectedRegions[i] = &apiviews.ProtectedRegionView{
+				ID:        &val.Id,
+				X:         &val.X,
+				Y:         &val.Y,
+				Width:     &val.Width,
+				Height:    &val.Height,
+				Reason:    &val.Reason,
+				CreatedAt: &val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoCanvasListRequest builds the gRPC request type from the payload of
+// the "CanvasList" endpoint of the "api" service.
+func NewProtoCanvasListRequest(payload *api.CanvasListPayload) *apipb.CanvasListRequest {
+	message := &apipb.CanvasListRequest{
+		IncludeArchived: &payload.IncludeArchived,
+	}
+	return message
+}
+
+// NewCanvasListResult builds the result type of the "CanvasList" endpoint of
+// the "api" service from the gRPC response type.
+func NewCanvasListResult(message *apipb.CanvasListResponse) *apiviews.CanvasesView {
+	result := &apiviews.CanvasesView{}
+	if message.Canvases != nil {
+		result.Canvases = make([]*apiviews.CanvasView, len(message.Canvases))
+		for i, val := range message.Canvases {
+			result.Canvases[i] = &apiviews.CanvasView{
+				ID:         &val.Id,
+				Width:      &val.Width,
+				Height:     &val.Height,
+				CreatedAt:  &val.CreatedAt,
+				ArchivedAt: val.ArchivedAt,
+				Version:    &val.Version,
+			}
+			if val.Palette != nil {
+				result.Canvases[i].Palette = make([]string, len(val.Palette))
+				for j, val := range val.Palette {
+					result.Canvases[i].Palette[j] = val
+				}
+			}
+			if val.ProtectedRegions != nil {
+				result.Canvases[i].ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(val.ProtectedRegions))
+				for j, val := range val.ProtectedRegions {
+					result.Canvases[i].ProtectedRegions[j] = &apiviews.ProtectedRegionView{
+						ID:        &val.Id,
+						X:         &val.X,
+						Y:         &val.Y,
+						Width:     &val.Width,
+						Height:    &val.Height,
+						Reason:    &val.Reason,
+						CreatedAt: &val.CreatedAt,
+						ExpiresAt: val.ExpiresAt,
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
 // NewProtoCanvasGetRequest builds the gRPC request type from the payload of
 // the "CanvasGet" endpoint of the "api" service.
-func NewProtoCanvasGetRequest() *apipb.CanvasGetRequest {
-	message := &apipb.CanvasGetRequest{}
+func NewProtoCanvasGetRequest(payload *api.CanvasGetPayload) *apipb.CanvasGetRequest {
+	message := &apipb.CanvasGetRequest{
+		Id: payload.ID,
+	}
 	return message
 }
 
@@ -23,9 +128,698 @@ func NewProtoCanvasGetRequest() *apipb.CanvasGetRequest {
 // "api" service from the gRPC response type.
 func NewCanvasGetResult(message *apipb.CanvasGetResponse) *apiviews.CanvasView {
 	result := &apiviews.CanvasView{
-		ID:     &message.Id,
+		ID:         &message.Id,
+		Width:      &message.Width,
+		Height:     &message.Height,
+		CreatedAt:  &message.CreatedAt,
+		ArchivedAt: message.ArchivedAt,
+		Version:    &message.Version,
+	}
+	if message.Palette != nil {
+		result.Palette = make([]string, len(message.Palette))
+		for i, val := range message.Palette {
+			result.Palette[i] = val
+		}
+	}
+	if message.ProtectedRegions != nil {
+		result.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(message.ProtectedRegions))
+		for i, val := range message.ProtectedRegions {
+			result.ProtectedRegions[i] = &apiviews.ProtectedRegionView{
+				ID:        &val.Id,
+				X:         &val.X,
+				Y:         &val.Y,
+				Width:     &val.Width,
+				Height:    &val.Height,
+				Reason:    &val.Reason,
+				CreatedAt: &val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoCanvasArchiveRequest builds the gRPC request type from the payload
+// of the "CanvasArchive" endpoint of the "api" service.
+func NewProtoCanvasArchiveRequest(payload *api.CanvasArchivePayload) *apipb.CanvasArchiveRequest {
+	message := &apipb.CanvasArchiveRequest{
+		Id: payload.ID,
+	}
+	return message
+}
+
+// NewCanvasArchiveResult builds the result type of the "CanvasArchive"
+// endpoint of the "api" service from the gRPC response type.
+func NewCanvasArchiveResult(message *apipb.CanvasArchiveResponse) *apiviews.CanvasView {
+	result := &apiviews.CanvasView{
+		ID:         &message.Id,
+		Width:      &message.Width,
+		Height:     &message.Height,
+		CreatedAt:  &message.CreatedAt,
+		ArchivedAt: message.ArchivedAt,
+		Version:    &message.Version,
+	}
+	if message.Palette != nil {
+		result.Palette = make([]string, len(message.Palette))
+		for i, val := range message.Palette {
+			result.Palette[i] = val
+		}
+	}
+	if message.ProtectedRegions != nil {
+		result.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(message.ProtectedRegions))
+		for i, val := range message.ProtectedRegions {
+			result.ProtectedRegions[i] = &apiviews.ProtectedRegionView{
+				ID:        &val.Id,
+				X:         &val.X,
+				Y:         &val.Y,
+				Width:     &val.Width,
+				Height:    &val.Height,
+				Reason:    &val.Reason,
+				CreatedAt: &val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoCanvasResizeRequest builds the gRPC request type from the payload of
+// the "CanvasResize" endpoint of the "api" service.
+func NewProtoCanvasResizeRequest(payload *api.CanvasResizePayload) *apipb.CanvasResizeRequest {
+	message := &apipb.CanvasResizeRequest{
+		Id:     payload.ID,
+		Left:   &payload.Left,
+		Top:    &payload.Top,
+		Right:  &payload.Right,
+		Bottom: &payload.Bottom,
+		Fill:   &payload.Fill,
+	}
+	return message
+}
+
+// NewCanvasResizeResult builds the result type of the "CanvasResize" endpoint
+// of the "api" service from the gRPC response type.
+func NewCanvasResizeResult(message *apipb.CanvasResizeResponse) *apiviews.CanvasView {
+	result := &apiviews.CanvasView{
+		ID:         &message.Id,
+		Width:      &message.Width,
+		Height:     &message.Height,
+		CreatedAt:  &message.CreatedAt,
+		ArchivedAt: message.ArchivedAt,
+		Version:    &message.Version,
+	}
+	if message.Palette != nil {
+		result.Palette = make([]string, len(message.Palette))
+		for i, val := range message.Palette {
+			result.Palette[i] = val
+		}
+	}
+	if message.ProtectedRegions != nil {
+		result.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(message.ProtectedRegions))
+		for i, val := range message.ProtectedRegions {
+			result.ProtectedRegions[i] = &apiviews.ProtectedRegionView{
+				ID:        &val.Id,
+				X:         &val.X,
+				Y:         &val.Y,
+				Width:     &val.Width,
+				Height:    &val.Height,
+				Reason:    &val.Reason,
+				CreatedAt: &val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoProtectedRegionCreateRequest builds the gRPC request type from the
+// payload of the "ProtectedRegionCreate" endpoint of the "api" service.
+func NewProtoProtectedRegionCreateRequest(payload *api.ProtectedRegionCreatePayload) *apipb.ProtectedRegionCreateRequest {
+	message := &apipb.ProtectedRegionCreateRequest{
+		X:        payload.X,
+		Y:        payload.Y,
+		Width:    payload.Width,
+		Height:   payload.Height,
+		Reason:   payload.Reason,
+		Duration: payload.Duration,
+		Id:       payload.ID,
+	}
+	return message
+}
+
+// NewProtectedRegionCreateResult builds the result type of the
+// "ProtectedRegionCreate" endpoint of the "api" service from the gRPC response
+// type.
+func NewProtectedRegionCreateResult(message *apipb.ProtectedRegionCreateResponse) *apiviews.ProtectedRegionView {
+	result := &apiviews.ProtectedRegionView{
+		ID:        &message.Id,
+		X:         &message.X,
+		Y:         &message.Y,
+		Width:     &message.Width,
+		Height:    &message.Height,
+		Reason:    &message.Reason,
+		CreatedAt: &message.CreatedAt,
+		ExpiresAt: message.ExpiresAt,
+	}
+	return result
+}
+
+// NewProtoProtectedRegionDeleteRequest builds the gRPC request type from the
+// payload of the "ProtectedRegionDelete" endpoint of the "api" service.
+func NewProtoProtectedRegionDeleteRequest(payload *api.ProtectedRegionDeletePayload) *apipb.ProtectedRegionDeleteRequest {
+	message := &apipb.ProtectedRegionDeleteRequest{
+		Id:       payload.ID,
+		RegionId: payload.RegionID,
+	}
+	return message
+}
+
+// NewProtoCanvasRollbackRequest builds the gRPC request type from the payload
+// of the "CanvasRollback" endpoint of the "api" service.
+func NewProtoCanvasRollbackRequest(payload *api.CanvasRollbackPayload) *apipb.CanvasRollbackRequest {
+	message := &apipb.CanvasRollbackRequest{
+		Id:     payload.ID,
+		UserId: payload.UserID,
+		X:      payload.X,
+		Y:      payload.Y,
+		Width:  payload.Width,
+		Height: payload.Height,
+		From:   payload.From,
+		To:     payload.To,
+	}
+	return message
+}
+
+// NewCanvasRollbackResult builds the result type of the "CanvasRollback"
+// endpoint of the "api" service from the gRPC response type.
+func NewCanvasRollbackResult(message *apipb.CanvasRollbackResponse) *apiviews.RollbackView {
+	result := &apiviews.RollbackView{
+		Reverted: &message.Reverted,
+	}
+	return result
+}
+
+// NewProtoAuditLogGetRequest builds the gRPC request type from the payload of
+// the "AuditLogGet" endpoint of the "api" service.
+func NewProtoAuditLogGetRequest(payload *api.AuditLogGetPayload) *apipb.AuditLogGetRequest {
+	message := &apipb.AuditLogGetRequest{
+		Id:    payload.ID,
+		Limit: &payload.Limit,
+	}
+	return message
+}
+
+// NewAuditLogGetResult builds the result type of the "AuditLogGet" endpoint of
+// the "api" service from the gRPC response type.
+func NewAuditLogGetResult(message *apipb.AuditLogGetResponse) *apiviews.AuditLogView {
+	result := &apiviews.AuditLogView{}
+	if message.Entries != nil {
+		result.Entries = make([]*apiviews.AuditEntryView, len(message.Entries))
+		for i, val := range message.Entries {
+			result.Entries[i] = &apiviews.AuditEntryView{
+				Action:    &val.Action,
+				UserID:    &val.UserId,
+				Details:   &val.Details,
+				CreatedAt: &val.CreatedAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoCanvasPixelsGetRequest builds the gRPC request type from the payload
+// of the "CanvasPixelsGet" endpoint of the "api" service.
+func NewProtoCanvasPixelsGetRequest(payload *api.CanvasPixelsGetPayload) *apipb.CanvasPixelsGetRequest {
+	message := &apipb.CanvasPixelsGetRequest{
+		Id: payload.ID,
+	}
+	return message
+}
+
+// NewCanvasPixelsGetResult builds the result type of the "CanvasPixelsGet"
+// endpoint of the "api" service from the gRPC response type.
+func NewCanvasPixelsGetResult(message *apipb.CanvasPixelsGetResponse) *apiviews.CanvasPixelsView {
+	result := &apiviews.CanvasPixelsView{
+		Width:  &message.Width,
+		Height: &message.Height,
+		Pixels: message.Pixels,
+	}
+	return result
+}
+
+// NewProtoCanvasChunksGetRequest builds the gRPC request type from the payload
+// of the "CanvasChunksGet" endpoint of the "api" service.
+func NewProtoCanvasChunksGetRequest(payload *api.CanvasChunksGetPayload) *apipb.CanvasChunksGetRequest {
+	message := &apipb.CanvasChunksGetRequest{
+		Id:    payload.ID,
+		Since: &payload.Since,
+	}
+	return message
+}
+
+// NewCanvasChunksGetResult builds the result type of the "CanvasChunksGet"
+// endpoint of the "api" service from the gRPC response type.
+func NewCanvasChunksGetResult(message *apipb.CanvasChunksGetResponse) *apiviews.CanvasChunksView {
+	result := &apiviews.CanvasChunksView{
+		Version:   &message.Version,
+		ChunkSize: &message.ChunkSize,
+	}
+	if message.Chunks != nil {
+		result.Chunks = make([]*apiviews.CanvasChunkView, len(message.Chunks))
+		for i, val := range message.Chunks {
+			result.Chunks[i] = &apiviews.CanvasChunkView{
+				X:       &val.X,
+				Y:       &val.Y,
+				Width:   &val.Width,
+				Height:  &val.Height,
+				Version: &val.Version,
+				Pixels:  val.Pixels,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoCanvasRegionGetRequest builds the gRPC request type from the payload
+// of the "CanvasRegionGet" endpoint of the "api" service.
+func NewProtoCanvasRegionGetRequest(payload *api.CanvasRegionGetPayload) *apipb.CanvasRegionGetRequest {
+	message := &apipb.CanvasRegionGetRequest{
+		X:      payload.X,
+		Y:      payload.Y,
+		Width:  payload.Width,
+		Height: payload.Height,
+		Id:     payload.ID,
+	}
+	return message
+}
+
+// NewCanvasRegionGetResult builds the result type of the "CanvasRegionGet"
+// endpoint of the "api" service from the gRPC response type.
+func NewCanvasRegionGetResult(message *apipb.CanvasRegionGetResponse) *apiviews.CanvasRegionView {
+	result := &apiviews.CanvasRegionView{
+		X:      &message.X,
+		Y:      &message.Y,
 		Width:  &message.Width,
 		Height: &message.Height,
+		Pixels: message.Pixels,
 	}
 	return result
 }
+
+// NewProtoCanvasSubscribeRequest builds the gRPC request type from the payload
+// of the "CanvasSubscribe" endpoint of the "api" service.
+func NewProtoCanvasSubscribeRequest(payload *api.CanvasSubscribePayload) *apipb.CanvasSubscribeRequest {
+	message := &apipb.CanvasSubscribeRequest{
+		Since:       payload.Since,
+		LastEventId: payload.LastEventID,
+		Id:          payload.ID,
+	}
+	return message
+}
+
+func NewCanvasSubscribeResponseCanvasEvent(v *apipb.CanvasSubscribeResponse) *api.CanvasEvent {
+	result := &api.CanvasEvent{
+		ID:   v.Id,
+		Type: v.Type,
+	}
+	if v.Pixel != nil {
+		result.Pixel = protobufApipbPixelEventToAPIPixelEvent(v.Pixel)
+	}
+	if v.Snapshot != nil {
+		result.Snapshot = protobufApipbCanvasSnapshotToAPICanvasSnapshot(v.Snapshot)
+	}
+	return result
+}
+
+// NewProtoPixelPlaceRequest builds the gRPC request type from the payload of
+// the "PixelPlace" endpoint of the "api" service.
+func NewProtoPixelPlaceRequest(payload *api.PixelPlacePayload) *apipb.PixelPlaceRequest {
+	message := &apipb.PixelPlaceRequest{
+		X:     payload.X,
+		Y:     payload.Y,
+		Color: payload.Color,
+		Id:    payload.ID,
+	}
+	return message
+}
+
+// NewPixelPlaceResult builds the result type of the "PixelPlace" endpoint of
+// the "api" service from the gRPC response type.
+func NewPixelPlaceResult(message *apipb.PixelPlaceResponse) *apiviews.PixelView {
+	result := &apiviews.PixelView{
+		X:     &message.X,
+		Y:     &message.Y,
+		Color: &message.Color,
+	}
+	return result
+}
+
+// NewPixelPlaceCooldownActiveError builds the error type of the "PixelPlace"
+// endpoint of the "api" service from the gRPC error response type.
+func NewPixelPlaceCooldownActiveError(message *apipb.PixelPlaceCooldownActiveError) *api.CooldownError {
+	er := &api.CooldownError{
+		Message:    message.Message_,
+		RetryAfter: message.RetryAfter,
+	}
+	return er
+}
+
+// NewProtoPixelInfoGetRequest builds the gRPC request type from the payload of
+// the "PixelInfoGet" endpoint of the "api" service.
+func NewProtoPixelInfoGetRequest(payload *api.PixelInfoGetPayload) *apipb.PixelInfoGetRequest {
+	message := &apipb.PixelInfoGetRequest{
+		X:     payload.X,
+		Y:     payload.Y,
+		Limit: &payload.Limit,
+		Id:    payload.ID,
+	}
+	return message
+}
+
+// NewPixelInfoGetResult builds the result type of the "PixelInfoGet" endpoint
+// of the "api" service from the gRPC response type.
+func NewPixelInfoGetResult(message *apipb.PixelInfoGetResponse) *apiviews.PixelInfoView {
+	result := &apiviews.PixelInfoView{
+		X: &message.X,
+		Y: &message.Y,
+	}
+	if message.Placements != nil {
+		result.Placements = make([]*apiviews.PixelHistoryEntryView, len(message.Placements))
+		for i, val := range message.Placements {
+			result.Placements[i] = &apiviews.PixelHistoryEntryView{
+				UserID:   &val.UserId,
+				Color:    &val.Color,
+				PlacedAt: &val.PlacedAt,
+				Seq:      &val.Seq,
+			}
+		}
+	}
+	return result
+}
+
+// ValidateCanvasCreateResponse runs the validations defined on
+// CanvasCreateResponse.
+func ValidateCanvasCreateResponse(message *apipb.CanvasCreateResponse) (err error) {
+	if message.Palette == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("palette", "message"))
+	}
+	if message.ProtectedRegions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protected_regions", "message"))
+	}
+	for _, e := range message.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("message.palette[*]", e, "^#[0-9A-F]{6}$"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if message.ArchivedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.archived_at", *message.ArchivedAt, goa.FormatDateTime))
+	}
+	for _, e := range message.ProtectedRegions {
+		if e != nil {
+			if err2 := ValidateProtectedRegion(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateProtectedRegion runs the validations defined on ProtectedRegion.
+func ValidateProtectedRegion(elem *apipb.ProtectedRegion) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	if elem.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("elem.expires_at", *elem.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCanvasListResponse runs the validations defined on
+// CanvasListResponse.
+func ValidateCanvasListResponse(message *apipb.CanvasListResponse) (err error) {
+	if message.Canvases == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("canvases", "message"))
+	}
+	for _, e := range message.Canvases {
+		if e != nil {
+			if err2 := ValidateCanvas(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCanvas runs the validations defined on Canvas.
+func ValidateCanvas(elem *apipb.Canvas) (err error) {
+	if elem.Palette == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("palette", "elem"))
+	}
+	if elem.ProtectedRegions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protected_regions", "elem"))
+	}
+	for _, e := range elem.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("elem.palette[*]", e, "^#[0-9A-F]{6}$"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	if elem.ArchivedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("elem.archived_at", *elem.ArchivedAt, goa.FormatDateTime))
+	}
+	for _, e := range elem.ProtectedRegions {
+		if e != nil {
+			if err2 := ValidateProtectedRegion(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCanvasGetResponse runs the validations defined on CanvasGetResponse.
+func ValidateCanvasGetResponse(message *apipb.CanvasGetResponse) (err error) {
+	if message.Palette == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("palette", "message"))
+	}
+	if message.ProtectedRegions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protected_regions", "message"))
+	}
+	for _, e := range message.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("message.palette[*]", e, "^#[0-9A-F]{6}$"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if message.ArchivedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.archived_at", *message.ArchivedAt, goa.FormatDateTime))
+	}
+	for _, e := range message.ProtectedRegions {
+		if e != nil {
+			if err2 := ValidateProtectedRegion(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCanvasArchiveResponse runs the validations defined on
+// CanvasArchiveResponse.
+func ValidateCanvasArchiveResponse(message *apipb.CanvasArchiveResponse) (err error) {
+	if message.Palette == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("palette", "message"))
+	}
+	if message.ProtectedRegions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protected_regions", "message"))
+	}
+	for _, e := range message.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("message.palette[*]", e, "^#[0-9A-F]{6}$"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if message.ArchivedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.archived_at", *message.ArchivedAt, goa.FormatDateTime))
+	}
+	for _, e := range message.ProtectedRegions {
+		if e != nil {
+			if err2 := ValidateProtectedRegion(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCanvasResizeResponse runs the validations defined on
+// CanvasResizeResponse.
+func ValidateCanvasResizeResponse(message *apipb.CanvasResizeResponse) (err error) {
+	if message.Palette == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("palette", "message"))
+	}
+	if message.ProtectedRegions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protected_regions", "message"))
+	}
+	for _, e := range message.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("message.palette[*]", e, "^#[0-9A-F]{6}$"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if message.ArchivedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.archived_at", *message.ArchivedAt, goa.FormatDateTime))
+	}
+	for _, e := range message.ProtectedRegions {
+		if e != nil {
+			if err2 := ValidateProtectedRegion(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateProtectedRegionCreateResponse runs the validations defined on
+// ProtectedRegionCreateResponse.
+func ValidateProtectedRegionCreateResponse(message *apipb.ProtectedRegionCreateResponse) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if message.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.expires_at", *message.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAuditLogGetResponse runs the validations defined on
+// AuditLogGetResponse.
+func ValidateAuditLogGetResponse(message *apipb.AuditLogGetResponse) (err error) {
+	if message.Entries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entries", "message"))
+	}
+	for _, e := range message.Entries {
+		if e != nil {
+			if err2 := ValidateAuditEntry(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAuditEntry runs the validations defined on AuditEntry.
+func ValidateAuditEntry(elem *apipb.AuditEntry) (err error) {
+	if !(elem.Action == "rollback") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.action", elem.Action, []any{"rollback"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateCanvasChunksGetResponse runs the validations defined on
+// CanvasChunksGetResponse.
+func ValidateCanvasChunksGetResponse(message *apipb.CanvasChunksGetResponse) (err error) {
+	if message.Chunks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("chunks", "message"))
+	}
+	return
+}
+
+// ValidateCanvasSubscribeResponse runs the validations defined on
+// CanvasSubscribeResponse.
+func ValidateCanvasSubscribeResponse(stream *apipb.CanvasSubscribeResponse) (err error) {
+	if !(stream.Type == "pixel" || stream.Type == "snapshot") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("stream.type", stream.Type, []any{"pixel", "snapshot"}))
+	}
+	if stream.Pixel != nil {
+		if err2 := ValidatePixelEvent(stream.Pixel); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePixelEvent runs the validations defined on PixelEvent.
+func ValidatePixelEvent(pixel *apipb.PixelEvent) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("pixel.placed_at", pixel.PlacedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidatePixelInfoGetResponse runs the validations defined on
+// PixelInfoGetResponse.
+func ValidatePixelInfoGetResponse(message *apipb.PixelInfoGetResponse) (err error) {
+	if message.Placements == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placements", "message"))
+	}
+	for _, e := range message.Placements {
+		if e != nil {
+			if err2 := ValidatePixelHistoryEntry(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePixelHistoryEntry runs the validations defined on PixelHistoryEntry.
+func ValidatePixelHistoryEntry(elem *apipb.PixelHistoryEntry) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.placed_at", elem.PlacedAt, goa.FormatDateTime))
+	return
+}
+
+// svcAPIPixelEventToApipbPixelEvent builds a value of type *apipb.PixelEvent
+// from a value of type *api.PixelEvent.
+func svcAPIPixelEventToApipbPixelEvent(v *api.PixelEvent) *apipb.PixelEvent {
+	if v == nil {
+		return nil
+	}
+	res := &apipb.PixelEvent{
+		X:        v.X,
+		Y:        v.Y,
+		Color:    v.Color,
+		UserId:   v.UserID,
+		PlacedAt: v.PlacedAt,
+		Seq:      v.Seq,
+	}
+
+	return res
+}
+
+// svcAPICanvasSnapshotToApipbCanvasSnapshot builds a value of type
+// *apipb.CanvasSnapshot from a value of type *api.CanvasSnapshot.
+func svcAPICanvasSnapshotToApipbCanvasSnapshot(v *api.CanvasSnapshot) *apipb.CanvasSnapshot {
+	if v == nil {
+		return nil
+	}
+	res := &apipb.CanvasSnapshot{
+		Seq:    v.Seq,
+		Width:  v.Width,
+		Height: v.Height,
+		Pixels: v.Pixels,
+	}
+
+	return res
+}
+
+// protobufApipbPixelEventToAPIPixelEvent builds a value of type
+// *api.PixelEvent from a value of type *apipb.PixelEvent.
+func protobufApipbPixelEventToAPIPixelEvent(v *apipb.PixelEvent) *api.PixelEvent {
+	if v == nil {
+		return nil
+	}
+	res := &api.PixelEvent{
+		X:        v.X,
+		Y:        v.Y,
+		Color:    v.Color,
+		UserID:   v.UserId,
+		PlacedAt: v.PlacedAt,
+		Seq:      v.Seq,
+	}
+
+	return res
+}
+
+// protobufApipbCanvasSnapshotToAPICanvasSnapshot builds a value of type
+// *api.CanvasSnapshot from a value of type *apipb.CanvasSnapshot.
+func protobufApipbCanvasSnapshotToAPICanvasSnapshot(v *apipb.CanvasSnapshot) *api.CanvasSnapshot {
+	if v == nil {
+		return nil
+	}
+	res := &api.CanvasSnapshot{
+		Seq:    v.Seq,
+		Width:  v.Width,
+		Height: v.Height,
+		Pixels: v.Pixels,
+	}
+
+	return res
+}
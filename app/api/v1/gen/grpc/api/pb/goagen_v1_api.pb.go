@@ -1,4 +1,4 @@
-// Code generated with goa v3.22.1, DO NOT EDIT.
+// Code generated with goa v3.22.6, DO NOT EDIT.
 //
 // api protocol buffer definition
 //
@@ -28,27 +28,2358 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CanvasCreateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Width  int32                  `protobuf:"zigzag32,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Ordered list of colors available on the canvas, defaults to the standard
+	// palette if empty.
+	Palette       []string `protobuf:"bytes,3,rep,name=palette,proto3" json:"palette,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasCreateRequest) Reset() {
+	*x = CanvasCreateRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasCreateRequest) ProtoMessage() {}
+
+func (x *CanvasCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasCreateRequest.ProtoReflect.Descriptor instead.
+func (*CanvasCreateRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CanvasCreateRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasCreateRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasCreateRequest) GetPalette() []string {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+type CanvasCreateResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `protobuf:"bytes,4,rep,name=palette,proto3" json:"palette,omitempty"`
+	CreatedAt string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `protobuf:"zigzag32,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegion `protobuf:"bytes,8,rep,name=protected_regions,json=protectedRegions,proto3" json:"protected_regions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CanvasCreateResponse) Reset() {
+	*x = CanvasCreateResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasCreateResponse) ProtoMessage() {}
+
+func (x *CanvasCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasCreateResponse.ProtoReflect.Descriptor instead.
+func (*CanvasCreateResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CanvasCreateResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasCreateResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasCreateResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasCreateResponse) GetPalette() []string {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+func (x *CanvasCreateResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CanvasCreateResponse) GetArchivedAt() string {
+	if x != nil && x.ArchivedAt != nil {
+		return *x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *CanvasCreateResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasCreateResponse) GetProtectedRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.ProtectedRegions
+	}
+	return nil
+}
+
+type ProtectedRegion struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	X         int32                  `protobuf:"zigzag32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y         int32                  `protobuf:"zigzag32,3,opt,name=y,proto3" json:"y,omitempty"`
+	Width     int32                  `protobuf:"zigzag32,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height    int32                  `protobuf:"zigzag32,5,opt,name=height,proto3" json:"height,omitempty"`
+	Reason    string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set if the region is only protected until then.
+	ExpiresAt     *string `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtectedRegion) Reset() {
+	*x = ProtectedRegion{}
+	mi := &file_goagen_v1_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtectedRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectedRegion) ProtoMessage() {}
+
+func (x *ProtectedRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectedRegion.ProtoReflect.Descriptor instead.
+func (*ProtectedRegion) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProtectedRegion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProtectedRegion) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ProtectedRegion) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ProtectedRegion) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ProtectedRegion) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtectedRegion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProtectedRegion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProtectedRegion) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+type CanvasListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to include archived canvases.
+	IncludeArchived *bool `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3,oneof" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CanvasListRequest) Reset() {
+	*x = CanvasListRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasListRequest) ProtoMessage() {}
+
+func (x *CanvasListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasListRequest.ProtoReflect.Descriptor instead.
+func (*CanvasListRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CanvasListRequest) GetIncludeArchived() bool {
+	if x != nil && x.IncludeArchived != nil {
+		return *x.IncludeArchived
+	}
+	return false
+}
+
+type CanvasListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Canvases in the order they were created.
+	Canvases      []*Canvas `protobuf:"bytes,1,rep,name=canvases,proto3" json:"canvases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasListResponse) Reset() {
+	*x = CanvasListResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasListResponse) ProtoMessage() {}
+
+func (x *CanvasListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasListResponse.ProtoReflect.Descriptor instead.
+func (*CanvasListResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CanvasListResponse) GetCanvases() []*Canvas {
+	if x != nil {
+		return x.Canvases
+	}
+	return nil
+}
+
+type Canvas struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `protobuf:"bytes,4,rep,name=palette,proto3" json:"palette,omitempty"`
+	CreatedAt string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `protobuf:"zigzag32,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegion `protobuf:"bytes,8,rep,name=protected_regions,json=protectedRegions,proto3" json:"protected_regions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Canvas) Reset() {
+	*x = Canvas{}
+	mi := &file_goagen_v1_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Canvas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canvas) ProtoMessage() {}
+
+func (x *Canvas) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canvas.ProtoReflect.Descriptor instead.
+func (*Canvas) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Canvas) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Canvas) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Canvas) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Canvas) GetPalette() []string {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+func (x *Canvas) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Canvas) GetArchivedAt() string {
+	if x != nil && x.ArchivedAt != nil {
+		return *x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *Canvas) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Canvas) GetProtectedRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.ProtectedRegions
+	}
+	return nil
+}
+
 type CanvasGetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasGetRequest) Reset() {
+	*x = CanvasGetRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasGetRequest) ProtoMessage() {}
+
+func (x *CanvasGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasGetRequest.ProtoReflect.Descriptor instead.
+func (*CanvasGetRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CanvasGetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CanvasGetResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `protobuf:"bytes,4,rep,name=palette,proto3" json:"palette,omitempty"`
+	CreatedAt string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `protobuf:"zigzag32,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegion `protobuf:"bytes,8,rep,name=protected_regions,json=protectedRegions,proto3" json:"protected_regions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CanvasGetResponse) Reset() {
+	*x = CanvasGetResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasGetResponse) ProtoMessage() {}
+
+func (x *CanvasGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasGetResponse.ProtoReflect.Descriptor instead.
+func (*CanvasGetResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CanvasGetResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasGetResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasGetResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasGetResponse) GetPalette() []string {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+func (x *CanvasGetResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CanvasGetResponse) GetArchivedAt() string {
+	if x != nil && x.ArchivedAt != nil {
+		return *x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *CanvasGetResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasGetResponse) GetProtectedRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.ProtectedRegions
+	}
+	return nil
+}
+
+type CanvasArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasArchiveRequest) Reset() {
+	*x = CanvasArchiveRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasArchiveRequest) ProtoMessage() {}
+
+func (x *CanvasArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasArchiveRequest.ProtoReflect.Descriptor instead.
+func (*CanvasArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CanvasArchiveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CanvasArchiveResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `protobuf:"bytes,4,rep,name=palette,proto3" json:"palette,omitempty"`
+	CreatedAt string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `protobuf:"zigzag32,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegion `protobuf:"bytes,8,rep,name=protected_regions,json=protectedRegions,proto3" json:"protected_regions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CanvasArchiveResponse) Reset() {
+	*x = CanvasArchiveResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasArchiveResponse) ProtoMessage() {}
+
+func (x *CanvasArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasArchiveResponse.ProtoReflect.Descriptor instead.
+func (*CanvasArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CanvasArchiveResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasArchiveResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasArchiveResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasArchiveResponse) GetPalette() []string {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+func (x *CanvasArchiveResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CanvasArchiveResponse) GetArchivedAt() string {
+	if x != nil && x.ArchivedAt != nil {
+		return *x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *CanvasArchiveResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasArchiveResponse) GetProtectedRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.ProtectedRegions
+	}
+	return nil
+}
+
+type CanvasResizeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Left   *int32 `protobuf:"zigzag32,2,opt,name=left,proto3,oneof" json:"left,omitempty"`
+	Top    *int32 `protobuf:"zigzag32,3,opt,name=top,proto3,oneof" json:"top,omitempty"`
+	Right  *int32 `protobuf:"zigzag32,4,opt,name=right,proto3,oneof" json:"right,omitempty"`
+	Bottom *int32 `protobuf:"zigzag32,5,opt,name=bottom,proto3,oneof" json:"bottom,omitempty"`
+	// Color of the pixels added to the canvas.
+	Fill          *int32 `protobuf:"zigzag32,6,opt,name=fill,proto3,oneof" json:"fill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasResizeRequest) Reset() {
+	*x = CanvasResizeRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasResizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasResizeRequest) ProtoMessage() {}
+
+func (x *CanvasResizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasResizeRequest.ProtoReflect.Descriptor instead.
+func (*CanvasResizeRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CanvasResizeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasResizeRequest) GetLeft() int32 {
+	if x != nil && x.Left != nil {
+		return *x.Left
+	}
+	return 0
+}
+
+func (x *CanvasResizeRequest) GetTop() int32 {
+	if x != nil && x.Top != nil {
+		return *x.Top
+	}
+	return 0
+}
+
+func (x *CanvasResizeRequest) GetRight() int32 {
+	if x != nil && x.Right != nil {
+		return *x.Right
+	}
+	return 0
+}
+
+func (x *CanvasResizeRequest) GetBottom() int32 {
+	if x != nil && x.Bottom != nil {
+		return *x.Bottom
+	}
+	return 0
+}
+
+func (x *CanvasResizeRequest) GetFill() int32 {
+	if x != nil && x.Fill != nil {
+		return *x.Fill
+	}
+	return 0
+}
+
+type CanvasResizeResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `protobuf:"bytes,4,rep,name=palette,proto3" json:"palette,omitempty"`
+	CreatedAt string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `protobuf:"zigzag32,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegion `protobuf:"bytes,8,rep,name=protected_regions,json=protectedRegions,proto3" json:"protected_regions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CanvasResizeResponse) Reset() {
+	*x = CanvasResizeResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasResizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasResizeResponse) ProtoMessage() {}
+
+func (x *CanvasResizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasResizeResponse.ProtoReflect.Descriptor instead.
+func (*CanvasResizeResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CanvasResizeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasResizeResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasResizeResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasResizeResponse) GetPalette() []string {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+func (x *CanvasResizeResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CanvasResizeResponse) GetArchivedAt() string {
+	if x != nil && x.ArchivedAt != nil {
+		return *x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *CanvasResizeResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasResizeResponse) GetProtectedRegions() []*ProtectedRegion {
+	if x != nil {
+		return x.ProtectedRegions
+	}
+	return nil
+}
+
+type ProtectedRegionCreateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	X      int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Why the region is protected, e.g. a sponsor's logo.
+	Reason *string `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Number of seconds to protect the region for. The region is protected until
+	// deleted if unset.
+	Duration *int32 `protobuf:"zigzag32,6,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtectedRegionCreateRequest) Reset() {
+	*x = ProtectedRegionCreateRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtectedRegionCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectedRegionCreateRequest) ProtoMessage() {}
+
+func (x *ProtectedRegionCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectedRegionCreateRequest.ProtoReflect.Descriptor instead.
+func (*ProtectedRegionCreateRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProtectedRegionCreateRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ProtectedRegionCreateRequest) GetDuration() int32 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProtectedRegionCreateResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	X         int32                  `protobuf:"zigzag32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y         int32                  `protobuf:"zigzag32,3,opt,name=y,proto3" json:"y,omitempty"`
+	Width     int32                  `protobuf:"zigzag32,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height    int32                  `protobuf:"zigzag32,5,opt,name=height,proto3" json:"height,omitempty"`
+	Reason    string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set if the region is only protected until then.
+	ExpiresAt     *string `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtectedRegionCreateResponse) Reset() {
+	*x = ProtectedRegionCreateResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtectedRegionCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectedRegionCreateResponse) ProtoMessage() {}
+
+func (x *ProtectedRegionCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectedRegionCreateResponse.ProtoReflect.Descriptor instead.
+func (*ProtectedRegionCreateResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProtectedRegionCreateResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProtectedRegionCreateResponse) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateResponse) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtectedRegionCreateResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProtectedRegionCreateResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProtectedRegionCreateResponse) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+type ProtectedRegionDeleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the protected region, e.g. rgn_01h455vb4pex5vsknk084sn02q.
+	RegionId      string `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtectedRegionDeleteRequest) Reset() {
+	*x = ProtectedRegionDeleteRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtectedRegionDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectedRegionDeleteRequest) ProtoMessage() {}
+
+func (x *ProtectedRegionDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectedRegionDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ProtectedRegionDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProtectedRegionDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProtectedRegionDeleteRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+type ProtectedRegionDeleteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasGetRequest) Reset() {
-	*x = CanvasGetRequest{}
-	mi := &file_goagen_v1_api_proto_msgTypes[0]
+func (x *ProtectedRegionDeleteResponse) Reset() {
+	*x = ProtectedRegionDeleteResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtectedRegionDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectedRegionDeleteResponse) ProtoMessage() {}
+
+func (x *ProtectedRegionDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectedRegionDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ProtectedRegionDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+type CanvasRollbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Revert pixels placed by this user.
+	UserId *string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	X      *int32  `protobuf:"zigzag32,3,opt,name=x,proto3,oneof" json:"x,omitempty"`
+	Y      *int32  `protobuf:"zigzag32,4,opt,name=y,proto3,oneof" json:"y,omitempty"`
+	// Revert pixels within this region, along with x, y and height.
+	Width  *int32 `protobuf:"zigzag32,5,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height *int32 `protobuf:"zigzag32,6,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	// Revert pixels placed at or after this time.
+	From *string `protobuf:"bytes,7,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// Revert pixels placed before this time.
+	To            *string `protobuf:"bytes,8,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasRollbackRequest) Reset() {
+	*x = CanvasRollbackRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasRollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasRollbackRequest) ProtoMessage() {}
+
+func (x *CanvasRollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasRollbackRequest.ProtoReflect.Descriptor instead.
+func (*CanvasRollbackRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CanvasRollbackRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasRollbackRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *CanvasRollbackRequest) GetX() int32 {
+	if x != nil && x.X != nil {
+		return *x.X
+	}
+	return 0
+}
+
+func (x *CanvasRollbackRequest) GetY() int32 {
+	if x != nil && x.Y != nil {
+		return *x.Y
+	}
+	return 0
+}
+
+func (x *CanvasRollbackRequest) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *CanvasRollbackRequest) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *CanvasRollbackRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *CanvasRollbackRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+type CanvasRollbackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of pixels reverted.
+	Reverted      int32 `protobuf:"zigzag32,1,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasRollbackResponse) Reset() {
+	*x = CanvasRollbackResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasRollbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasRollbackResponse) ProtoMessage() {}
+
+func (x *CanvasRollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasRollbackResponse.ProtoReflect.Descriptor instead.
+func (*CanvasRollbackResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CanvasRollbackResponse) GetReverted() int32 {
+	if x != nil {
+		return x.Reverted
+	}
+	return 0
+}
+
+type AuditLogGetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of entries to return.
+	Limit         *int32 `protobuf:"zigzag32,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogGetRequest) Reset() {
+	*x = AuditLogGetRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogGetRequest) ProtoMessage() {}
+
+func (x *AuditLogGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogGetRequest.ProtoReflect.Descriptor instead.
+func (*AuditLogGetRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AuditLogGetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogGetRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type AuditLogGetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Latest actions taken by moderators on the canvas, most recent first.
+	Entries       []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogGetResponse) Reset() {
+	*x = AuditLogGetResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogGetResponse) ProtoMessage() {}
+
+func (x *AuditLogGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogGetResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogGetResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuditLogGetResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// An action taken by a moderator on a canvas.
+type AuditEntry struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Action string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// ID of the moderator who took the action.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Details       string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	CreatedAt     string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_goagen_v1_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CanvasPixelsGetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasPixelsGetRequest) Reset() {
+	*x = CanvasPixelsGetRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasPixelsGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasPixelsGetRequest) ProtoMessage() {}
+
+func (x *CanvasPixelsGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasPixelsGetRequest.ProtoReflect.Descriptor instead.
+func (*CanvasPixelsGetRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CanvasPixelsGetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CanvasPixelsGetResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Width  int32                  `protobuf:"zigzag32,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels        []byte `protobuf:"bytes,3,opt,name=pixels,proto3" json:"pixels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasPixelsGetResponse) Reset() {
+	*x = CanvasPixelsGetResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasPixelsGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasPixelsGetResponse) ProtoMessage() {}
+
+func (x *CanvasPixelsGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasPixelsGetResponse.ProtoReflect.Descriptor instead.
+func (*CanvasPixelsGetResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CanvasPixelsGetResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasPixelsGetResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasPixelsGetResponse) GetPixels() []byte {
+	if x != nil {
+		return x.Pixels
+	}
+	return nil
+}
+
+type CanvasChunksGetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the canvas the client already has, or 0 for every chunk with
+	// pixels placed.
+	Since         *int64 `protobuf:"zigzag64,2,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasChunksGetRequest) Reset() {
+	*x = CanvasChunksGetRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChunksGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChunksGetRequest) ProtoMessage() {}
+
+func (x *CanvasChunksGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChunksGetRequest.ProtoReflect.Descriptor instead.
+func (*CanvasChunksGetRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CanvasChunksGetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasChunksGetRequest) GetSince() int64 {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return 0
+}
+
+type CanvasChunksGetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sequence number of the latest placement on the canvas, to pass as since to
+	// fetch only later changes.
+	Version int64 `protobuf:"zigzag64,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Width and height of each chunk, except those on the right and bottom edges
+	// of the canvas.
+	ChunkSize int32 `protobuf:"zigzag32,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// Chunks modified after the requested version.
+	Chunks        []*CanvasChunk `protobuf:"bytes,3,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasChunksGetResponse) Reset() {
+	*x = CanvasChunksGetResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChunksGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChunksGetResponse) ProtoMessage() {}
+
+func (x *CanvasChunksGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChunksGetResponse.ProtoReflect.Descriptor instead.
+func (*CanvasChunksGetResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CanvasChunksGetResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasChunksGetResponse) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *CanvasChunksGetResponse) GetChunks() []*CanvasChunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+// The pixels in one chunk of a canvas.
+type CanvasChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// X coordinate of the chunk, in chunks.
+	X int32 `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	// Y coordinate of the chunk, in chunks.
+	Y      int32 `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width  int32 `protobuf:"zigzag32,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"zigzag32,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Sequence number of the latest placement in the chunk.
+	Version int64 `protobuf:"zigzag64,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels        []byte `protobuf:"bytes,6,opt,name=pixels,proto3" json:"pixels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasChunk) Reset() {
+	*x = CanvasChunk{}
+	mi := &file_goagen_v1_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChunk) ProtoMessage() {}
+
+func (x *CanvasChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChunk.ProtoReflect.Descriptor instead.
+func (*CanvasChunk) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CanvasChunk) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CanvasChunk) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CanvasChunk) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasChunk) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasChunk) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CanvasChunk) GetPixels() []byte {
+	if x != nil {
+		return x.Pixels
+	}
+	return nil
+}
+
+type CanvasRegionGetRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	X      int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,4,opt,name=height,proto3" json:"height,omitempty"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id            string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasRegionGetRequest) Reset() {
+	*x = CanvasRegionGetRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasRegionGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasRegionGetRequest) ProtoMessage() {}
+
+func (x *CanvasRegionGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasRegionGetRequest.ProtoReflect.Descriptor instead.
+func (*CanvasRegionGetRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CanvasRegionGetRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CanvasRegionGetResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	X      int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels        []byte `protobuf:"bytes,5,opt,name=pixels,proto3" json:"pixels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasRegionGetResponse) Reset() {
+	*x = CanvasRegionGetResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasRegionGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasRegionGetResponse) ProtoMessage() {}
+
+func (x *CanvasRegionGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasRegionGetResponse.ProtoReflect.Descriptor instead.
+func (*CanvasRegionGetResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CanvasRegionGetResponse) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetResponse) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasRegionGetResponse) GetPixels() []byte {
+	if x != nil {
+		return x.Pixels
+	}
+	return nil
+}
+
+type CanvasSubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Sequence number of the last event received. Placements made after it are
+	// replayed before live events.
+	Since *int64 `protobuf:"zigzag64,1,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// Set from the Last-Event-ID header by reconnecting SSE clients, in place of
+	// since.
+	LastEventId   *string `protobuf:"bytes,2,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasSubscribeRequest) Reset() {
+	*x = CanvasSubscribeRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasSubscribeRequest) ProtoMessage() {}
+
+func (x *CanvasSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*CanvasSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CanvasSubscribeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasSubscribeRequest) GetSince() int64 {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return 0
+}
+
+func (x *CanvasSubscribeRequest) GetLastEventId() string {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return ""
+}
+
+type CanvasSubscribeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sequence number the canvas is at once the event is applied, used to resume
+	// the stream.
+	Id    string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  string      `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Pixel *PixelEvent `protobuf:"bytes,3,opt,name=pixel,proto3" json:"pixel,omitempty"`
+	// Sent instead of replaying missed placements when there are too many of them,
+	// or when the canvas has been resized.
+	Snapshot      *CanvasSnapshot `protobuf:"bytes,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasSubscribeResponse) Reset() {
+	*x = CanvasSubscribeResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasSubscribeResponse) ProtoMessage() {}
+
+func (x *CanvasSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*CanvasSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CanvasSubscribeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasSubscribeResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CanvasSubscribeResponse) GetPixel() *PixelEvent {
+	if x != nil {
+		return x.Pixel
+	}
+	return nil
+}
+
+func (x *CanvasSubscribeResponse) GetSnapshot() *CanvasSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// A pixel placement accepted on the canvas.
+type PixelEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	X     int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Color int32                  `protobuf:"zigzag32,3,opt,name=color,proto3" json:"color,omitempty"`
+	// ID of the user who placed the pixel.
+	UserId   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlacedAt string `protobuf:"bytes,5,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	// Sequence number of the placement on the canvas.
+	Seq           int64 `protobuf:"zigzag64,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PixelEvent) Reset() {
+	*x = PixelEvent{}
+	mi := &file_goagen_v1_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PixelEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PixelEvent) ProtoMessage() {}
+
+func (x *PixelEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PixelEvent.ProtoReflect.Descriptor instead.
+func (*PixelEvent) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PixelEvent) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PixelEvent) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PixelEvent) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *PixelEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PixelEvent) GetPlacedAt() string {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return ""
+}
+
+func (x *PixelEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// Every pixel on the canvas as of a sequence number.
+type CanvasSnapshot struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Seq    int64                  `protobuf:"zigzag64,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Width  int32                  `protobuf:"zigzag32,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32                  `protobuf:"zigzag32,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels        []byte `protobuf:"bytes,4,opt,name=pixels,proto3" json:"pixels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasSnapshot) Reset() {
+	*x = CanvasSnapshot{}
+	mi := &file_goagen_v1_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasSnapshot) ProtoMessage() {}
+
+func (x *CanvasSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasSnapshot.ProtoReflect.Descriptor instead.
+func (*CanvasSnapshot) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CanvasSnapshot) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *CanvasSnapshot) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CanvasSnapshot) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CanvasSnapshot) GetPixels() []byte {
+	if x != nil {
+		return x.Pixels
+	}
+	return nil
+}
+
+type PixelPlaceCooldownActiveError struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Message_ string                 `protobuf:"bytes,1,opt,name=message_,json=message,proto3" json:"message_,omitempty"`
+	// Number of seconds to wait before placing another pixel.
+	RetryAfter    int32 `protobuf:"zigzag32,2,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PixelPlaceCooldownActiveError) Reset() {
+	*x = PixelPlaceCooldownActiveError{}
+	mi := &file_goagen_v1_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasGetRequest) String() string {
+func (x *PixelPlaceCooldownActiveError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasGetRequest) ProtoMessage() {}
+func (*PixelPlaceCooldownActiveError) ProtoMessage() {}
 
-func (x *CanvasGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_v1_api_proto_msgTypes[0]
+func (x *PixelPlaceCooldownActiveError) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,35 +2390,118 @@ func (x *CanvasGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasGetRequest.ProtoReflect.Descriptor instead.
-func (*CanvasGetRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_v1_api_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PixelPlaceCooldownActiveError.ProtoReflect.Descriptor instead.
+func (*PixelPlaceCooldownActiveError) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{32}
 }
 
-type CanvasGetResponse struct {
+func (x *PixelPlaceCooldownActiveError) GetMessage_() string {
+	if x != nil {
+		return x.Message_
+	}
+	return ""
+}
+
+func (x *PixelPlaceCooldownActiveError) GetRetryAfter() int32 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type PixelPlaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	X     int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Color int32                  `protobuf:"zigzag32,3,opt,name=color,proto3" json:"color,omitempty"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id            string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PixelPlaceRequest) Reset() {
+	*x = PixelPlaceRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PixelPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PixelPlaceRequest) ProtoMessage() {}
+
+func (x *PixelPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PixelPlaceRequest.ProtoReflect.Descriptor instead.
+func (*PixelPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PixelPlaceRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PixelPlaceRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PixelPlaceRequest) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *PixelPlaceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PixelPlaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Width         int32                  `protobuf:"zigzag32,2,opt,name=width,proto3" json:"width,omitempty"`
-	Height        int32                  `protobuf:"zigzag32,3,opt,name=height,proto3" json:"height,omitempty"`
+	X             int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Color         int32                  `protobuf:"zigzag32,3,opt,name=color,proto3" json:"color,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasGetResponse) Reset() {
-	*x = CanvasGetResponse{}
-	mi := &file_goagen_v1_api_proto_msgTypes[1]
+func (x *PixelPlaceResponse) Reset() {
+	*x = PixelPlaceResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasGetResponse) String() string {
+func (x *PixelPlaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasGetResponse) ProtoMessage() {}
+func (*PixelPlaceResponse) ProtoMessage() {}
 
-func (x *CanvasGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_v1_api_proto_msgTypes[1]
+func (x *PixelPlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -98,28 +2512,230 @@ func (x *CanvasGetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasGetResponse.ProtoReflect.Descriptor instead.
-func (*CanvasGetResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_v1_api_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PixelPlaceResponse.ProtoReflect.Descriptor instead.
+func (*PixelPlaceResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CanvasGetResponse) GetId() string {
+func (x *PixelPlaceResponse) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PixelPlaceResponse) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PixelPlaceResponse) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+type PixelInfoGetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	X     int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	// Maximum number of placements to return.
+	Limit *int32 `protobuf:"zigzag32,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.
+	Id            string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PixelInfoGetRequest) Reset() {
+	*x = PixelInfoGetRequest{}
+	mi := &file_goagen_v1_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PixelInfoGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PixelInfoGetRequest) ProtoMessage() {}
+
+func (x *PixelInfoGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PixelInfoGetRequest.ProtoReflect.Descriptor instead.
+func (*PixelInfoGetRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PixelInfoGetRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PixelInfoGetRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PixelInfoGetRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *PixelInfoGetRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CanvasGetResponse) GetWidth() int32 {
+type PixelInfoGetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	X     int32                  `protobuf:"zigzag32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32                  `protobuf:"zigzag32,2,opt,name=y,proto3" json:"y,omitempty"`
+	// Latest placements at the coordinate, most recent first.
+	Placements    []*PixelHistoryEntry `protobuf:"bytes,3,rep,name=placements,proto3" json:"placements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PixelInfoGetResponse) Reset() {
+	*x = PixelInfoGetResponse{}
+	mi := &file_goagen_v1_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PixelInfoGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PixelInfoGetResponse) ProtoMessage() {}
+
+func (x *PixelInfoGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[36]
 	if x != nil {
-		return x.Width
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PixelInfoGetResponse.ProtoReflect.Descriptor instead.
+func (*PixelInfoGetResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PixelInfoGetResponse) GetX() int32 {
+	if x != nil {
+		return x.X
 	}
 	return 0
 }
 
-func (x *CanvasGetResponse) GetHeight() int32 {
+func (x *PixelInfoGetResponse) GetY() int32 {
 	if x != nil {
-		return x.Height
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PixelInfoGetResponse) GetPlacements() []*PixelHistoryEntry {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+// A past placement at a coordinate.
+type PixelHistoryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the user who placed the pixel.
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Color    int32  `protobuf:"zigzag32,2,opt,name=color,proto3" json:"color,omitempty"`
+	PlacedAt string `protobuf:"bytes,3,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	// Sequence number of the placement on the canvas.
+	Seq           int64 `protobuf:"zigzag64,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PixelHistoryEntry) Reset() {
+	*x = PixelHistoryEntry{}
+	mi := &file_goagen_v1_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PixelHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PixelHistoryEntry) ProtoMessage() {}
+
+func (x *PixelHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_v1_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PixelHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PixelHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_goagen_v1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PixelHistoryEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PixelHistoryEntry) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *PixelHistoryEntry) GetPlacedAt() string {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return ""
+}
+
+func (x *PixelHistoryEntry) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
 	}
 	return 0
 }
@@ -128,14 +2744,268 @@ var File_goagen_v1_api_proto protoreflect.FileDescriptor
 
 const file_goagen_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"\x13goagen_v1_api.proto\x12\x03api\"\x12\n" +
-	"\x10CanvasGetRequest\"Q\n" +
+	"\x13goagen_v1_api.proto\x12\x03api\"]\n" +
+	"\x13CanvasCreateRequest\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x11R\x06height\x12\x18\n" +
+	"\apalette\x18\x03 \x03(\tR\apalette\"\xa0\x02\n" +
+	"\x14CanvasCreateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x11R\x06height\x12\x18\n" +
+	"\apalette\x18\x04 \x03(\tR\apalette\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12$\n" +
+	"\varchived_at\x18\x06 \x01(\tH\x00R\n" +
+	"archivedAt\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\a \x01(\x11R\aversion\x12A\n" +
+	"\x11protected_regions\x18\b \x03(\v2\x14.api.ProtectedRegionR\x10protectedRegionsB\x0e\n" +
+	"\f_archived_at\"\xd5\x01\n" +
+	"\x0fProtectedRegion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x11R\x06height\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\"\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"X\n" +
+	"\x11CanvasListRequest\x12.\n" +
+	"\x10include_archived\x18\x01 \x01(\bH\x00R\x0fincludeArchived\x88\x01\x01B\x13\n" +
+	"\x11_include_archived\"=\n" +
+	"\x12CanvasListResponse\x12'\n" +
+	"\bcanvases\x18\x01 \x03(\v2\v.api.CanvasR\bcanvases\"\x92\x02\n" +
+	"\x06Canvas\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x11R\x06height\x12\x18\n" +
+	"\apalette\x18\x04 \x03(\tR\apalette\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12$\n" +
+	"\varchived_at\x18\x06 \x01(\tH\x00R\n" +
+	"archivedAt\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\a \x01(\x11R\aversion\x12A\n" +
+	"\x11protected_regions\x18\b \x03(\v2\x14.api.ProtectedRegionR\x10protectedRegionsB\x0e\n" +
+	"\f_archived_at\"\"\n" +
+	"\x10CanvasGetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9d\x02\n" +
 	"\x11CanvasGetResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05width\x18\x02 \x01(\x11R\x05width\x12\x16\n" +
-	"\x06height\x18\x03 \x01(\x11R\x06height2A\n" +
-	"\x03API\x12:\n" +
-	"\tCanvasGet\x12\x15.api.CanvasGetRequest\x1a\x16.api.CanvasGetResponseB\bZ\x06/apipbb\x06proto3"
+	"\x06height\x18\x03 \x01(\x11R\x06height\x12\x18\n" +
+	"\apalette\x18\x04 \x03(\tR\apalette\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12$\n" +
+	"\varchived_at\x18\x06 \x01(\tH\x00R\n" +
+	"archivedAt\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\a \x01(\x11R\aversion\x12A\n" +
+	"\x11protected_regions\x18\b \x03(\v2\x14.api.ProtectedRegionR\x10protectedRegionsB\x0e\n" +
+	"\f_archived_at\"&\n" +
+	"\x14CanvasArchiveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa1\x02\n" +
+	"\x15CanvasArchiveResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x11R\x06height\x12\x18\n" +
+	"\apalette\x18\x04 \x03(\tR\apalette\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12$\n" +
+	"\varchived_at\x18\x06 \x01(\tH\x00R\n" +
+	"archivedAt\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\a \x01(\x11R\aversion\x12A\n" +
+	"\x11protected_regions\x18\b \x03(\v2\x14.api.ProtectedRegionR\x10protectedRegionsB\x0e\n" +
+	"\f_archived_at\"\xd5\x01\n" +
+	"\x13CanvasResizeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04left\x18\x02 \x01(\x11H\x00R\x04left\x88\x01\x01\x12\x15\n" +
+	"\x03top\x18\x03 \x01(\x11H\x01R\x03top\x88\x01\x01\x12\x19\n" +
+	"\x05right\x18\x04 \x01(\x11H\x02R\x05right\x88\x01\x01\x12\x1b\n" +
+	"\x06bottom\x18\x05 \x01(\x11H\x03R\x06bottom\x88\x01\x01\x12\x17\n" +
+	"\x04fill\x18\x06 \x01(\x11H\x04R\x04fill\x88\x01\x01B\a\n" +
+	"\x05_leftB\x06\n" +
+	"\x04_topB\b\n" +
+	"\x06_rightB\t\n" +
+	"\a_bottomB\a\n" +
+	"\x05_fill\"\xa0\x02\n" +
+	"\x14CanvasResizeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x11R\x06height\x12\x18\n" +
+	"\apalette\x18\x04 \x03(\tR\apalette\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12$\n" +
+	"\varchived_at\x18\x06 \x01(\tH\x00R\n" +
+	"archivedAt\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\a \x01(\x11R\aversion\x12A\n" +
+	"\x11protected_regions\x18\b \x03(\v2\x14.api.ProtectedRegionR\x10protectedRegionsB\x0e\n" +
+	"\f_archived_at\"\xce\x01\n" +
+	"\x1cProtectedRegionCreateRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x11R\x06height\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x06 \x01(\x11H\x01R\bduration\x88\x01\x01\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02idB\t\n" +
+	"\a_reasonB\v\n" +
+	"\t_duration\"\xe3\x01\n" +
+	"\x1dProtectedRegionCreateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x11R\x06height\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\"\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"K\n" +
+	"\x1cProtectedRegionDeleteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tregion_id\x18\x02 \x01(\tR\bregionId\"\x1f\n" +
+	"\x1dProtectedRegionDeleteResponse\"\x8e\x02\n" +
+	"\x15CanvasRollbackRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x88\x01\x01\x12\x11\n" +
+	"\x01x\x18\x03 \x01(\x11H\x01R\x01x\x88\x01\x01\x12\x11\n" +
+	"\x01y\x18\x04 \x01(\x11H\x02R\x01y\x88\x01\x01\x12\x19\n" +
+	"\x05width\x18\x05 \x01(\x11H\x03R\x05width\x88\x01\x01\x12\x1b\n" +
+	"\x06height\x18\x06 \x01(\x11H\x04R\x06height\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\a \x01(\tH\x05R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\b \x01(\tH\x06R\x02to\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x04\n" +
+	"\x02_xB\x04\n" +
+	"\x02_yB\b\n" +
+	"\x06_widthB\t\n" +
+	"\a_heightB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"4\n" +
+	"\x16CanvasRollbackResponse\x12\x1a\n" +
+	"\breverted\x18\x01 \x01(\x11R\breverted\"I\n" +
+	"\x12AuditLogGetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x11H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"@\n" +
+	"\x13AuditLogGetResponse\x12)\n" +
+	"\aentries\x18\x01 \x03(\v2\x0f.api.AuditEntryR\aentries\"v\n" +
+	"\n" +
+	"AuditEntry\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\adetails\x18\x03 \x01(\tR\adetails\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"(\n" +
+	"\x16CanvasPixelsGetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x17CanvasPixelsGetResponse\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x11R\x06height\x12\x16\n" +
+	"\x06pixels\x18\x03 \x01(\fR\x06pixels\"M\n" +
+	"\x16CanvasChunksGetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05since\x18\x02 \x01(\x12H\x00R\x05since\x88\x01\x01B\b\n" +
+	"\x06_since\"|\n" +
+	"\x17CanvasChunksGetResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x12R\aversion\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x02 \x01(\x11R\tchunkSize\x12(\n" +
+	"\x06chunks\x18\x03 \x03(\v2\x10.api.CanvasChunkR\x06chunks\"\x89\x01\n" +
+	"\vCanvasChunk\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x11R\x06height\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x12R\aversion\x12\x16\n" +
+	"\x06pixels\x18\x06 \x01(\fR\x06pixels\"r\n" +
+	"\x16CanvasRegionGetRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x11R\x06height\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\"{\n" +
+	"\x17CanvasRegionGetResponse\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x11R\x06height\x12\x16\n" +
+	"\x06pixels\x18\x05 \x01(\fR\x06pixels\"\x88\x01\n" +
+	"\x16CanvasSubscribeRequest\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x19\n" +
+	"\x05since\x18\x01 \x01(\x12H\x00R\x05since\x88\x01\x01\x12'\n" +
+	"\rlast_event_id\x18\x02 \x01(\tH\x01R\vlastEventId\x88\x01\x01B\b\n" +
+	"\x06_sinceB\x10\n" +
+	"\x0e_last_event_id\"\x95\x01\n" +
+	"\x17CanvasSubscribeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\x05pixel\x18\x03 \x01(\v2\x0f.api.PixelEventR\x05pixel\x12/\n" +
+	"\bsnapshot\x18\x04 \x01(\v2\x13.api.CanvasSnapshotR\bsnapshot\"\x86\x01\n" +
+	"\n" +
+	"PixelEvent\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\x11R\x05color\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tplaced_at\x18\x05 \x01(\tR\bplacedAt\x12\x10\n" +
+	"\x03seq\x18\x06 \x01(\x12R\x03seq\"h\n" +
+	"\x0eCanvasSnapshot\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x12R\x03seq\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x11R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x11R\x06height\x12\x16\n" +
+	"\x06pixels\x18\x04 \x01(\fR\x06pixels\"[\n" +
+	"\x1dPixelPlaceCooldownActiveError\x12\x19\n" +
+	"\bmessage_\x18\x01 \x01(\tR\amessage\x12\x1f\n" +
+	"\vretry_after\x18\x02 \x01(\x11R\n" +
+	"retryAfter\"U\n" +
+	"\x11PixelPlaceRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\x11R\x05color\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\"F\n" +
+	"\x12PixelPlaceResponse\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\x11R\x05color\"f\n" +
+	"\x13PixelInfoGetRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x11H\x00R\x05limit\x88\x01\x01\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02idB\b\n" +
+	"\x06_limit\"j\n" +
+	"\x14PixelInfoGetResponse\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x11R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x11R\x01y\x126\n" +
+	"\n" +
+	"placements\x18\x03 \x03(\v2\x16.api.PixelHistoryEntryR\n" +
+	"placements\"q\n" +
+	"\x11PixelHistoryEntry\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\x11R\x05color\x12\x1b\n" +
+	"\tplaced_at\x18\x03 \x01(\tR\bplacedAt\x12\x10\n" +
+	"\x03seq\x18\x04 \x01(\x12R\x03seq2\xdd\b\n" +
+	"\x03API\x12C\n" +
+	"\fCanvasCreate\x12\x18.api.CanvasCreateRequest\x1a\x19.api.CanvasCreateResponse\x12=\n" +
+	"\n" +
+	"CanvasList\x12\x16.api.CanvasListRequest\x1a\x17.api.CanvasListResponse\x12:\n" +
+	"\tCanvasGet\x12\x15.api.CanvasGetRequest\x1a\x16.api.CanvasGetResponse\x12F\n" +
+	"\rCanvasArchive\x12\x19.api.CanvasArchiveRequest\x1a\x1a.api.CanvasArchiveResponse\x12C\n" +
+	"\fCanvasResize\x12\x18.api.CanvasResizeRequest\x1a\x19.api.CanvasResizeResponse\x12^\n" +
+	"\x15ProtectedRegionCreate\x12!.api.ProtectedRegionCreateRequest\x1a\".api.ProtectedRegionCreateResponse\x12^\n" +
+	"\x15ProtectedRegionDelete\x12!.api.ProtectedRegionDeleteRequest\x1a\".api.ProtectedRegionDeleteResponse\x12I\n" +
+	"\x0eCanvasRollback\x12\x1a.api.CanvasRollbackRequest\x1a\x1b.api.CanvasRollbackResponse\x12@\n" +
+	"\vAuditLogGet\x12\x17.api.AuditLogGetRequest\x1a\x18.api.AuditLogGetResponse\x12L\n" +
+	"\x0fCanvasPixelsGet\x12\x1b.api.CanvasPixelsGetRequest\x1a\x1c.api.CanvasPixelsGetResponse\x12L\n" +
+	"\x0fCanvasChunksGet\x12\x1b.api.CanvasChunksGetRequest\x1a\x1c.api.CanvasChunksGetResponse\x12L\n" +
+	"\x0fCanvasRegionGet\x12\x1b.api.CanvasRegionGetRequest\x1a\x1c.api.CanvasRegionGetResponse\x12N\n" +
+	"\x0fCanvasSubscribe\x12\x1b.api.CanvasSubscribeRequest\x1a\x1c.api.CanvasSubscribeResponse0\x01\x12=\n" +
+	"\n" +
+	"PixelPlace\x12\x16.api.PixelPlaceRequest\x1a\x17.api.PixelPlaceResponse\x12C\n" +
+	"\fPixelInfoGet\x12\x18.api.PixelInfoGetRequest\x1a\x19.api.PixelInfoGetResponseB\bZ\x06/apipbb\x06proto3"
 
 var (
 	file_goagen_v1_api_proto_rawDescOnce sync.Once
@@ -149,19 +3019,94 @@ func file_goagen_v1_api_proto_rawDescGZIP() []byte {
 	return file_goagen_v1_api_proto_rawDescData
 }
 
-var file_goagen_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_goagen_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_goagen_v1_api_proto_goTypes = []any{
-	(*CanvasGetRequest)(nil),  // 0: api.CanvasGetRequest
-	(*CanvasGetResponse)(nil), // 1: api.CanvasGetResponse
+	(*CanvasCreateRequest)(nil),           // 0: api.CanvasCreateRequest
+	(*CanvasCreateResponse)(nil),          // 1: api.CanvasCreateResponse
+	(*ProtectedRegion)(nil),               // 2: api.ProtectedRegion
+	(*CanvasListRequest)(nil),             // 3: api.CanvasListRequest
+	(*CanvasListResponse)(nil),            // 4: api.CanvasListResponse
+	(*Canvas)(nil),                        // 5: api.Canvas
+	(*CanvasGetRequest)(nil),              // 6: api.CanvasGetRequest
+	(*CanvasGetResponse)(nil),             // 7: api.CanvasGetResponse
+	(*CanvasArchiveRequest)(nil),          // 8: api.CanvasArchiveRequest
+	(*CanvasArchiveResponse)(nil),         // 9: api.CanvasArchiveResponse
+	(*CanvasResizeRequest)(nil),           // 10: api.CanvasResizeRequest
+	(*CanvasResizeResponse)(nil),          // 11: api.CanvasResizeResponse
+	(*ProtectedRegionCreateRequest)(nil),  // 12: api.ProtectedRegionCreateRequest
+	(*ProtectedRegionCreateResponse)(nil), // 13: api.ProtectedRegionCreateResponse
+	(*ProtectedRegionDeleteRequest)(nil),  // 14: api.ProtectedRegionDeleteRequest
+	(*ProtectedRegionDeleteResponse)(nil), // 15: api.ProtectedRegionDeleteResponse
+	(*CanvasRollbackRequest)(nil),         // 16: api.CanvasRollbackRequest
+	(*CanvasRollbackResponse)(nil),        // 17: api.CanvasRollbackResponse
+	(*AuditLogGetRequest)(nil),            // 18: api.AuditLogGetRequest
+	(*AuditLogGetResponse)(nil),           // 19: api.AuditLogGetResponse
+	(*AuditEntry)(nil),                    // 20: api.AuditEntry
+	(*CanvasPixelsGetRequest)(nil),        // 21: api.CanvasPixelsGetRequest
+	(*CanvasPixelsGetResponse)(nil),       // 22: api.CanvasPixelsGetResponse
+	(*CanvasChunksGetRequest)(nil),        // 23: api.CanvasChunksGetRequest
+	(*CanvasChunksGetResponse)(nil),       // 24: api.CanvasChunksGetResponse
+	(*CanvasChunk)(nil),                   // 25: api.CanvasChunk
+	(*CanvasRegionGetRequest)(nil),        // 26: api.CanvasRegionGetRequest
+	(*CanvasRegionGetResponse)(nil),       // 27: api.CanvasRegionGetResponse
+	(*CanvasSubscribeRequest)(nil),        // 28: api.CanvasSubscribeRequest
+	(*CanvasSubscribeResponse)(nil),       // 29: api.CanvasSubscribeResponse
+	(*PixelEvent)(nil),                    // 30: api.PixelEvent
+	(*CanvasSnapshot)(nil),                // 31: api.CanvasSnapshot
+	(*PixelPlaceCooldownActiveError)(nil), // 32: api.PixelPlaceCooldownActiveError
+	(*PixelPlaceRequest)(nil),             // 33: api.PixelPlaceRequest
+	(*PixelPlaceResponse)(nil),            // 34: api.PixelPlaceResponse
+	(*PixelInfoGetRequest)(nil),           // 35: api.PixelInfoGetRequest
+	(*PixelInfoGetResponse)(nil),          // 36: api.PixelInfoGetResponse
+	(*PixelHistoryEntry)(nil),             // 37: api.PixelHistoryEntry
 }
 var file_goagen_v1_api_proto_depIdxs = []int32{
-	0, // 0: api.API.CanvasGet:input_type -> api.CanvasGetRequest
-	1, // 1: api.API.CanvasGet:output_type -> api.CanvasGetResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: api.CanvasCreateResponse.protected_regions:type_name -> api.ProtectedRegion
+	5,  // 1: api.CanvasListResponse.canvases:type_name -> api.Canvas
+	2,  // 2: api.Canvas.protected_regions:type_name -> api.ProtectedRegion
+	2,  // 3: api.CanvasGetResponse.protected_regions:type_name -> api.ProtectedRegion
+	2,  // 4: api.CanvasArchiveResponse.protected_regions:type_name -> api.ProtectedRegion
+	2,  // 5: api.CanvasResizeResponse.protected_regions:type_name -> api.ProtectedRegion
+	20, // 6: api.AuditLogGetResponse.entries:type_name -> api.AuditEntry
+	25, // 7: api.CanvasChunksGetResponse.chunks:type_name -> api.CanvasChunk
+	30, // 8: api.CanvasSubscribeResponse.pixel:type_name -> api.PixelEvent
+	31, // 9: api.CanvasSubscribeResponse.snapshot:type_name -> api.CanvasSnapshot
+	37, // 10: api.PixelInfoGetResponse.placements:type_name -> api.PixelHistoryEntry
+	0,  // 11: api.API.CanvasCreate:input_type -> api.CanvasCreateRequest
+	3,  // 12: api.API.CanvasList:input_type -> api.CanvasListRequest
+	6,  // 13: api.API.CanvasGet:input_type -> api.CanvasGetRequest
+	8,  // 14: api.API.CanvasArchive:input_type -> api.CanvasArchiveRequest
+	10, // 15: api.API.CanvasResize:input_type -> api.CanvasResizeRequest
+	12, // 16: api.API.ProtectedRegionCreate:input_type -> api.ProtectedRegionCreateRequest
+	14, // 17: api.API.ProtectedRegionDelete:input_type -> api.ProtectedRegionDeleteRequest
+	16, // 18: api.API.CanvasRollback:input_type -> api.CanvasRollbackRequest
+	18, // 19: api.API.AuditLogGet:input_type -> api.AuditLogGetRequest
+	21, // 20: api.API.CanvasPixelsGet:input_type -> api.CanvasPixelsGetRequest
+	23, // 21: api.API.CanvasChunksGet:input_type -> api.CanvasChunksGetRequest
+	26, // 22: api.API.CanvasRegionGet:input_type -> api.CanvasRegionGetRequest
+	28, // 23: api.API.CanvasSubscribe:input_type -> api.CanvasSubscribeRequest
+	33, // 24: api.API.PixelPlace:input_type -> api.PixelPlaceRequest
+	35, // 25: api.API.PixelInfoGet:input_type -> api.PixelInfoGetRequest
+	1,  // 26: api.API.CanvasCreate:output_type -> api.CanvasCreateResponse
+	4,  // 27: api.API.CanvasList:output_type -> api.CanvasListResponse
+	7,  // 28: api.API.CanvasGet:output_type -> api.CanvasGetResponse
+	9,  // 29: api.API.CanvasArchive:output_type -> api.CanvasArchiveResponse
+	11, // 30: api.API.CanvasResize:output_type -> api.CanvasResizeResponse
+	13, // 31: api.API.ProtectedRegionCreate:output_type -> api.ProtectedRegionCreateResponse
+	15, // 32: api.API.ProtectedRegionDelete:output_type -> api.ProtectedRegionDeleteResponse
+	17, // 33: api.API.CanvasRollback:output_type -> api.CanvasRollbackResponse
+	19, // 34: api.API.AuditLogGet:output_type -> api.AuditLogGetResponse
+	22, // 35: api.API.CanvasPixelsGet:output_type -> api.CanvasPixelsGetResponse
+	24, // 36: api.API.CanvasChunksGet:output_type -> api.CanvasChunksGetResponse
+	27, // 37: api.API.CanvasRegionGet:output_type -> api.CanvasRegionGetResponse
+	29, // 38: api.API.CanvasSubscribe:output_type -> api.CanvasSubscribeResponse
+	34, // 39: api.API.PixelPlace:output_type -> api.PixelPlaceResponse
+	36, // 40: api.API.PixelInfoGet:output_type -> api.PixelInfoGetResponse
+	26, // [26:41] is the sub-list for method output_type
+	11, // [11:26] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_goagen_v1_api_proto_init() }
@@ -169,13 +3114,28 @@ func file_goagen_v1_api_proto_init() {
 	if File_goagen_v1_api_proto != nil {
 		return
 	}
+	file_goagen_v1_api_proto_msgTypes[1].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[2].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[3].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[5].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[7].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[9].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[10].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[11].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[12].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[13].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[16].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[18].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[23].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[28].OneofWrappers = []any{}
+	file_goagen_v1_api_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_v1_api_proto_rawDesc), len(file_goagen_v1_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
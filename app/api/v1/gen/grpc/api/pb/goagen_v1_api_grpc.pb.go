@@ -1,4 +1,4 @@
-// Code generated with goa v3.22.1, DO NOT EDIT.
+// Code generated with goa v3.22.6, DO NOT EDIT.
 //
 // api protocol buffer definition
 //
@@ -26,7 +26,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	API_CanvasGet_FullMethodName = "/api.API/CanvasGet"
+	API_CanvasCreate_FullMethodName          = "/api.API/CanvasCreate"
+	API_CanvasList_FullMethodName            = "/api.API/CanvasList"
+	API_CanvasGet_FullMethodName             = "/api.API/CanvasGet"
+	API_CanvasArchive_FullMethodName         = "/api.API/CanvasArchive"
+	API_CanvasResize_FullMethodName          = "/api.API/CanvasResize"
+	API_ProtectedRegionCreate_FullMethodName = "/api.API/ProtectedRegionCreate"
+	API_ProtectedRegionDelete_FullMethodName = "/api.API/ProtectedRegionDelete"
+	API_CanvasRollback_FullMethodName        = "/api.API/CanvasRollback"
+	API_AuditLogGet_FullMethodName           = "/api.API/AuditLogGet"
+	API_CanvasPixelsGet_FullMethodName       = "/api.API/CanvasPixelsGet"
+	API_CanvasChunksGet_FullMethodName       = "/api.API/CanvasChunksGet"
+	API_CanvasRegionGet_FullMethodName       = "/api.API/CanvasRegionGet"
+	API_CanvasSubscribe_FullMethodName       = "/api.API/CanvasSubscribe"
+	API_PixelPlace_FullMethodName            = "/api.API/PixelPlace"
+	API_PixelInfoGet_FullMethodName          = "/api.API/PixelInfoGet"
 )
 
 // APIClient is the client API for API service.
@@ -35,8 +49,45 @@ const (
 //
 // Service is the api service interface.
 type APIClient interface {
+	// CanvasCreate implements CanvasCreate.
+	CanvasCreate(ctx context.Context, in *CanvasCreateRequest, opts ...grpc.CallOption) (*CanvasCreateResponse, error)
+	// CanvasList implements CanvasList.
+	CanvasList(ctx context.Context, in *CanvasListRequest, opts ...grpc.CallOption) (*CanvasListResponse, error)
 	// CanvasGet implements CanvasGet.
 	CanvasGet(ctx context.Context, in *CanvasGetRequest, opts ...grpc.CallOption) (*CanvasGetResponse, error)
+	// Archive a canvas, after which it can still be viewed but no more pixels can
+	// be placed on it.
+	CanvasArchive(ctx context.Context, in *CanvasArchiveRequest, opts ...grpc.CallOption) (*CanvasArchiveResponse, error)
+	// Grow a canvas by a number of pixels on each side. Existing pixels keep their
+	// colors and history, but move right and down by the number of pixels added on
+	// the left and top.
+	CanvasResize(ctx context.Context, in *CanvasResizeRequest, opts ...grpc.CallOption) (*CanvasResizeResponse, error)
+	// Protect a region of a canvas, after which no pixels can be placed in it
+	// until it expires or is deleted.
+	ProtectedRegionCreate(ctx context.Context, in *ProtectedRegionCreateRequest, opts ...grpc.CallOption) (*ProtectedRegionCreateResponse, error)
+	// Delete a protected region of a canvas, after which pixels can be placed in
+	// it again.
+	ProtectedRegionDelete(ctx context.Context, in *ProtectedRegionDeleteRequest, opts ...grpc.CallOption) (*ProtectedRegionDeleteResponse, error)
+	// Revert the pixels last placed by a user, or within a region and time window,
+	// to their previous colors. Every filter that is set must match, and at least
+	// a user or a region must be set. Only placements since the canvas was last
+	// resized are reverted.
+	CanvasRollback(ctx context.Context, in *CanvasRollbackRequest, opts ...grpc.CallOption) (*CanvasRollbackResponse, error)
+	// List the latest actions taken by moderators on a canvas.
+	AuditLogGet(ctx context.Context, in *AuditLogGetRequest, opts ...grpc.CallOption) (*AuditLogGetResponse, error)
+	// CanvasPixelsGet implements CanvasPixelsGet.
+	CanvasPixelsGet(ctx context.Context, in *CanvasPixelsGetRequest, opts ...grpc.CallOption) (*CanvasPixelsGetResponse, error)
+	// Get the chunks of a canvas modified since a version, so that clients can
+	// refresh only what changed.
+	CanvasChunksGet(ctx context.Context, in *CanvasChunksGetRequest, opts ...grpc.CallOption) (*CanvasChunksGetResponse, error)
+	// CanvasRegionGet implements CanvasRegionGet.
+	CanvasRegionGet(ctx context.Context, in *CanvasRegionGetRequest, opts ...grpc.CallOption) (*CanvasRegionGetResponse, error)
+	// CanvasSubscribe implements CanvasSubscribe.
+	CanvasSubscribe(ctx context.Context, in *CanvasSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CanvasSubscribeResponse], error)
+	// PixelPlace implements PixelPlace.
+	PixelPlace(ctx context.Context, in *PixelPlaceRequest, opts ...grpc.CallOption) (*PixelPlaceResponse, error)
+	// PixelInfoGet implements PixelInfoGet.
+	PixelInfoGet(ctx context.Context, in *PixelInfoGetRequest, opts ...grpc.CallOption) (*PixelInfoGetResponse, error)
 }
 
 type aPIClient struct {
@@ -47,6 +98,26 @@ func NewAPIClient(cc grpc.ClientConnInterface) APIClient {
 	return &aPIClient{cc}
 }
 
+func (c *aPIClient) CanvasCreate(ctx context.Context, in *CanvasCreateRequest, opts ...grpc.CallOption) (*CanvasCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasCreateResponse)
+	err := c.cc.Invoke(ctx, API_CanvasCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CanvasList(ctx context.Context, in *CanvasListRequest, opts ...grpc.CallOption) (*CanvasListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasListResponse)
+	err := c.cc.Invoke(ctx, API_CanvasList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CanvasGet(ctx context.Context, in *CanvasGetRequest, opts ...grpc.CallOption) (*CanvasGetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CanvasGetResponse)
@@ -57,14 +128,180 @@ func (c *aPIClient) CanvasGet(ctx context.Context, in *CanvasGetRequest, opts ..
 	return out, nil
 }
 
+func (c *aPIClient) CanvasArchive(ctx context.Context, in *CanvasArchiveRequest, opts ...grpc.CallOption) (*CanvasArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasArchiveResponse)
+	err := c.cc.Invoke(ctx, API_CanvasArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CanvasResize(ctx context.Context, in *CanvasResizeRequest, opts ...grpc.CallOption) (*CanvasResizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasResizeResponse)
+	err := c.cc.Invoke(ctx, API_CanvasResize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ProtectedRegionCreate(ctx context.Context, in *ProtectedRegionCreateRequest, opts ...grpc.CallOption) (*ProtectedRegionCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProtectedRegionCreateResponse)
+	err := c.cc.Invoke(ctx, API_ProtectedRegionCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ProtectedRegionDelete(ctx context.Context, in *ProtectedRegionDeleteRequest, opts ...grpc.CallOption) (*ProtectedRegionDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProtectedRegionDeleteResponse)
+	err := c.cc.Invoke(ctx, API_ProtectedRegionDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CanvasRollback(ctx context.Context, in *CanvasRollbackRequest, opts ...grpc.CallOption) (*CanvasRollbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasRollbackResponse)
+	err := c.cc.Invoke(ctx, API_CanvasRollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AuditLogGet(ctx context.Context, in *AuditLogGetRequest, opts ...grpc.CallOption) (*AuditLogGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogGetResponse)
+	err := c.cc.Invoke(ctx, API_AuditLogGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CanvasPixelsGet(ctx context.Context, in *CanvasPixelsGetRequest, opts ...grpc.CallOption) (*CanvasPixelsGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasPixelsGetResponse)
+	err := c.cc.Invoke(ctx, API_CanvasPixelsGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CanvasChunksGet(ctx context.Context, in *CanvasChunksGetRequest, opts ...grpc.CallOption) (*CanvasChunksGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasChunksGetResponse)
+	err := c.cc.Invoke(ctx, API_CanvasChunksGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CanvasRegionGet(ctx context.Context, in *CanvasRegionGetRequest, opts ...grpc.CallOption) (*CanvasRegionGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanvasRegionGetResponse)
+	err := c.cc.Invoke(ctx, API_CanvasRegionGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CanvasSubscribe(ctx context.Context, in *CanvasSubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CanvasSubscribeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], API_CanvasSubscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CanvasSubscribeRequest, CanvasSubscribeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_CanvasSubscribeClient = grpc.ServerStreamingClient[CanvasSubscribeResponse]
+
+func (c *aPIClient) PixelPlace(ctx context.Context, in *PixelPlaceRequest, opts ...grpc.CallOption) (*PixelPlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PixelPlaceResponse)
+	err := c.cc.Invoke(ctx, API_PixelPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PixelInfoGet(ctx context.Context, in *PixelInfoGetRequest, opts ...grpc.CallOption) (*PixelInfoGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PixelInfoGetResponse)
+	err := c.cc.Invoke(ctx, API_PixelInfoGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility.
 //
 // Service is the api service interface.
 type APIServer interface {
+	// CanvasCreate implements CanvasCreate.
+	CanvasCreate(context.Context, *CanvasCreateRequest) (*CanvasCreateResponse, error)
+	// CanvasList implements CanvasList.
+	CanvasList(context.Context, *CanvasListRequest) (*CanvasListResponse, error)
 	// CanvasGet implements CanvasGet.
 	CanvasGet(context.Context, *CanvasGetRequest) (*CanvasGetResponse, error)
+	// Archive a canvas, after which it can still be viewed but no more pixels can
+	// be placed on it.
+	CanvasArchive(context.Context, *CanvasArchiveRequest) (*CanvasArchiveResponse, error)
+	// Grow a canvas by a number of pixels on each side. Existing pixels keep their
+	// colors and history, but move right and down by the number of pixels added on
+	// the left and top.
+	CanvasResize(context.Context, *CanvasResizeRequest) (*CanvasResizeResponse, error)
+	// Protect a region of a canvas, after which no pixels can be placed in it
+	// until it expires or is deleted.
+	ProtectedRegionCreate(context.Context, *ProtectedRegionCreateRequest) (*ProtectedRegionCreateResponse, error)
+	// Delete a protected region of a canvas, after which pixels can be placed in
+	// it again.
+	ProtectedRegionDelete(context.Context, *ProtectedRegionDeleteRequest) (*ProtectedRegionDeleteResponse, error)
+	// Revert the pixels last placed by a user, or within a region and time window,
+	// to their previous colors. Every filter that is set must match, and at least
+	// a user or a region must be set. Only placements since the canvas was last
+	// resized are reverted.
+	CanvasRollback(context.Context, *CanvasRollbackRequest) (*CanvasRollbackResponse, error)
+	// List the latest actions taken by moderators on a canvas.
+	AuditLogGet(context.Context, *AuditLogGetRequest) (*AuditLogGetResponse, error)
+	// CanvasPixelsGet implements CanvasPixelsGet.
+	CanvasPixelsGet(context.Context, *CanvasPixelsGetRequest) (*CanvasPixelsGetResponse, error)
+	// Get the chunks of a canvas modified since a version, so that clients can
+	// refresh only what changed.
+	CanvasChunksGet(context.Context, *CanvasChunksGetRequest) (*CanvasChunksGetResponse, error)
+	// CanvasRegionGet implements CanvasRegionGet.
+	CanvasRegionGet(context.Context, *CanvasRegionGetRequest) (*CanvasRegionGetResponse, error)
+	// CanvasSubscribe implements CanvasSubscribe.
+	CanvasSubscribe(*CanvasSubscribeRequest, grpc.ServerStreamingServer[CanvasSubscribeResponse]) error
+	// PixelPlace implements PixelPlace.
+	PixelPlace(context.Context, *PixelPlaceRequest) (*PixelPlaceResponse, error)
+	// PixelInfoGet implements PixelInfoGet.
+	PixelInfoGet(context.Context, *PixelInfoGetRequest) (*PixelInfoGetResponse, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -75,9 +312,51 @@ type APIServer interface {
 // pointer dereference when methods are called.
 type UnimplementedAPIServer struct{}
 
+func (UnimplementedAPIServer) CanvasCreate(context.Context, *CanvasCreateRequest) (*CanvasCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasCreate not implemented")
+}
+func (UnimplementedAPIServer) CanvasList(context.Context, *CanvasListRequest) (*CanvasListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasList not implemented")
+}
 func (UnimplementedAPIServer) CanvasGet(context.Context, *CanvasGetRequest) (*CanvasGetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CanvasGet not implemented")
 }
+func (UnimplementedAPIServer) CanvasArchive(context.Context, *CanvasArchiveRequest) (*CanvasArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasArchive not implemented")
+}
+func (UnimplementedAPIServer) CanvasResize(context.Context, *CanvasResizeRequest) (*CanvasResizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasResize not implemented")
+}
+func (UnimplementedAPIServer) ProtectedRegionCreate(context.Context, *ProtectedRegionCreateRequest) (*ProtectedRegionCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtectedRegionCreate not implemented")
+}
+func (UnimplementedAPIServer) ProtectedRegionDelete(context.Context, *ProtectedRegionDeleteRequest) (*ProtectedRegionDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtectedRegionDelete not implemented")
+}
+func (UnimplementedAPIServer) CanvasRollback(context.Context, *CanvasRollbackRequest) (*CanvasRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasRollback not implemented")
+}
+func (UnimplementedAPIServer) AuditLogGet(context.Context, *AuditLogGetRequest) (*AuditLogGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLogGet not implemented")
+}
+func (UnimplementedAPIServer) CanvasPixelsGet(context.Context, *CanvasPixelsGetRequest) (*CanvasPixelsGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasPixelsGet not implemented")
+}
+func (UnimplementedAPIServer) CanvasChunksGet(context.Context, *CanvasChunksGetRequest) (*CanvasChunksGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasChunksGet not implemented")
+}
+func (UnimplementedAPIServer) CanvasRegionGet(context.Context, *CanvasRegionGetRequest) (*CanvasRegionGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanvasRegionGet not implemented")
+}
+func (UnimplementedAPIServer) CanvasSubscribe(*CanvasSubscribeRequest, grpc.ServerStreamingServer[CanvasSubscribeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CanvasSubscribe not implemented")
+}
+func (UnimplementedAPIServer) PixelPlace(context.Context, *PixelPlaceRequest) (*PixelPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PixelPlace not implemented")
+}
+func (UnimplementedAPIServer) PixelInfoGet(context.Context, *PixelInfoGetRequest) (*PixelInfoGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PixelInfoGet not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 func (UnimplementedAPIServer) testEmbeddedByValue()             {}
 
@@ -99,6 +378,42 @@ func RegisterAPIServer(s grpc.ServiceRegistrar, srv APIServer) {
 	s.RegisterService(&API_ServiceDesc, srv)
 }
 
+func _API_CanvasCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasCreate(ctx, req.(*CanvasCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CanvasList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasList(ctx, req.(*CanvasListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CanvasGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CanvasGetRequest)
 	if err := dec(in); err != nil {
@@ -117,6 +432,215 @@ func _API_CanvasGet_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CanvasArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasArchive(ctx, req.(*CanvasArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CanvasResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasResizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasResize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasResize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasResize(ctx, req.(*CanvasResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ProtectedRegionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtectedRegionCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ProtectedRegionCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ProtectedRegionCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ProtectedRegionCreate(ctx, req.(*ProtectedRegionCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ProtectedRegionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtectedRegionDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ProtectedRegionDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ProtectedRegionDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ProtectedRegionDelete(ctx, req.(*ProtectedRegionDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CanvasRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasRollback(ctx, req.(*CanvasRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AuditLogGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AuditLogGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_AuditLogGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AuditLogGet(ctx, req.(*AuditLogGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CanvasPixelsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasPixelsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasPixelsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasPixelsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasPixelsGet(ctx, req.(*CanvasPixelsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CanvasChunksGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasChunksGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasChunksGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasChunksGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasChunksGet(ctx, req.(*CanvasChunksGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CanvasRegionGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanvasRegionGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CanvasRegionGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CanvasRegionGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CanvasRegionGet(ctx, req.(*CanvasRegionGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CanvasSubscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CanvasSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).CanvasSubscribe(m, &grpc.GenericServerStream[CanvasSubscribeRequest, CanvasSubscribeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_CanvasSubscribeServer = grpc.ServerStreamingServer[CanvasSubscribeResponse]
+
+func _API_PixelPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PixelPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PixelPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PixelPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PixelPlace(ctx, req.(*PixelPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PixelInfoGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PixelInfoGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PixelInfoGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_PixelInfoGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PixelInfoGet(ctx, req.(*PixelInfoGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -124,11 +648,69 @@ var API_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api.API",
 	HandlerType: (*APIServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CanvasCreate",
+			Handler:    _API_CanvasCreate_Handler,
+		},
+		{
+			MethodName: "CanvasList",
+			Handler:    _API_CanvasList_Handler,
+		},
 		{
 			MethodName: "CanvasGet",
 			Handler:    _API_CanvasGet_Handler,
 		},
+		{
+			MethodName: "CanvasArchive",
+			Handler:    _API_CanvasArchive_Handler,
+		},
+		{
+			MethodName: "CanvasResize",
+			Handler:    _API_CanvasResize_Handler,
+		},
+		{
+			MethodName: "ProtectedRegionCreate",
+			Handler:    _API_ProtectedRegionCreate_Handler,
+		},
+		{
+			MethodName: "ProtectedRegionDelete",
+			Handler:    _API_ProtectedRegionDelete_Handler,
+		},
+		{
+			MethodName: "CanvasRollback",
+			Handler:    _API_CanvasRollback_Handler,
+		},
+		{
+			MethodName: "AuditLogGet",
+			Handler:    _API_AuditLogGet_Handler,
+		},
+		{
+			MethodName: "CanvasPixelsGet",
+			Handler:    _API_CanvasPixelsGet_Handler,
+		},
+		{
+			MethodName: "CanvasChunksGet",
+			Handler:    _API_CanvasChunksGet_Handler,
+		},
+		{
+			MethodName: "CanvasRegionGet",
+			Handler:    _API_CanvasRegionGet_Handler,
+		},
+		{
+			MethodName: "PixelPlace",
+			Handler:    _API_PixelPlace_Handler,
+		},
+		{
+			MethodName: "PixelInfoGet",
+			Handler:    _API_PixelInfoGet_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CanvasSubscribe",
+			Handler:       _API_CanvasSubscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "goagen_v1_api.proto",
 }
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api gRPC server encoders and decoders
 //
@@ -9,12 +9,102 @@ package server
 
 import (
 	"context"
+	"strings"
 
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
+	apipb "github.com/jace-ys/pikcel/api/v1/gen/grpc/api/pb"
 	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
 	"google.golang.org/grpc/metadata"
 )
 
+// EncodeCanvasCreateResponse encodes responses from the "api" service
+// "CanvasCreate" endpoint.
+func EncodeCanvasCreateResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.Canvas)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasCreate", "*apiviews.Canvas", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasCreateResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasCreateRequest decodes requests sent to "api" service
+// "CanvasCreate" endpoint.
+func DecodeCanvasCreateRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.CanvasCreateRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasCreateRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasCreate", "*apipb.CanvasCreateRequest", v)
+		}
+		if err = ValidateCanvasCreateRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.CanvasCreatePayload
+	{
+		payload = NewCanvasCreatePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCanvasListResponse encodes responses from the "api" service
+// "CanvasList" endpoint.
+func EncodeCanvasListResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.Canvases)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasList", "*apiviews.Canvases", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasListResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasListRequest decodes requests sent to "api" service "CanvasList"
+// endpoint.
+func DecodeCanvasListRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *apipb.CanvasListRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasListRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasList", "*apipb.CanvasListRequest", v)
+		}
+	}
+	var payload *api.CanvasListPayload
+	{
+		payload = NewCanvasListPayload(message)
+	}
+	return payload, nil
+}
+
 // EncodeCanvasGetResponse encodes responses from the "api" service "CanvasGet"
 // endpoint.
 func EncodeCanvasGetResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
@@ -27,3 +117,558 @@ func EncodeCanvasGetResponse(ctx context.Context, v any, hdr, trlr *metadata.MD)
 	resp := NewProtoCanvasGetResponse(result)
 	return resp, nil
 }
+
+// DecodeCanvasGetRequest decodes requests sent to "api" service "CanvasGet"
+// endpoint.
+func DecodeCanvasGetRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *apipb.CanvasGetRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasGetRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasGet", "*apipb.CanvasGetRequest", v)
+		}
+	}
+	var payload *api.CanvasGetPayload
+	{
+		payload = NewCanvasGetPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeCanvasArchiveResponse encodes responses from the "api" service
+// "CanvasArchive" endpoint.
+func EncodeCanvasArchiveResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.Canvas)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasArchive", "*apiviews.Canvas", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasArchiveResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasArchiveRequest decodes requests sent to "api" service
+// "CanvasArchive" endpoint.
+func DecodeCanvasArchiveRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.CanvasArchiveRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasArchiveRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasArchive", "*apipb.CanvasArchiveRequest", v)
+		}
+	}
+	var payload *api.CanvasArchivePayload
+	{
+		payload = NewCanvasArchivePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCanvasResizeResponse encodes responses from the "api" service
+// "CanvasResize" endpoint.
+func EncodeCanvasResizeResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.Canvas)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasResize", "*apiviews.Canvas", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasResizeResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasResizeRequest decodes requests sent to "api" service
+// "CanvasResize" endpoint.
+func DecodeCanvasResizeRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.CanvasResizeRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasResizeRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasResize", "*apipb.CanvasResizeRequest", v)
+		}
+		if err = ValidateCanvasResizeRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.CanvasResizePayload
+	{
+		payload = NewCanvasResizePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeProtectedRegionCreateResponse encodes responses from the "api" service
+// "ProtectedRegionCreate" endpoint.
+func EncodeProtectedRegionCreateResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.ProtectedRegion)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "ProtectedRegionCreate", "*apiviews.ProtectedRegion", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoProtectedRegionCreateResponse(result)
+	return resp, nil
+}
+
+// DecodeProtectedRegionCreateRequest decodes requests sent to "api" service
+// "ProtectedRegionCreate" endpoint.
+func DecodeProtectedRegionCreateRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.ProtectedRegionCreateRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.ProtectedRegionCreateRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "ProtectedRegionCreate", "*apipb.ProtectedRegionCreateRequest", v)
+		}
+		if err = ValidateProtectedRegionCreateRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.ProtectedRegionCreatePayload
+	{
+		payload = NewProtectedRegionCreatePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeProtectedRegionDeleteResponse encodes responses from the "api" service
+// "ProtectedRegionDelete" endpoint.
+func EncodeProtectedRegionDeleteResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoProtectedRegionDeleteResponse()
+	return resp, nil
+}
+
+// DecodeProtectedRegionDeleteRequest decodes requests sent to "api" service
+// "ProtectedRegionDelete" endpoint.
+func DecodeProtectedRegionDeleteRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.ProtectedRegionDeleteRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.ProtectedRegionDeleteRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "ProtectedRegionDelete", "*apipb.ProtectedRegionDeleteRequest", v)
+		}
+	}
+	var payload *api.ProtectedRegionDeletePayload
+	{
+		payload = NewProtectedRegionDeletePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCanvasRollbackResponse encodes responses from the "api" service
+// "CanvasRollback" endpoint.
+func EncodeCanvasRollbackResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.Rollback)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasRollback", "*apiviews.Rollback", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasRollbackResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasRollbackRequest decodes requests sent to "api" service
+// "CanvasRollback" endpoint.
+func DecodeCanvasRollbackRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.CanvasRollbackRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasRollbackRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasRollback", "*apipb.CanvasRollbackRequest", v)
+		}
+		if err = ValidateCanvasRollbackRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.CanvasRollbackPayload
+	{
+		payload = NewCanvasRollbackPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeAuditLogGetResponse encodes responses from the "api" service
+// "AuditLogGet" endpoint.
+func EncodeAuditLogGetResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.AuditLog)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "AuditLogGet", "*apiviews.AuditLog", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoAuditLogGetResponse(result)
+	return resp, nil
+}
+
+// DecodeAuditLogGetRequest decodes requests sent to "api" service
+// "AuditLogGet" endpoint.
+func DecodeAuditLogGetRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.AuditLogGetRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.AuditLogGetRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "AuditLogGet", "*apipb.AuditLogGetRequest", v)
+		}
+		if err = ValidateAuditLogGetRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.AuditLogGetPayload
+	{
+		payload = NewAuditLogGetPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCanvasPixelsGetResponse encodes responses from the "api" service
+// "CanvasPixelsGet" endpoint.
+func EncodeCanvasPixelsGetResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.CanvasPixels)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasPixelsGet", "*apiviews.CanvasPixels", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasPixelsGetResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasPixelsGetRequest decodes requests sent to "api" service
+// "CanvasPixelsGet" endpoint.
+func DecodeCanvasPixelsGetRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *apipb.CanvasPixelsGetRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasPixelsGetRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasPixelsGet", "*apipb.CanvasPixelsGetRequest", v)
+		}
+	}
+	var payload *api.CanvasPixelsGetPayload
+	{
+		payload = NewCanvasPixelsGetPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeCanvasChunksGetResponse encodes responses from the "api" service
+// "CanvasChunksGet" endpoint.
+func EncodeCanvasChunksGetResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.CanvasChunks)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasChunksGet", "*apiviews.CanvasChunks", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasChunksGetResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasChunksGetRequest decodes requests sent to "api" service
+// "CanvasChunksGet" endpoint.
+func DecodeCanvasChunksGetRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *apipb.CanvasChunksGetRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasChunksGetRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasChunksGet", "*apipb.CanvasChunksGetRequest", v)
+		}
+		if err := ValidateCanvasChunksGetRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.CanvasChunksGetPayload
+	{
+		payload = NewCanvasChunksGetPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeCanvasRegionGetResponse encodes responses from the "api" service
+// "CanvasRegionGet" endpoint.
+func EncodeCanvasRegionGetResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.CanvasRegion)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasRegionGet", "*apiviews.CanvasRegion", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoCanvasRegionGetResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasRegionGetRequest decodes requests sent to "api" service
+// "CanvasRegionGet" endpoint.
+func DecodeCanvasRegionGetRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *apipb.CanvasRegionGetRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasRegionGetRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasRegionGet", "*apipb.CanvasRegionGetRequest", v)
+		}
+		if err := ValidateCanvasRegionGetRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.CanvasRegionGetPayload
+	{
+		payload = NewCanvasRegionGetPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeCanvasSubscribeResponse encodes responses from the "api" service
+// "CanvasSubscribe" endpoint.
+func EncodeCanvasSubscribeResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*api.CanvasEvent)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "CanvasSubscribe", "*api.CanvasEvent", v)
+	}
+	resp := NewProtoCanvasSubscribeResponse(result)
+	return resp, nil
+}
+
+// DecodeCanvasSubscribeRequest decodes requests sent to "api" service
+// "CanvasSubscribe" endpoint.
+func DecodeCanvasSubscribeRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *apipb.CanvasSubscribeRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.CanvasSubscribeRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "CanvasSubscribe", "*apipb.CanvasSubscribeRequest", v)
+		}
+		if err := ValidateCanvasSubscribeRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.CanvasSubscribePayload
+	{
+		payload = NewCanvasSubscribePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePixelPlaceResponse encodes responses from the "api" service
+// "PixelPlace" endpoint.
+func EncodePixelPlaceResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.Pixel)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "PixelPlace", "*apiviews.Pixel", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoPixelPlaceResponse(result)
+	return resp, nil
+}
+
+// DecodePixelPlaceRequest decodes requests sent to "api" service "PixelPlace"
+// endpoint.
+func DecodePixelPlaceRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *apipb.PixelPlaceRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.PixelPlaceRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "PixelPlace", "*apipb.PixelPlaceRequest", v)
+		}
+		if err = ValidatePixelPlaceRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.PixelPlacePayload
+	{
+		payload = NewPixelPlacePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodePixelInfoGetResponse encodes responses from the "api" service
+// "PixelInfoGet" endpoint.
+func EncodePixelInfoGetResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*apiviews.PixelInfo)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("api", "PixelInfoGet", "*apiviews.PixelInfo", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoPixelInfoGetResponse(result)
+	return resp, nil
+}
+
+// DecodePixelInfoGetRequest decodes requests sent to "api" service
+// "PixelInfoGet" endpoint.
+func DecodePixelInfoGetRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *apipb.PixelInfoGetRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*apipb.PixelInfoGetRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("api", "PixelInfoGet", "*apipb.PixelInfoGetRequest", v)
+		}
+		if err := ValidatePixelInfoGetRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *api.PixelInfoGetPayload
+	{
+		payload = NewPixelInfoGetPayload(message)
+	}
+	return payload, nil
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api gRPC server
 //
@@ -20,22 +20,119 @@ import (
 
 // Server implements the apipb.APIServer interface.
 type Server struct {
-	CanvasGetH goagrpc.UnaryHandler
+	CanvasCreateH          goagrpc.UnaryHandler
+	CanvasListH            goagrpc.UnaryHandler
+	CanvasGetH             goagrpc.UnaryHandler
+	CanvasArchiveH         goagrpc.UnaryHandler
+	CanvasResizeH          goagrpc.UnaryHandler
+	ProtectedRegionCreateH goagrpc.UnaryHandler
+	ProtectedRegionDeleteH goagrpc.UnaryHandler
+	CanvasRollbackH        goagrpc.UnaryHandler
+	AuditLogGetH           goagrpc.UnaryHandler
+	CanvasPixelsGetH       goagrpc.UnaryHandler
+	CanvasChunksGetH       goagrpc.UnaryHandler
+	CanvasRegionGetH       goagrpc.UnaryHandler
+	CanvasSubscribeH       goagrpc.StreamHandler
+	PixelPlaceH            goagrpc.UnaryHandler
+	PixelInfoGetH          goagrpc.UnaryHandler
 	apipb.UnimplementedAPIServer
 }
 
+// CanvasSubscribeServerStream implements the api.CanvasSubscribeServerStream
+// interface.
+type CanvasSubscribeServerStream struct {
+	stream apipb.API_CanvasSubscribeServer
+}
+
 // New instantiates the server struct with the api service endpoints.
-func New(e *api.Endpoints, uh goagrpc.UnaryHandler) *Server {
+func New(e *api.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
 	return &Server{
-		CanvasGetH: NewCanvasGetHandler(e.CanvasGet, uh),
+		CanvasCreateH:          NewCanvasCreateHandler(e.CanvasCreate, uh),
+		CanvasListH:            NewCanvasListHandler(e.CanvasList, uh),
+		CanvasGetH:             NewCanvasGetHandler(e.CanvasGet, uh),
+		CanvasArchiveH:         NewCanvasArchiveHandler(e.CanvasArchive, uh),
+		CanvasResizeH:          NewCanvasResizeHandler(e.CanvasResize, uh),
+		ProtectedRegionCreateH: NewProtectedRegionCreateHandler(e.ProtectedRegionCreate, uh),
+		ProtectedRegionDeleteH: NewProtectedRegionDeleteHandler(e.ProtectedRegionDelete, uh),
+		CanvasRollbackH:        NewCanvasRollbackHandler(e.CanvasRollback, uh),
+		AuditLogGetH:           NewAuditLogGetHandler(e.AuditLogGet, uh),
+		CanvasPixelsGetH:       NewCanvasPixelsGetHandler(e.CanvasPixelsGet, uh),
+		CanvasChunksGetH:       NewCanvasChunksGetHandler(e.CanvasChunksGet, uh),
+		CanvasRegionGetH:       NewCanvasRegionGetHandler(e.CanvasRegionGet, uh),
+		CanvasSubscribeH:       NewCanvasSubscribeHandler(e.CanvasSubscribe, sh),
+		PixelPlaceH:            NewPixelPlaceHandler(e.PixelPlace, uh),
+		PixelInfoGetH:          NewPixelInfoGetHandler(e.PixelInfoGet, uh),
+	}
+}
+
+// NewCanvasCreateHandler creates a gRPC handler which serves the "api" service
+// "CanvasCreate" endpoint.
+func NewCanvasCreateHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasCreateRequest, EncodeCanvasCreateResponse)
+	}
+	return h
+}
+
+// CanvasCreate implements the "CanvasCreate" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasCreate(ctx context.Context, message *apipb.CanvasCreateRequest) (*apipb.CanvasCreateResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasCreate")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasCreateH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.CanvasCreateResponse), nil
+}
+
+// NewCanvasListHandler creates a gRPC handler which serves the "api" service
+// "CanvasList" endpoint.
+func NewCanvasListHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasListRequest, EncodeCanvasListResponse)
+	}
+	return h
+}
+
+// CanvasList implements the "CanvasList" method in apipb.APIServer interface.
+func (s *Server) CanvasList(ctx context.Context, message *apipb.CanvasListRequest) (*apipb.CanvasListResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasList")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasListH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
 	}
+	return resp.(*apipb.CanvasListResponse), nil
 }
 
 // NewCanvasGetHandler creates a gRPC handler which serves the "api" service
 // "CanvasGet" endpoint.
 func NewCanvasGetHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
 	if h == nil {
-		h = goagrpc.NewUnaryHandler(endpoint, nil, EncodeCanvasGetResponse)
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasGetRequest, EncodeCanvasGetResponse)
 	}
 	return h
 }
@@ -53,9 +150,441 @@ func (s *Server) CanvasGet(ctx context.Context, message *apipb.CanvasGetRequest)
 				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
 			case "access_denied":
 				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*apipb.CanvasGetResponse), nil
 }
+
+// NewCanvasArchiveHandler creates a gRPC handler which serves the "api"
+// service "CanvasArchive" endpoint.
+func NewCanvasArchiveHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasArchiveRequest, EncodeCanvasArchiveResponse)
+	}
+	return h
+}
+
+// CanvasArchive implements the "CanvasArchive" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasArchive(ctx context.Context, message *apipb.CanvasArchiveRequest) (*apipb.CanvasArchiveResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasArchive")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasArchiveH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.CanvasArchiveResponse), nil
+}
+
+// NewCanvasResizeHandler creates a gRPC handler which serves the "api" service
+// "CanvasResize" endpoint.
+func NewCanvasResizeHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasResizeRequest, EncodeCanvasResizeResponse)
+	}
+	return h
+}
+
+// CanvasResize implements the "CanvasResize" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasResize(ctx context.Context, message *apipb.CanvasResizeRequest) (*apipb.CanvasResizeResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasResize")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasResizeH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "canvas_archived":
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.CanvasResizeResponse), nil
+}
+
+// NewProtectedRegionCreateHandler creates a gRPC handler which serves the
+// "api" service "ProtectedRegionCreate" endpoint.
+func NewProtectedRegionCreateHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeProtectedRegionCreateRequest, EncodeProtectedRegionCreateResponse)
+	}
+	return h
+}
+
+// ProtectedRegionCreate implements the "ProtectedRegionCreate" method in
+// apipb.APIServer interface.
+func (s *Server) ProtectedRegionCreate(ctx context.Context, message *apipb.ProtectedRegionCreateRequest) (*apipb.ProtectedRegionCreateResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ProtectedRegionCreate")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.ProtectedRegionCreateH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.ProtectedRegionCreateResponse), nil
+}
+
+// NewProtectedRegionDeleteHandler creates a gRPC handler which serves the
+// "api" service "ProtectedRegionDelete" endpoint.
+func NewProtectedRegionDeleteHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeProtectedRegionDeleteRequest, EncodeProtectedRegionDeleteResponse)
+	}
+	return h
+}
+
+// ProtectedRegionDelete implements the "ProtectedRegionDelete" method in
+// apipb.APIServer interface.
+func (s *Server) ProtectedRegionDelete(ctx context.Context, message *apipb.ProtectedRegionDeleteRequest) (*apipb.ProtectedRegionDeleteResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ProtectedRegionDelete")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.ProtectedRegionDeleteH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.ProtectedRegionDeleteResponse), nil
+}
+
+// NewCanvasRollbackHandler creates a gRPC handler which serves the "api"
+// service "CanvasRollback" endpoint.
+func NewCanvasRollbackHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasRollbackRequest, EncodeCanvasRollbackResponse)
+	}
+	return h
+}
+
+// CanvasRollback implements the "CanvasRollback" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasRollback(ctx context.Context, message *apipb.CanvasRollbackRequest) (*apipb.CanvasRollbackResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasRollback")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasRollbackH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "canvas_archived":
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.CanvasRollbackResponse), nil
+}
+
+// NewAuditLogGetHandler creates a gRPC handler which serves the "api" service
+// "AuditLogGet" endpoint.
+func NewAuditLogGetHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeAuditLogGetRequest, EncodeAuditLogGetResponse)
+	}
+	return h
+}
+
+// AuditLogGet implements the "AuditLogGet" method in apipb.APIServer interface.
+func (s *Server) AuditLogGet(ctx context.Context, message *apipb.AuditLogGetRequest) (*apipb.AuditLogGetResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "AuditLogGet")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.AuditLogGetH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.AuditLogGetResponse), nil
+}
+
+// NewCanvasPixelsGetHandler creates a gRPC handler which serves the "api"
+// service "CanvasPixelsGet" endpoint.
+func NewCanvasPixelsGetHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasPixelsGetRequest, EncodeCanvasPixelsGetResponse)
+	}
+	return h
+}
+
+// CanvasPixelsGet implements the "CanvasPixelsGet" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasPixelsGet(ctx context.Context, message *apipb.CanvasPixelsGetRequest) (*apipb.CanvasPixelsGetResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasPixelsGet")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasPixelsGetH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.CanvasPixelsGetResponse), nil
+}
+
+// NewCanvasChunksGetHandler creates a gRPC handler which serves the "api"
+// service "CanvasChunksGet" endpoint.
+func NewCanvasChunksGetHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasChunksGetRequest, EncodeCanvasChunksGetResponse)
+	}
+	return h
+}
+
+// CanvasChunksGet implements the "CanvasChunksGet" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasChunksGet(ctx context.Context, message *apipb.CanvasChunksGetRequest) (*apipb.CanvasChunksGetResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasChunksGet")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasChunksGetH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.CanvasChunksGetResponse), nil
+}
+
+// NewCanvasRegionGetHandler creates a gRPC handler which serves the "api"
+// service "CanvasRegionGet" endpoint.
+func NewCanvasRegionGetHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCanvasRegionGetRequest, EncodeCanvasRegionGetResponse)
+	}
+	return h
+}
+
+// CanvasRegionGet implements the "CanvasRegionGet" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasRegionGet(ctx context.Context, message *apipb.CanvasRegionGetRequest) (*apipb.CanvasRegionGetResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasRegionGet")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.CanvasRegionGetH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.CanvasRegionGetResponse), nil
+}
+
+// NewCanvasSubscribeHandler creates a gRPC handler which serves the "api"
+// service "CanvasSubscribe" endpoint.
+func NewCanvasSubscribeHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, DecodeCanvasSubscribeRequest)
+	}
+	return h
+}
+
+// CanvasSubscribe implements the "CanvasSubscribe" method in apipb.APIServer
+// interface.
+func (s *Server) CanvasSubscribe(message *apipb.CanvasSubscribeRequest, stream apipb.API_CanvasSubscribeServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "CanvasSubscribe")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	p, err := s.CanvasSubscribeH.Decode(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	ep := &api.CanvasSubscribeEndpointInput{
+		Stream:  &CanvasSubscribeServerStream{stream: stream},
+		Payload: p.(*api.CanvasSubscribePayload),
+	}
+	err = s.CanvasSubscribeH.Handle(ctx, ep)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// NewPixelPlaceHandler creates a gRPC handler which serves the "api" service
+// "PixelPlace" endpoint.
+func NewPixelPlaceHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePixelPlaceRequest, EncodePixelPlaceResponse)
+	}
+	return h
+}
+
+// PixelPlace implements the "PixelPlace" method in apipb.APIServer interface.
+func (s *Server) PixelPlace(ctx context.Context, message *apipb.PixelPlaceRequest) (*apipb.PixelPlaceResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "PixelPlace")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.PixelPlaceH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "cooldown_active":
+				var er *api.CooldownError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.ResourceExhausted, err, NewPixelPlaceCooldownActiveError(er))
+			case "canvas_archived":
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.PixelPlaceResponse), nil
+}
+
+// NewPixelInfoGetHandler creates a gRPC handler which serves the "api" service
+// "PixelInfoGet" endpoint.
+func NewPixelInfoGetHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePixelInfoGetRequest, EncodePixelInfoGetResponse)
+	}
+	return h
+}
+
+// PixelInfoGet implements the "PixelInfoGet" method in apipb.APIServer
+// interface.
+func (s *Server) PixelInfoGet(ctx context.Context, message *apipb.PixelInfoGetRequest) (*apipb.PixelInfoGetResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "PixelInfoGet")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+	resp, err := s.PixelInfoGetH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "access_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*apipb.PixelInfoGetResponse), nil
+}
+
+// Send streams instances of "apipb.CanvasSubscribeResponse" to the
+// "CanvasSubscribe" endpoint gRPC stream.
+func (s *CanvasSubscribeServerStream) Send(res *api.CanvasEvent) error {
+	v := NewProtoCanvasEventCanvasSubscribeResponse(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "apipb.CanvasSubscribeResponse" to the
+// "CanvasSubscribe" endpoint gRPC stream with context.
+func (s *CanvasSubscribeServerStream) SendWithContext(ctx context.Context, res *api.CanvasEvent) error {
+	return s.Send(res)
+}
+
+func (s *CanvasSubscribeServerStream) Close() error {
+	// nothing to do here
+	return nil
+}
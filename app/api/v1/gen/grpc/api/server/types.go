@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api gRPC server types
 //
@@ -8,17 +8,914 @@
 package server
 
 import (
+	"unicode/utf8"
+
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
 	apipb "github.com/jace-ys/pikcel/api/v1/gen/grpc/api/pb"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// NewCanvasCreatePayload builds the payload of the "CanvasCreate" endpoint of
+// the "api" service from the gRPC request type.
+func NewCanvasCreatePayload(message *apipb.CanvasCreateRequest, token string) *api.CanvasCreatePayload {
+	v := &api.CanvasCreatePayload{
+		Width:  message.Width,
+		Height: message.Height,
+	}
+	if message.Palette != nil {
+		v.Palette = make([]string, len(message.Palette))
+		for i, val := range message.Palette {
+			v.Palette[i] = val
+		}
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCanvasCreateResponse builds the gRPC response type from the result
+// of the "CanvasCreate" endpoint of the "api" service.
+func NewProtoCanvasCreateResponse(result *apiviews.CanvasView) *apipb.CanvasCreateResponse {
+	message := &apipb.CanvasCreateResponse{
+		Id:         *result.ID,
+		Width:      *result.Width,
+		Height:     *result.Height,
+		CreatedAt:  *result.CreatedAt,
+		ArchivedAt: result.ArchivedAt,
+		Version:    *result.Version,
+	}
+	if result.Palette != nil {
+		message.Palette = make([]string, len(result.Palette))
+		for i, val := range result.Palette {
+			message.Palette[i] = val
+		}
+	}
+	if result.ProtectedRegions != nil {
+		message.ProtectedRegions = make([]*apipb.ProtectedRegion, len(result.ProtectedRegions))
+		for i, val := range result.ProtectedRegions {
+			message.ProtectedRegions[i] = &apipb.ProtectedRegion{
+				Id:        *val.ID,
+				X:         *val.X,
+				Y:         *val.Y,
+				Width:     *val.Width,
+				Height:    *val.Height,
+				Reason:    *val.Reason,
+				CreatedAt: *val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewCanvasListPayload builds the payload of the "CanvasList" endpoint of the
+// "api" service from the gRPC request type.
+func NewCanvasListPayload(message *apipb.CanvasListRequest) *api.CanvasListPayload {
+	v := &api.CanvasListPayload{}
+	if message.IncludeArchived != nil {
+		v.IncludeArchived = *message.IncludeArchived
+	}
+	if message.IncludeArchived == nil {
+		v.IncludeArchived = false
+	}
+	return v
+}
+
+// NewProtoCanvasListResponse builds the gRPC response type from the result of
+// the "CanvasList" endpoint of the "api" service.
+func NewProtoCanvasListResponse(result *apiviews.CanvasesView) *apipb.CanvasListResponse {
+	message := &apipb.CanvasListResponse{}
+	if result.Canvases != nil {
+		message.Canvases = make([]*apipb.Canvas, len(result.Canvases))
+		for i, val := range result.Canvases {
+			message.Canvases[i] = &apipb.Canvas{
+				Id:         *val.ID,
+				Width:      *val.Width,
+				Height:     *val.Height,
+				CreatedAt:  *val.CreatedAt,
+				ArchivedAt: val.ArchivedAt,
+				Version:    *val.Version,
+			}
+			if val.Palette != nil {
+				message.Canvases[i].Palette = make([]string, len(val.Palette))
+				for j, val := range val.Palette {
+					message.Canvases[i].Palette[j] = val
+				}
+			}
+			if val.ProtectedRegions != nil {
+				message.Canvases[i].ProtectedRegions = make([]*apipb.ProtectedRegion, len(val.ProtectedRegions))
+				for j, val := range val.ProtectedRegions {
+					message.Canvases[i].ProtectedRegions[j] = &apipb.ProtectedRegion{
+						Id:        *val.ID,
+						X:         *val.X,
+						Y:         *val.Y,
+						Width:     *val.Width,
+						Height:    *val.Height,
+						Reason:    *val.Reason,
+						CreatedAt: *val.CreatedAt,
+						ExpiresAt: val.ExpiresAt,
+					}
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewCanvasGetPayload builds the payload of the "CanvasGet" endpoint of the
+// "api" service from the gRPC request type.
+func NewCanvasGetPayload(message *apipb.CanvasGetRequest) *api.CanvasGetPayload {
+	v := &api.CanvasGetPayload{
+		ID: message.Id,
+	}
+	return v
+}
+
 // NewProtoCanvasGetResponse builds the gRPC response type from the result of
 // the "CanvasGet" endpoint of the "api" service.
 func NewProtoCanvasGetResponse(result *apiviews.CanvasView) *apipb.CanvasGetResponse {
 	message := &apipb.CanvasGetResponse{
-		Id:     *result.ID,
+		Id:         *result.ID,
+		Width:      *result.Width,
+		Height:     *result.Height,
+		CreatedAt:  *result.CreatedAt,
+		ArchivedAt: result.ArchivedAt,
+		Version:    *result.Version,
+	}
+	if result.Palette != nil {
+		message.Palette = make([]string, len(result.Palette))
+		for i, val := range result.Palette {
+			message.Palette[i] = val
+		}
+	}
+	if result.ProtectedRegions != nil {
+		message.ProtectedRegions = make([]*apipb.ProtectedRegion, len(result.ProtectedRegions))
+		for i, val := range result.ProtectedRegions {
+			message.ProtectedRegions[i] = &apipb.ProtectedRegion{
+				Id:        *val.ID,
+				X:         *val.X,
+				Y:         *val.Y,
+				Width:     *val.Width,
+				Height:    *val.Height,
+				Reason:    *val.Reason,
+				CreatedAt: *val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewCanvasArchivePayload builds the payload of the "CanvasArchive" endpoint
+// of the "api" service from the gRPC request type.
+func NewCanvasArchivePayload(message *apipb.CanvasArchiveRequest, token string) *api.CanvasArchivePayload {
+	v := &api.CanvasArchivePayload{
+		ID: message.Id,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCanvasArchiveResponse builds the gRPC response type from the result
+// of the "CanvasArchive" endpoint of the "api" service.
+func NewProtoCanvasArchiveResponse(result *apiviews.CanvasView) *apipb.CanvasArchiveResponse {
+	message := &apipb.CanvasArchiveResponse{
+		Id:         *result.ID,
+		Width:      *result.Width,
+		Height:     *result.Height,
+		CreatedAt:  *result.CreatedAt,
+		ArchivedAt: result.ArchivedAt,
+		Version:    *result.Version,
+	}
+	if result.Palette != nil {
+		message.Palette = make([]string, len(result.Palette))
+		for i, val := range result.Palette {
+			message.Palette[i] = val
+		}
+	}
+	if result.ProtectedRegions != nil {
+		message.ProtectedRegions = make([]*apipb.ProtectedRegion, len(result.ProtectedRegions))
+		for i, val := range result.ProtectedRegions {
+			message.ProtectedRegions[i] = &apipb.ProtectedRegion{
+				Id:        *val.ID,
+				X:         *val.X,
+				Y:         *val.Y,
+				Width:     *val.Width,
+				Height:    *val.Height,
+				Reason:    *val.Reason,
+				CreatedAt: *val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewCanvasResizePayload builds the payload of the "CanvasResize" endpoint of
+// the "api" service from the gRPC request type.
+func NewCanvasResizePayload(message *apipb.CanvasResizeRequest, token string) *api.CanvasResizePayload {
+	v := &api.CanvasResizePayload{
+		ID: message.Id,
+	}
+	if message.Left != nil {
+		v.Left = *message.Left
+	}
+	if message.Top != nil {
+		v.Top = *message.Top
+	}
+	if message.Right != nil {
+		v.Right = *message.Right
+	}
+	if message.Bottom != nil {
+		v.Bottom = *message.Bottom
+	}
+	if message.Fill != nil {
+		v.Fill = *message.Fill
+	}
+	if message.Left == nil {
+		v.Left = 0
+	}
+	if message.Top == nil {
+		v.Top = 0
+	}
+	if message.Right == nil {
+		v.Right = 0
+	}
+	if message.Bottom == nil {
+		v.Bottom = 0
+	}
+	if message.Fill == nil {
+		v.Fill = 0
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCanvasResizeResponse builds the gRPC response type from the result
+// of the "CanvasResize" endpoint of the "api" service.
+func NewProtoCanvasResizeResponse(result *apiviews.CanvasView) *apipb.CanvasResizeResponse {
+	message := &apipb.CanvasResizeResponse{
+		Id:         *result.ID,
+		Width:      *result.Width,
+		Height:     *result.Height,
+		CreatedAt:  *result.CreatedAt,
+		ArchivedAt: result.ArchivedAt,
+		Version:    *result.Version,
+	}
+	if result.Palette != nil {
+		message.Palette = make([]string, len(result.Palette))
+		for i, val := range result.Palette {
+			message.Palette[i] = val
+		}
+	}
+	if result.ProtectedRegions != nil {
+		message.ProtectedRegions = make([]*apipb.ProtectedRegion, len(result.ProtectedRegions))
+		for i, val := range result.ProtectedRegions {
+			message.ProtectedRegions[i] = &apipb.ProtectedRegion{
+				Id:        *val.ID,
+				X:         *val.X,
+				Y:         *val.Y,
+				Width:     *val.Width,
+				Height:    *val.Height,
+				Reason:    *val.Reason,
+				CreatedAt: *val.CreatedAt,
+				ExpiresAt: val.ExpiresAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewProtectedRegionCreatePayload builds the payload of the
+// "ProtectedRegionCreate" endpoint of the "api" service from the gRPC request
+// type.
+func NewProtectedRegionCreatePayload(message *apipb.ProtectedRegionCreateRequest, token string) *api.ProtectedRegionCreatePayload {
+	v := &api.ProtectedRegionCreatePayload{
+		X:        message.X,
+		Y:        message.Y,
+		Width:    message.Width,
+		Height:   message.Height,
+		Reason:   message.Reason,
+		Duration: message.Duration,
+		ID:       message.Id,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoProtectedRegionCreateResponse builds the gRPC response type from the
+// result of the "ProtectedRegionCreate" endpoint of the "api" service.
+func NewProtoProtectedRegionCreateResponse(result *apiviews.ProtectedRegionView) *apipb.ProtectedRegionCreateResponse {
+	message := &apipb.ProtectedRegionCreateResponse{
+		Id:        *result.ID,
+		X:         *result.X,
+		Y:         *result.Y,
+		Width:     *result.Width,
+		Height:    *result.Height,
+		Reason:    *result.Reason,
+		CreatedAt: *result.CreatedAt,
+		ExpiresAt: result.ExpiresAt,
+	}
+	return message
+}
+
+// NewProtectedRegionDeletePayload builds the payload of the
+// "ProtectedRegionDelete" endpoint of the "api" service from the gRPC request
+// type.
+func NewProtectedRegionDeletePayload(message *apipb.ProtectedRegionDeleteRequest, token string) *api.ProtectedRegionDeletePayload {
+	v := &api.ProtectedRegionDeletePayload{
+		ID:       message.Id,
+		RegionID: message.RegionId,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoProtectedRegionDeleteResponse builds the gRPC response type from the
+// result of the "ProtectedRegionDelete" endpoint of the "api" service.
+func NewProtoProtectedRegionDeleteResponse() *apipb.ProtectedRegionDeleteResponse {
+	message := &apipb.ProtectedRegionDeleteResponse{}
+	return message
+}
+
+// NewCanvasRollbackPayload builds the payload of the "CanvasRollback" endpoint
+// of the "api" service from the gRPC request type.
+func NewCanvasRollbackPayload(message *apipb.CanvasRollbackRequest, token string) *api.CanvasRollbackPayload {
+	v := &api.CanvasRollbackPayload{
+		ID:     message.Id,
+		UserID: message.UserId,
+		X:      message.X,
+		Y:      message.Y,
+		Width:  message.Width,
+		Height: message.Height,
+		From:   message.From,
+		To:     message.To,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCanvasRollbackResponse builds the gRPC response type from the result
+// of the "CanvasRollback" endpoint of the "api" service.
+func NewProtoCanvasRollbackResponse(result *apiviews.RollbackView) *apipb.CanvasRollbackResponse {
+	message := &apipb.CanvasRollbackResponse{
+		Reverted: *result.Reverted,
+	}
+	return message
+}
+
+// NewAuditLogGetPayload builds the payload of the "AuditLogGet" endpoint of
+// the "api" service from the gRPC request type.
+func NewAuditLogGetPayload(message *apipb.AuditLogGetRequest, token string) *api.AuditLogGetPayload {
+	v := &api.AuditLogGetPayload{
+		ID: message.Id,
+	}
+	if message.Limit != nil {
+		v.Limit = *message.Limit
+	}
+	if message.Limit == nil {
+		v.Limit = 50
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoAuditLogGetResponse builds the gRPC response type from the result of
+// the "AuditLogGet" endpoint of the "api" service.
+func NewProtoAuditLogGetResponse(result *apiviews.AuditLogView) *apipb.AuditLogGetResponse {
+	message := &apipb.AuditLogGetResponse{}
+	if result.Entries != nil {
+		message.Entries = make([]*apipb.AuditEntry, len(result.Entries))
+		for i, val := range result.Entries {
+			message.Entries[i] = &apipb.AuditEntry{
+				Action:    *val.Action,
+				UserId:    *val.UserID,
+				Details:   *val.Details,
+				CreatedAt: *val.CreatedAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewCanvasPixelsGetPayload builds the payload of the "CanvasPixelsGet"
+// endpoint of the "api" service from the gRPC request type.
+func NewCanvasPixelsGetPayload(message *apipb.CanvasPixelsGetRequest) *api.CanvasPixelsGetPayload {
+	v := &api.CanvasPixelsGetPayload{
+		ID: message.Id,
+	}
+	return v
+}
+
+// NewProtoCanvasPixelsGetResponse builds the gRPC response type from the
+// result of the "CanvasPixelsGet" endpoint of the "api" service.
+func NewProtoCanvasPixelsGetResponse(result *apiviews.CanvasPixelsView) *apipb.CanvasPixelsGetResponse {
+	message := &apipb.CanvasPixelsGetResponse{
 		Width:  *result.Width,
 		Height: *result.Height,
+		Pixels: result.Pixels,
+	}
+	return message
+}
+
+// NewCanvasChunksGetPayload builds the payload of the "CanvasChunksGet"
+// endpoint of the "api" service from the gRPC request type.
+func NewCanvasChunksGetPayload(message *apipb.CanvasChunksGetRequest) *api.CanvasChunksGetPayload {
+	v := &api.CanvasChunksGetPayload{
+		ID: message.Id,
+	}
+	if message.Since != nil {
+		v.Since = *message.Since
+	}
+	if message.Since == nil {
+		v.Since = 0
+	}
+	return v
+}
+
+// NewProtoCanvasChunksGetResponse builds the gRPC response type from the
+// result of the "CanvasChunksGet" endpoint of the "api" service.
+func NewProtoCanvasChunksGetResponse(result *apiviews.CanvasChunksView) *apipb.CanvasChunksGetResponse {
+	message := &apipb.CanvasChunksGetResponse{
+		Version:   *result.Version,
+		ChunkSize: *result.ChunkSize,
+	}
+	if result.Chunks != nil {
+		message.Chunks = make([]*apipb.CanvasChunk, len(result.Chunks))
+		for i, val := range result.Chunks {
+			message.Chunks[i] = &apipb.CanvasChunk{
+				X:       *val.X,
+				Y:       *val.Y,
+				Width:   *val.Width,
+				Height:  *val.Height,
+				Version: *val.Version,
+				Pixels:  val.Pixels,
+			}
+		}
+	}
+	return message
+}
+
+// NewCanvasRegionGetPayload builds the payload of the "CanvasRegionGet"
+// endpoint of the "api" service from the gRPC request type.
+func NewCanvasRegionGetPayload(message *apipb.CanvasRegionGetRequest) *api.CanvasRegionGetPayload {
+	v := &api.CanvasRegionGetPayload{
+		X:      message.X,
+		Y:      message.Y,
+		Width:  message.Width,
+		Height: message.Height,
+		ID:     message.Id,
+	}
+	return v
+}
+
+// NewProtoCanvasRegionGetResponse builds the gRPC response type from the
+// result of the "CanvasRegionGet" endpoint of the "api" service.
+func NewProtoCanvasRegionGetResponse(result *apiviews.CanvasRegionView) *apipb.CanvasRegionGetResponse {
+	message := &apipb.CanvasRegionGetResponse{
+		X:      *result.X,
+		Y:      *result.Y,
+		Width:  *result.Width,
+		Height: *result.Height,
+		Pixels: result.Pixels,
+	}
+	return message
+}
+
+// NewCanvasSubscribePayload builds the payload of the "CanvasSubscribe"
+// endpoint of the "api" service from the gRPC request type.
+func NewCanvasSubscribePayload(message *apipb.CanvasSubscribeRequest) *api.CanvasSubscribePayload {
+	v := &api.CanvasSubscribePayload{
+		ID:          message.Id,
+		Since:       message.Since,
+		LastEventID: message.LastEventId,
+	}
+	return v
+}
+
+// NewProtoCanvasSubscribeResponse builds the gRPC response type from the
+// result of the "CanvasSubscribe" endpoint of the "api" service.
+func NewProtoCanvasSubscribeResponse(result *api.CanvasEvent) *apipb.CanvasSubscribeResponse {
+	message := &apipb.CanvasSubscribeResponse{
+		Id:   result.ID,
+		Type: result.Type,
+	}
+	if result.Pixel != nil {
+		message.Pixel = svcAPIPixelEventToApipbPixelEvent(result.Pixel)
+	}
+	if result.Snapshot != nil {
+		message.Snapshot = svcAPICanvasSnapshotToApipbCanvasSnapshot(result.Snapshot)
 	}
 	return message
 }
+
+func NewProtoCanvasEventCanvasSubscribeResponse(result *api.CanvasEvent) *apipb.CanvasSubscribeResponse {
+	v := &apipb.CanvasSubscribeResponse{
+		Id:   result.ID,
+		Type: result.Type,
+	}
+	if result.Pixel != nil {
+		v.Pixel = svcAPIPixelEventToApipbPixelEvent(result.Pixel)
+	}
+	if result.Snapshot != nil {
+		v.Snapshot = svcAPICanvasSnapshotToApipbCanvasSnapshot(result.Snapshot)
+	}
+	return v
+}
+
+// NewPixelPlacePayload builds the payload of the "PixelPlace" endpoint of the
+// "api" service from the gRPC request type.
+func NewPixelPlacePayload(message *apipb.PixelPlaceRequest, token string) *api.PixelPlacePayload {
+	v := &api.PixelPlacePayload{
+		X:     message.X,
+		Y:     message.Y,
+		Color: message.Color,
+		ID:    message.Id,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoPixelPlaceResponse builds the gRPC response type from the result of
+// the "PixelPlace" endpoint of the "api" service.
+func NewProtoPixelPlaceResponse(result *apiviews.PixelView) *apipb.PixelPlaceResponse {
+	message := &apipb.PixelPlaceResponse{
+		X:     *result.X,
+		Y:     *result.Y,
+		Color: *result.Color,
+	}
+	return message
+}
+
+// NewPixelPlaceCooldownActiveError builds the gRPC error response type from
+// the error of the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceCooldownActiveError(er *api.CooldownError) *apipb.PixelPlaceCooldownActiveError {
+	message := &apipb.PixelPlaceCooldownActiveError{
+		Message_:   er.Message,
+		RetryAfter: er.RetryAfter,
+	}
+	return message
+}
+
+// NewPixelInfoGetPayload builds the payload of the "PixelInfoGet" endpoint of
+// the "api" service from the gRPC request type.
+func NewPixelInfoGetPayload(message *apipb.PixelInfoGetRequest) *api.PixelInfoGetPayload {
+	v := &api.PixelInfoGetPayload{
+		X:  message.X,
+		Y:  message.Y,
+		ID: message.Id,
+	}
+	if message.Limit != nil {
+		v.Limit = *message.Limit
+	}
+	if message.Limit == nil {
+		v.Limit = 10
+	}
+	return v
+}
+
+// NewProtoPixelInfoGetResponse builds the gRPC response type from the result
+// of the "PixelInfoGet" endpoint of the "api" service.
+func NewProtoPixelInfoGetResponse(result *apiviews.PixelInfoView) *apipb.PixelInfoGetResponse {
+	message := &apipb.PixelInfoGetResponse{
+		X: *result.X,
+		Y: *result.Y,
+	}
+	if result.Placements != nil {
+		message.Placements = make([]*apipb.PixelHistoryEntry, len(result.Placements))
+		for i, val := range result.Placements {
+			message.Placements[i] = &apipb.PixelHistoryEntry{
+				UserId:   *val.UserID,
+				Color:    *val.Color,
+				PlacedAt: *val.PlacedAt,
+				Seq:      *val.Seq,
+			}
+		}
+	}
+	return message
+}
+
+// ValidateCanvasCreateRequest runs the validations defined on
+// CanvasCreateRequest.
+func ValidateCanvasCreateRequest(message *apipb.CanvasCreateRequest) (err error) {
+	if message.Width < 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", message.Width, 1, true))
+	}
+	if message.Width > 2048 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", message.Width, 2048, false))
+	}
+	if message.Height < 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", message.Height, 1, true))
+	}
+	if message.Height > 2048 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", message.Height, 2048, false))
+	}
+	if len(message.Palette) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.palette", message.Palette, len(message.Palette), 256, false))
+	}
+	for _, e := range message.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("message.palette[*]", e, "^#[0-9A-Fa-f]{6}$"))
+	}
+	return
+}
+
+// ValidateCanvasResizeRequest runs the validations defined on
+// CanvasResizeRequest.
+func ValidateCanvasResizeRequest(message *apipb.CanvasResizeRequest) (err error) {
+	if message.Left != nil {
+		if *message.Left < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.left", *message.Left, 0, true))
+		}
+	}
+	if message.Left != nil {
+		if *message.Left > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.left", *message.Left, 2048, false))
+		}
+	}
+	if message.Top != nil {
+		if *message.Top < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.top", *message.Top, 0, true))
+		}
+	}
+	if message.Top != nil {
+		if *message.Top > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.top", *message.Top, 2048, false))
+		}
+	}
+	if message.Right != nil {
+		if *message.Right < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.right", *message.Right, 0, true))
+		}
+	}
+	if message.Right != nil {
+		if *message.Right > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.right", *message.Right, 2048, false))
+		}
+	}
+	if message.Bottom != nil {
+		if *message.Bottom < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.bottom", *message.Bottom, 0, true))
+		}
+	}
+	if message.Bottom != nil {
+		if *message.Bottom > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.bottom", *message.Bottom, 2048, false))
+		}
+	}
+	if message.Fill != nil {
+		if *message.Fill < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.fill", *message.Fill, 0, true))
+		}
+	}
+	if message.Fill != nil {
+		if *message.Fill > 255 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.fill", *message.Fill, 255, false))
+		}
+	}
+	return
+}
+
+// ValidateProtectedRegionCreateRequest runs the validations defined on
+// ProtectedRegionCreateRequest.
+func ValidateProtectedRegionCreateRequest(message *apipb.ProtectedRegionCreateRequest) (err error) {
+	if message.X < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.x", message.X, 0, true))
+	}
+	if message.Y < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.y", message.Y, 0, true))
+	}
+	if message.Width < 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", message.Width, 1, true))
+	}
+	if message.Width > 2048 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", message.Width, 2048, false))
+	}
+	if message.Height < 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", message.Height, 1, true))
+	}
+	if message.Height > 2048 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", message.Height, 2048, false))
+	}
+	if message.Reason != nil {
+		if utf8.RuneCountInString(*message.Reason) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.reason", *message.Reason, utf8.RuneCountInString(*message.Reason), 200, false))
+		}
+	}
+	if message.Duration != nil {
+		if *message.Duration < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.duration", *message.Duration, 1, true))
+		}
+	}
+	return
+}
+
+// ValidateCanvasRollbackRequest runs the validations defined on
+// CanvasRollbackRequest.
+func ValidateCanvasRollbackRequest(message *apipb.CanvasRollbackRequest) (err error) {
+	if message.X != nil {
+		if *message.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.x", *message.X, 0, true))
+		}
+	}
+	if message.Y != nil {
+		if *message.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.y", *message.Y, 0, true))
+		}
+	}
+	if message.Width != nil {
+		if *message.Width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", *message.Width, 1, true))
+		}
+	}
+	if message.Width != nil {
+		if *message.Width > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", *message.Width, 2048, false))
+		}
+	}
+	if message.Height != nil {
+		if *message.Height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", *message.Height, 1, true))
+		}
+	}
+	if message.Height != nil {
+		if *message.Height > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", *message.Height, 2048, false))
+		}
+	}
+	if message.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.from", *message.From, goa.FormatDateTime))
+	}
+	if message.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.to", *message.To, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAuditLogGetRequest runs the validations defined on
+// AuditLogGetRequest.
+func ValidateAuditLogGetRequest(message *apipb.AuditLogGetRequest) (err error) {
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateCanvasChunksGetRequest runs the validations defined on
+// CanvasChunksGetRequest.
+func ValidateCanvasChunksGetRequest(message *apipb.CanvasChunksGetRequest) (err error) {
+	if message.Since != nil {
+		if *message.Since < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.since", *message.Since, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateCanvasRegionGetRequest runs the validations defined on
+// CanvasRegionGetRequest.
+func ValidateCanvasRegionGetRequest(message *apipb.CanvasRegionGetRequest) (err error) {
+	if message.X < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.x", message.X, 0, true))
+	}
+	if message.Y < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.y", message.Y, 0, true))
+	}
+	if message.Width < 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", message.Width, 1, true))
+	}
+	if message.Width > 256 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.width", message.Width, 256, false))
+	}
+	if message.Height < 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", message.Height, 1, true))
+	}
+	if message.Height > 256 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.height", message.Height, 256, false))
+	}
+	return
+}
+
+// ValidateCanvasSubscribeRequest runs the validations defined on
+// CanvasSubscribeRequest.
+func ValidateCanvasSubscribeRequest(message *apipb.CanvasSubscribeRequest) (err error) {
+	if message.Since != nil {
+		if *message.Since < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.since", *message.Since, 0, true))
+		}
+	}
+	return
+}
+
+// ValidatePixelPlaceRequest runs the validations defined on PixelPlaceRequest.
+func ValidatePixelPlaceRequest(message *apipb.PixelPlaceRequest) (err error) {
+	if message.X < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.x", message.X, 0, true))
+	}
+	if message.Y < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.y", message.Y, 0, true))
+	}
+	if message.Color < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.color", message.Color, 0, true))
+	}
+	if message.Color > 255 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.color", message.Color, 255, false))
+	}
+	return
+}
+
+// ValidatePixelInfoGetRequest runs the validations defined on
+// PixelInfoGetRequest.
+func ValidatePixelInfoGetRequest(message *apipb.PixelInfoGetRequest) (err error) {
+	if message.X < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.x", message.X, 0, true))
+	}
+	if message.Y < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.y", message.Y, 0, true))
+	}
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 100, false))
+		}
+	}
+	return
+}
+
+// svcAPIPixelEventToApipbPixelEvent builds a value of type *apipb.PixelEvent
+// from a value of type *api.PixelEvent.
+func svcAPIPixelEventToApipbPixelEvent(v *api.PixelEvent) *apipb.PixelEvent {
+	if v == nil {
+		return nil
+	}
+	res := &apipb.PixelEvent{
+		X:        v.X,
+		Y:        v.Y,
+		Color:    v.Color,
+		UserId:   v.UserID,
+		PlacedAt: v.PlacedAt,
+		Seq:      v.Seq,
+	}
+
+	return res
+}
+
+// svcAPICanvasSnapshotToApipbCanvasSnapshot builds a value of type
+// *apipb.CanvasSnapshot from a value of type *api.CanvasSnapshot.
+func svcAPICanvasSnapshotToApipbCanvasSnapshot(v *api.CanvasSnapshot) *apipb.CanvasSnapshot {
+	if v == nil {
+		return nil
+	}
+	res := &apipb.CanvasSnapshot{
+		Seq:    v.Seq,
+		Width:  v.Width,
+		Height: v.Height,
+		Pixels: v.Pixels,
+	}
+
+	return res
+}
+
+// protobufApipbPixelEventToAPIPixelEvent builds a value of type
+// *api.PixelEvent from a value of type *apipb.PixelEvent.
+func protobufApipbPixelEventToAPIPixelEvent(v *apipb.PixelEvent) *api.PixelEvent {
+	if v == nil {
+		return nil
+	}
+	res := &api.PixelEvent{
+		X:        v.X,
+		Y:        v.Y,
+		Color:    v.Color,
+		UserID:   v.UserId,
+		PlacedAt: v.PlacedAt,
+		Seq:      v.Seq,
+	}
+
+	return res
+}
+
+// protobufApipbCanvasSnapshotToAPICanvasSnapshot builds a value of type
+// *api.CanvasSnapshot from a value of type *apipb.CanvasSnapshot.
+func protobufApipbCanvasSnapshotToAPICanvasSnapshot(v *apipb.CanvasSnapshot) *api.CanvasSnapshot {
+	if v == nil {
+		return nil
+	}
+	res := &api.CanvasSnapshot{
+		Seq:    v.Seq,
+		Width:  v.Width,
+		Height: v.Height,
+		Pixels: v.Pixels,
+	}
+
+	return res
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // pikcel gRPC client CLI support package
 //
@@ -22,13 +22,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"api canvas-get",
+		"api (canvas-create|canvas-list|canvas-get|canvas-archive|canvas-resize|protected-region-create|protected-region-delete|canvas-rollback|audit-log-get|canvas-pixels-get|canvas-chunks-get|canvas-region-get|canvas-subscribe|pixel-place|pixel-info-get)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` api canvas-get` + "\n" +
+	return os.Args[0] + " " + "api canvas-create --message '{\n      \"height\": 1237,\n      \"palette\": [\n         \"#a567d4\",\n         \"#9e76C0\",\n         \"#AfbBC5\"\n      ],\n      \"width\": 1514\n   }' --token \"Architecto provident reprehenderit.\"" + "\n" +
 		""
 }
 
@@ -41,10 +41,75 @@ func ParseEndpoint(
 	var (
 		apiFlags = flag.NewFlagSet("api", flag.ContinueOnError)
 
-		apiCanvasGetFlags = flag.NewFlagSet("canvas-get", flag.ExitOnError)
+		apiCanvasCreateFlags       = flag.NewFlagSet("canvas-create", flag.ExitOnError)
+		apiCanvasCreateMessageFlag = apiCanvasCreateFlags.String("message", "", "")
+		apiCanvasCreateTokenFlag   = apiCanvasCreateFlags.String("token", "REQUIRED", "")
+
+		apiCanvasListFlags       = flag.NewFlagSet("canvas-list", flag.ExitOnError)
+		apiCanvasListMessageFlag = apiCanvasListFlags.String("message", "", "")
+
+		apiCanvasGetFlags       = flag.NewFlagSet("canvas-get", flag.ExitOnError)
+		apiCanvasGetMessageFlag = apiCanvasGetFlags.String("message", "", "")
+
+		apiCanvasArchiveFlags       = flag.NewFlagSet("canvas-archive", flag.ExitOnError)
+		apiCanvasArchiveMessageFlag = apiCanvasArchiveFlags.String("message", "", "")
+		apiCanvasArchiveTokenFlag   = apiCanvasArchiveFlags.String("token", "REQUIRED", "")
+
+		apiCanvasResizeFlags       = flag.NewFlagSet("canvas-resize", flag.ExitOnError)
+		apiCanvasResizeMessageFlag = apiCanvasResizeFlags.String("message", "", "")
+		apiCanvasResizeTokenFlag   = apiCanvasResizeFlags.String("token", "REQUIRED", "")
+
+		apiProtectedRegionCreateFlags       = flag.NewFlagSet("protected-region-create", flag.ExitOnError)
+		apiProtectedRegionCreateMessageFlag = apiProtectedRegionCreateFlags.String("message", "", "")
+		apiProtectedRegionCreateTokenFlag   = apiProtectedRegionCreateFlags.String("token", "REQUIRED", "")
+
+		apiProtectedRegionDeleteFlags       = flag.NewFlagSet("protected-region-delete", flag.ExitOnError)
+		apiProtectedRegionDeleteMessageFlag = apiProtectedRegionDeleteFlags.String("message", "", "")
+		apiProtectedRegionDeleteTokenFlag   = apiProtectedRegionDeleteFlags.String("token", "REQUIRED", "")
+
+		apiCanvasRollbackFlags       = flag.NewFlagSet("canvas-rollback", flag.ExitOnError)
+		apiCanvasRollbackMessageFlag = apiCanvasRollbackFlags.String("message", "", "")
+		apiCanvasRollbackTokenFlag   = apiCanvasRollbackFlags.String("token", "REQUIRED", "")
+
+		apiAuditLogGetFlags       = flag.NewFlagSet("audit-log-get", flag.ExitOnError)
+		apiAuditLogGetMessageFlag = apiAuditLogGetFlags.String("message", "", "")
+		apiAuditLogGetTokenFlag   = apiAuditLogGetFlags.String("token", "REQUIRED", "")
+
+		apiCanvasPixelsGetFlags       = flag.NewFlagSet("canvas-pixels-get", flag.ExitOnError)
+		apiCanvasPixelsGetMessageFlag = apiCanvasPixelsGetFlags.String("message", "", "")
+
+		apiCanvasChunksGetFlags       = flag.NewFlagSet("canvas-chunks-get", flag.ExitOnError)
+		apiCanvasChunksGetMessageFlag = apiCanvasChunksGetFlags.String("message", "", "")
+
+		apiCanvasRegionGetFlags       = flag.NewFlagSet("canvas-region-get", flag.ExitOnError)
+		apiCanvasRegionGetMessageFlag = apiCanvasRegionGetFlags.String("message", "", "")
+
+		apiCanvasSubscribeFlags       = flag.NewFlagSet("canvas-subscribe", flag.ExitOnError)
+		apiCanvasSubscribeMessageFlag = apiCanvasSubscribeFlags.String("message", "", "")
+
+		apiPixelPlaceFlags       = flag.NewFlagSet("pixel-place", flag.ExitOnError)
+		apiPixelPlaceMessageFlag = apiPixelPlaceFlags.String("message", "", "")
+		apiPixelPlaceTokenFlag   = apiPixelPlaceFlags.String("token", "REQUIRED", "")
+
+		apiPixelInfoGetFlags       = flag.NewFlagSet("pixel-info-get", flag.ExitOnError)
+		apiPixelInfoGetMessageFlag = apiPixelInfoGetFlags.String("message", "", "")
 	)
 	apiFlags.Usage = apiUsage
+	apiCanvasCreateFlags.Usage = apiCanvasCreateUsage
+	apiCanvasListFlags.Usage = apiCanvasListUsage
 	apiCanvasGetFlags.Usage = apiCanvasGetUsage
+	apiCanvasArchiveFlags.Usage = apiCanvasArchiveUsage
+	apiCanvasResizeFlags.Usage = apiCanvasResizeUsage
+	apiProtectedRegionCreateFlags.Usage = apiProtectedRegionCreateUsage
+	apiProtectedRegionDeleteFlags.Usage = apiProtectedRegionDeleteUsage
+	apiCanvasRollbackFlags.Usage = apiCanvasRollbackUsage
+	apiAuditLogGetFlags.Usage = apiAuditLogGetUsage
+	apiCanvasPixelsGetFlags.Usage = apiCanvasPixelsGetUsage
+	apiCanvasChunksGetFlags.Usage = apiCanvasChunksGetUsage
+	apiCanvasRegionGetFlags.Usage = apiCanvasRegionGetUsage
+	apiCanvasSubscribeFlags.Usage = apiCanvasSubscribeUsage
+	apiPixelPlaceFlags.Usage = apiPixelPlaceUsage
+	apiPixelInfoGetFlags.Usage = apiPixelInfoGetUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -80,9 +145,51 @@ func ParseEndpoint(
 		switch svcn {
 		case "api":
 			switch epn {
+			case "canvas-create":
+				epf = apiCanvasCreateFlags
+
+			case "canvas-list":
+				epf = apiCanvasListFlags
+
 			case "canvas-get":
 				epf = apiCanvasGetFlags
 
+			case "canvas-archive":
+				epf = apiCanvasArchiveFlags
+
+			case "canvas-resize":
+				epf = apiCanvasResizeFlags
+
+			case "protected-region-create":
+				epf = apiProtectedRegionCreateFlags
+
+			case "protected-region-delete":
+				epf = apiProtectedRegionDeleteFlags
+
+			case "canvas-rollback":
+				epf = apiCanvasRollbackFlags
+
+			case "audit-log-get":
+				epf = apiAuditLogGetFlags
+
+			case "canvas-pixels-get":
+				epf = apiCanvasPixelsGetFlags
+
+			case "canvas-chunks-get":
+				epf = apiCanvasChunksGetFlags
+
+			case "canvas-region-get":
+				epf = apiCanvasRegionGetFlags
+
+			case "canvas-subscribe":
+				epf = apiCanvasSubscribeFlags
+
+			case "pixel-place":
+				epf = apiPixelPlaceFlags
+
+			case "pixel-info-get":
+				epf = apiPixelInfoGetFlags
+
 			}
 
 		}
@@ -108,8 +215,51 @@ func ParseEndpoint(
 		case "api":
 			c := apic.NewClient(cc, opts...)
 			switch epn {
+			case "canvas-create":
+				endpoint = c.CanvasCreate()
+				data, err = apic.BuildCanvasCreatePayload(*apiCanvasCreateMessageFlag, *apiCanvasCreateTokenFlag)
+			case "canvas-list":
+				endpoint = c.CanvasList()
+				data, err = apic.BuildCanvasListPayload(*apiCanvasListMessageFlag)
 			case "canvas-get":
 				endpoint = c.CanvasGet()
+				data, err = apic.BuildCanvasGetPayload(*apiCanvasGetMessageFlag)
+			case "canvas-archive":
+				endpoint = c.CanvasArchive()
+				data, err = apic.BuildCanvasArchivePayload(*apiCanvasArchiveMessageFlag, *apiCanvasArchiveTokenFlag)
+			case "canvas-resize":
+				endpoint = c.CanvasResize()
+				data, err = apic.BuildCanvasResizePayload(*apiCanvasResizeMessageFlag, *apiCanvasResizeTokenFlag)
+			case "protected-region-create":
+				endpoint = c.ProtectedRegionCreate()
+				data, err = apic.BuildProtectedRegionCreatePayload(*apiProtectedRegionCreateMessageFlag, *apiProtectedRegionCreateTokenFlag)
+			case "protected-region-delete":
+				endpoint = c.ProtectedRegionDelete()
+				data, err = apic.BuildProtectedRegionDeletePayload(*apiProtectedRegionDeleteMessageFlag, *apiProtectedRegionDeleteTokenFlag)
+			case "canvas-rollback":
+				endpoint = c.CanvasRollback()
+				data, err = apic.BuildCanvasRollbackPayload(*apiCanvasRollbackMessageFlag, *apiCanvasRollbackTokenFlag)
+			case "audit-log-get":
+				endpoint = c.AuditLogGet()
+				data, err = apic.BuildAuditLogGetPayload(*apiAuditLogGetMessageFlag, *apiAuditLogGetTokenFlag)
+			case "canvas-pixels-get":
+				endpoint = c.CanvasPixelsGet()
+				data, err = apic.BuildCanvasPixelsGetPayload(*apiCanvasPixelsGetMessageFlag)
+			case "canvas-chunks-get":
+				endpoint = c.CanvasChunksGet()
+				data, err = apic.BuildCanvasChunksGetPayload(*apiCanvasChunksGetMessageFlag)
+			case "canvas-region-get":
+				endpoint = c.CanvasRegionGet()
+				data, err = apic.BuildCanvasRegionGetPayload(*apiCanvasRegionGetMessageFlag)
+			case "canvas-subscribe":
+				endpoint = c.CanvasSubscribe()
+				data, err = apic.BuildCanvasSubscribePayload(*apiCanvasSubscribeMessageFlag)
+			case "pixel-place":
+				endpoint = c.PixelPlace()
+				data, err = apic.BuildPixelPlacePayload(*apiPixelPlaceMessageFlag, *apiPixelPlaceTokenFlag)
+			case "pixel-info-get":
+				endpoint = c.PixelInfoGet()
+				data, err = apic.BuildPixelInfoGetPayload(*apiPixelInfoGetMessageFlag)
 			}
 		}
 	}
@@ -122,23 +272,310 @@ func ParseEndpoint(
 
 // apiUsage displays the usage of the api command and its subcommands.
 func apiUsage() {
-	fmt.Fprintf(os.Stderr, `Service is the api service interface.
-Usage:
-    %[1]s [globalflags] api COMMAND [flags]
+	fmt.Fprintln(os.Stderr, `Service is the api service interface.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] api COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    canvas-create: CanvasCreate implements CanvasCreate.`)
+	fmt.Fprintln(os.Stderr, `    canvas-list: CanvasList implements CanvasList.`)
+	fmt.Fprintln(os.Stderr, `    canvas-get: CanvasGet implements CanvasGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-archive: Archive a canvas, after which it can still be viewed but no more pixels can be placed on it.`)
+	fmt.Fprintln(os.Stderr, `    canvas-resize: Grow a canvas by a number of pixels on each side. Existing pixels keep their colors and history, but move right and down by the number of pixels added on the left and top.`)
+	fmt.Fprintln(os.Stderr, `    protected-region-create: Protect a region of a canvas, after which no pixels can be placed in it until it expires or is deleted.`)
+	fmt.Fprintln(os.Stderr, `    protected-region-delete: Delete a protected region of a canvas, after which pixels can be placed in it again.`)
+	fmt.Fprintln(os.Stderr, `    canvas-rollback: Revert the pixels last placed by a user, or within a region and time window, to their previous colors. Every filter that is set must match, and at least a user or a region must be set. Only placements since the canvas was last resized are reverted.`)
+	fmt.Fprintln(os.Stderr, `    audit-log-get: List the latest actions taken by moderators on a canvas.`)
+	fmt.Fprintln(os.Stderr, `    canvas-pixels-get: CanvasPixelsGet implements CanvasPixelsGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-chunks-get: Get the chunks of a canvas modified since a version, so that clients can refresh only what changed.`)
+	fmt.Fprintln(os.Stderr, `    canvas-region-get: CanvasRegionGet implements CanvasRegionGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-subscribe: CanvasSubscribe implements CanvasSubscribe.`)
+	fmt.Fprintln(os.Stderr, `    pixel-place: PixelPlace implements PixelPlace.`)
+	fmt.Fprintln(os.Stderr, `    pixel-info-get: PixelInfoGet implements PixelInfoGet.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s api COMMAND --help\n", os.Args[0])
+}
+func apiCanvasCreateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-create", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasCreate implements CanvasCreate.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
 
-COMMAND:
-    canvas-get: CanvasGet implements CanvasGet.
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-create --message '{\n      \"height\": 1237,\n      \"palette\": [\n         \"#a567d4\",\n         \"#9e76C0\",\n         \"#AfbBC5\"\n      ],\n      \"width\": 1514\n   }' --token \"Architecto provident reprehenderit.\"")
+}
+
+func apiCanvasListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasList implements CanvasList.`)
 
-Additional help:
-    %[1]s api COMMAND --help
-`, os.Args[0])
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-list --message '{\n      \"include_archived\": true\n   }'")
 }
+
 func apiCanvasGetUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] api canvas-get
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasGet implements CanvasGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-get --message '{\n      \"id\": \"Fugiat fuga ducimus ex ad voluptatem optio.\"\n   }'")
+}
+
+func apiCanvasArchiveUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-archive", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Archive a canvas, after which it can still be viewed but no more pixels can be placed on it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-archive --message '{\n      \"id\": \"Quo harum nostrum provident tenetur itaque.\"\n   }' --token \"Perspiciatis repellat.\"")
+}
+
+func apiCanvasResizeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-resize", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Grow a canvas by a number of pixels on each side. Existing pixels keep their colors and history, but move right and down by the number of pixels added on the left and top.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-resize --message '{\n      \"bottom\": 1552,\n      \"fill\": 137,\n      \"id\": \"Consequatur assumenda dolores.\",\n      \"left\": 1082,\n      \"right\": 1232,\n      \"top\": 725\n   }' --token \"Minus molestias.\"")
+}
+
+func apiProtectedRegionCreateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api protected-region-create", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Protect a region of a canvas, after which no pixels can be placed in it until it expires or is deleted.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api protected-region-create --message '{\n      \"duration\": 958887467,\n      \"height\": 1522,\n      \"id\": \"Nulla accusantium.\",\n      \"reason\": \"mqu\",\n      \"width\": 1949,\n      \"x\": 929686738,\n      \"y\": 1753071713\n   }' --token \"Sed voluptatum dolore vitae rerum earum nihil.\"")
+}
+
+func apiProtectedRegionDeleteUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api protected-region-delete", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a protected region of a canvas, after which pixels can be placed in it again.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api protected-region-delete --message '{\n      \"id\": \"Perspiciatis inventore accusamus ea neque et nam.\",\n      \"region_id\": \"Veritatis eum accusantium.\"\n   }' --token \"Deleniti placeat sunt maxime rem cum quas.\"")
+}
+
+func apiCanvasRollbackUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-rollback", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revert the pixels last placed by a user, or within a region and time window, to their previous colors. Every filter that is set must match, and at least a user or a region must be set. Only placements since the canvas was last resized are reverted.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-rollback --message '{\n      \"from\": \"2002-12-14T21:08:14Z\",\n      \"height\": 1423,\n      \"id\": \"Consequatur et ratione voluptas tenetur.\",\n      \"to\": \"2008-09-08T07:24:16Z\",\n      \"user_id\": \"Consequatur tempora et voluptatem culpa sint id.\",\n      \"width\": 4,\n      \"x\": 1684008707,\n      \"y\": 760536883\n   }' --token \"Sed sapiente nulla voluptas nam delectus dolores.\"")
+}
+
+func apiAuditLogGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api audit-log-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the latest actions taken by moderators on a canvas.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api audit-log-get --message '{\n      \"id\": \"Ad ea minima et suscipit ipsam hic.\",\n      \"limit\": 210\n   }' --token \"Assumenda laudantium non et.\"")
+}
+
+func apiCanvasPixelsGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-pixels-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasPixelsGet implements CanvasPixelsGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-pixels-get --message '{\n      \"id\": \"Animi ea ipsa qui fugit.\"\n   }'")
+}
+
+func apiCanvasChunksGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-chunks-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the chunks of a canvas modified since a version, so that clients can refresh only what changed.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-chunks-get --message '{\n      \"id\": \"Quos quod aut repellendus ducimus omnis quos.\",\n      \"since\": 5583211389885068421\n   }'")
+}
+
+func apiCanvasRegionGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-region-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasRegionGet implements CanvasRegionGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-region-get --message '{\n      \"height\": 33,\n      \"id\": \"Dolore sint facere autem necessitatibus cupiditate accusamus.\",\n      \"width\": 182,\n      \"x\": 1437784298,\n      \"y\": 836809175\n   }'")
+}
+
+func apiCanvasSubscribeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-subscribe", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasSubscribe implements CanvasSubscribe.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-subscribe --message '{\n      \"id\": \"Alias voluptas eos.\",\n      \"last_event_id\": \"Recusandae eaque esse hic.\",\n      \"since\": 8301777832450915402\n   }'")
+}
+
+func apiPixelPlaceUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api pixel-place", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `PixelPlace implements PixelPlace.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api pixel-place --message '{\n      \"color\": 1,\n      \"id\": \"Voluptas veniam rerum nobis.\",\n      \"x\": 1374627332,\n      \"y\": 260654209\n   }' --token \"Et rerum fuga perferendis ducimus nobis eaque.\"")
+}
+
+func apiPixelInfoGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api pixel-info-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `PixelInfoGet implements PixelInfoGet.`)
 
-CanvasGet implements CanvasGet.
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
 
-Example:
-    %[1]s api canvas-get
-`, os.Args[0])
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api pixel-info-get --message '{\n      \"id\": \"Inventore dolore fuga non accusantium.\",\n      \"limit\": 57,\n      \"x\": 1135277294,\n      \"y\": 1483820484\n   }'")
 }
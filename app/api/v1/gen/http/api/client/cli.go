@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api HTTP client CLI support package
 //
@@ -6,3 +6,912 @@
 // $ goa gen github.com/jace-ys/pikcel/api/v1 -o api/v1
 
 package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCanvasCreatePayload builds the payload for the api CanvasCreate
+// endpoint from CLI flags.
+func BuildCanvasCreatePayload(apiCanvasCreateBody string, apiCanvasCreateToken string) (*api.CanvasCreatePayload, error) {
+	var err error
+	var body CanvasCreateRequestBody
+	{
+		err = json.Unmarshal([]byte(apiCanvasCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"height\": 1824,\n      \"palette\": [\n         \"#Bd04a9\",\n         \"#1dB02f\",\n         \"#F25271\"\n      ],\n      \"width\": 926\n   }'")
+		}
+		if body.Width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", body.Width, 1, true))
+		}
+		if body.Width > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", body.Width, 2048, false))
+		}
+		if body.Height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", body.Height, 1, true))
+		}
+		if body.Height > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", body.Height, 2048, false))
+		}
+		if len(body.Palette) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.palette", body.Palette, len(body.Palette), 256, false))
+		}
+		for _, e := range body.Palette {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.palette[*]", e, "^#[0-9A-Fa-f]{6}$"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = apiCanvasCreateToken
+	}
+	v := &api.CanvasCreatePayload{
+		Width:  body.Width,
+		Height: body.Height,
+	}
+	if body.Palette != nil {
+		v.Palette = make([]string, len(body.Palette))
+		for i, val := range body.Palette {
+			v.Palette[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasListPayload builds the payload for the api CanvasList endpoint
+// from CLI flags.
+func BuildCanvasListPayload(apiCanvasListIncludeArchived string) (*api.CanvasListPayload, error) {
+	var err error
+	var includeArchived bool
+	{
+		if apiCanvasListIncludeArchived != "" {
+			includeArchived, err = strconv.ParseBool(apiCanvasListIncludeArchived)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeArchived, must be BOOL")
+			}
+		}
+	}
+	v := &api.CanvasListPayload{}
+	v.IncludeArchived = includeArchived
+
+	return v, nil
+}
+
+// BuildCanvasGetPayload builds the payload for the api CanvasGet endpoint from
+// CLI flags.
+func BuildCanvasGetPayload(apiCanvasGetID string) (*api.CanvasGetPayload, error) {
+	var id string
+	{
+		id = apiCanvasGetID
+	}
+	v := &api.CanvasGetPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildCanvasArchivePayload builds the payload for the api CanvasArchive
+// endpoint from CLI flags.
+func BuildCanvasArchivePayload(apiCanvasArchiveID string, apiCanvasArchiveToken string) (*api.CanvasArchivePayload, error) {
+	var id string
+	{
+		id = apiCanvasArchiveID
+	}
+	var token string
+	{
+		token = apiCanvasArchiveToken
+	}
+	v := &api.CanvasArchivePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasResizePayload builds the payload for the api CanvasResize
+// endpoint from CLI flags.
+func BuildCanvasResizePayload(apiCanvasResizeBody string, apiCanvasResizeID string, apiCanvasResizeToken string) (*api.CanvasResizePayload, error) {
+	var err error
+	var body CanvasResizeRequestBody
+	{
+		err = json.Unmarshal([]byte(apiCanvasResizeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"bottom\": 587,\n      \"fill\": 215,\n      \"left\": 331,\n      \"right\": 566,\n      \"top\": 1623\n   }'")
+		}
+		if body.Left < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.left", body.Left, 0, true))
+		}
+		if body.Left > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.left", body.Left, 2048, false))
+		}
+		if body.Top < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top", body.Top, 0, true))
+		}
+		if body.Top > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top", body.Top, 2048, false))
+		}
+		if body.Right < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.right", body.Right, 0, true))
+		}
+		if body.Right > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.right", body.Right, 2048, false))
+		}
+		if body.Bottom < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.bottom", body.Bottom, 0, true))
+		}
+		if body.Bottom > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.bottom", body.Bottom, 2048, false))
+		}
+		if body.Fill < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.fill", body.Fill, 0, true))
+		}
+		if body.Fill > 255 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.fill", body.Fill, 255, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = apiCanvasResizeID
+	}
+	var token string
+	{
+		token = apiCanvasResizeToken
+	}
+	v := &api.CanvasResizePayload{
+		Left:   body.Left,
+		Top:    body.Top,
+		Right:  body.Right,
+		Bottom: body.Bottom,
+		Fill:   body.Fill,
+	}
+	{
+		var zero int32
+		if v.Left == zero {
+			v.Left = 0
+		}
+	}
+	{
+		var zero int32
+		if v.Top == zero {
+			v.Top = 0
+		}
+	}
+	{
+		var zero int32
+		if v.Right == zero {
+			v.Right = 0
+		}
+	}
+	{
+		var zero int32
+		if v.Bottom == zero {
+			v.Bottom = 0
+		}
+	}
+	{
+		var zero int32
+		if v.Fill == zero {
+			v.Fill = 0
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildProtectedRegionCreatePayload builds the payload for the api
+// ProtectedRegionCreate endpoint from CLI flags.
+func BuildProtectedRegionCreatePayload(apiProtectedRegionCreateBody string, apiProtectedRegionCreateID string, apiProtectedRegionCreateToken string) (*api.ProtectedRegionCreatePayload, error) {
+	var err error
+	var body ProtectedRegionCreateRequestBody
+	{
+		err = json.Unmarshal([]byte(apiProtectedRegionCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"duration\": 1840362032,\n      \"height\": 1102,\n      \"reason\": \"db4\",\n      \"width\": 1458,\n      \"x\": 1288742254,\n      \"y\": 1085671537\n   }'")
+		}
+		if body.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", body.X, 0, true))
+		}
+		if body.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", body.Y, 0, true))
+		}
+		if body.Width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", body.Width, 1, true))
+		}
+		if body.Width > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", body.Width, 2048, false))
+		}
+		if body.Height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", body.Height, 1, true))
+		}
+		if body.Height > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", body.Height, 2048, false))
+		}
+		if body.Reason != nil {
+			if utf8.RuneCountInString(*body.Reason) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 200, false))
+			}
+		}
+		if body.Duration != nil {
+			if *body.Duration < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = apiProtectedRegionCreateID
+	}
+	var token string
+	{
+		token = apiProtectedRegionCreateToken
+	}
+	v := &api.ProtectedRegionCreatePayload{
+		X:        body.X,
+		Y:        body.Y,
+		Width:    body.Width,
+		Height:   body.Height,
+		Reason:   body.Reason,
+		Duration: body.Duration,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildProtectedRegionDeletePayload builds the payload for the api
+// ProtectedRegionDelete endpoint from CLI flags.
+func BuildProtectedRegionDeletePayload(apiProtectedRegionDeleteID string, apiProtectedRegionDeleteRegionID string, apiProtectedRegionDeleteToken string) (*api.ProtectedRegionDeletePayload, error) {
+	var id string
+	{
+		id = apiProtectedRegionDeleteID
+	}
+	var regionID string
+	{
+		regionID = apiProtectedRegionDeleteRegionID
+	}
+	var token string
+	{
+		token = apiProtectedRegionDeleteToken
+	}
+	v := &api.ProtectedRegionDeletePayload{}
+	v.ID = id
+	v.RegionID = regionID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasRollbackPayload builds the payload for the api CanvasRollback
+// endpoint from CLI flags.
+func BuildCanvasRollbackPayload(apiCanvasRollbackBody string, apiCanvasRollbackID string, apiCanvasRollbackToken string) (*api.CanvasRollbackPayload, error) {
+	var err error
+	var body CanvasRollbackRequestBody
+	{
+		err = json.Unmarshal([]byte(apiCanvasRollbackBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"from\": \"2004-06-09T21:55:02Z\",\n      \"height\": 589,\n      \"to\": \"1976-02-24T11:19:54Z\",\n      \"user_id\": \"Ut tenetur voluptatum sint.\",\n      \"width\": 21,\n      \"x\": 74661222,\n      \"y\": 1961545439\n   }'")
+		}
+		if body.X != nil {
+			if *body.X < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", *body.X, 0, true))
+			}
+		}
+		if body.Y != nil {
+			if *body.Y < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", *body.Y, 0, true))
+			}
+		}
+		if body.Width != nil {
+			if *body.Width < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 1, true))
+			}
+		}
+		if body.Width != nil {
+			if *body.Width > 2048 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 2048, false))
+			}
+		}
+		if body.Height != nil {
+			if *body.Height < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 1, true))
+			}
+		}
+		if body.Height != nil {
+			if *body.Height > 2048 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 2048, false))
+			}
+		}
+		if body.From != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+		}
+		if body.To != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = apiCanvasRollbackID
+	}
+	var token string
+	{
+		token = apiCanvasRollbackToken
+	}
+	v := &api.CanvasRollbackPayload{
+		UserID: body.UserID,
+		X:      body.X,
+		Y:      body.Y,
+		Width:  body.Width,
+		Height: body.Height,
+		From:   body.From,
+		To:     body.To,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAuditLogGetPayload builds the payload for the api AuditLogGet endpoint
+// from CLI flags.
+func BuildAuditLogGetPayload(apiAuditLogGetID string, apiAuditLogGetLimit string, apiAuditLogGetToken string) (*api.AuditLogGetPayload, error) {
+	var err error
+	var id string
+	{
+		id = apiAuditLogGetID
+	}
+	var limit int32
+	{
+		if apiAuditLogGetLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(apiAuditLogGetLimit, 10, 32)
+			limit = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT32")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = apiAuditLogGetToken
+	}
+	v := &api.AuditLogGetPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCanvasPixelsGetPayload builds the payload for the api CanvasPixelsGet
+// endpoint from CLI flags.
+func BuildCanvasPixelsGetPayload(apiCanvasPixelsGetID string) (*api.CanvasPixelsGetPayload, error) {
+	var id string
+	{
+		id = apiCanvasPixelsGetID
+	}
+	v := &api.CanvasPixelsGetPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildCanvasChunksGetPayload builds the payload for the api CanvasChunksGet
+// endpoint from CLI flags.
+func BuildCanvasChunksGetPayload(apiCanvasChunksGetID string, apiCanvasChunksGetSince string) (*api.CanvasChunksGetPayload, error) {
+	var err error
+	var id string
+	{
+		id = apiCanvasChunksGetID
+	}
+	var since int64
+	{
+		if apiCanvasChunksGetSince != "" {
+			since, err = strconv.ParseInt(apiCanvasChunksGetSince, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for since, must be INT64")
+			}
+			if since < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("since", since, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &api.CanvasChunksGetPayload{}
+	v.ID = id
+	v.Since = since
+
+	return v, nil
+}
+
+// BuildCanvasRegionGetPayload builds the payload for the api CanvasRegionGet
+// endpoint from CLI flags.
+func BuildCanvasRegionGetPayload(apiCanvasRegionGetID string, apiCanvasRegionGetX string, apiCanvasRegionGetY string, apiCanvasRegionGetWidth string, apiCanvasRegionGetHeight string) (*api.CanvasRegionGetPayload, error) {
+	var err error
+	var id string
+	{
+		id = apiCanvasRegionGetID
+	}
+	var x int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionGetX, 10, 32)
+		x = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for x, must be INT32")
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var y int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionGetY, 10, 32)
+		y = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for y, must be INT32")
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var width int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionGetWidth, 10, 32)
+		width = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for width, must be INT32")
+		}
+		if width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 1, true))
+		}
+		if width > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var height int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionGetHeight, 10, 32)
+		height = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for height, must be INT32")
+		}
+		if height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 1, true))
+		}
+		if height > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &api.CanvasRegionGetPayload{}
+	v.ID = id
+	v.X = x
+	v.Y = y
+	v.Width = width
+	v.Height = height
+
+	return v, nil
+}
+
+// BuildCanvasImageGetPayload builds the payload for the api CanvasImageGet
+// endpoint from CLI flags.
+func BuildCanvasImageGetPayload(apiCanvasImageGetID string, apiCanvasImageGetScale string) (*api.CanvasImageGetPayload, error) {
+	var err error
+	var id string
+	{
+		id = apiCanvasImageGetID
+	}
+	var scale int32
+	{
+		if apiCanvasImageGetScale != "" {
+			var v int64
+			v, err = strconv.ParseInt(apiCanvasImageGetScale, 10, 32)
+			scale = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for scale, must be INT32")
+			}
+			if scale < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 1, true))
+			}
+			if scale > 16 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 16, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &api.CanvasImageGetPayload{}
+	v.ID = id
+	v.Scale = scale
+
+	return v, nil
+}
+
+// BuildCanvasRegionImageGetPayload builds the payload for the api
+// CanvasRegionImageGet endpoint from CLI flags.
+func BuildCanvasRegionImageGetPayload(apiCanvasRegionImageGetID string, apiCanvasRegionImageGetX string, apiCanvasRegionImageGetY string, apiCanvasRegionImageGetWidth string, apiCanvasRegionImageGetHeight string, apiCanvasRegionImageGetScale string) (*api.CanvasRegionImageGetPayload, error) {
+	var err error
+	var id string
+	{
+		id = apiCanvasRegionImageGetID
+	}
+	var x int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionImageGetX, 10, 32)
+		x = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for x, must be INT32")
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var y int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionImageGetY, 10, 32)
+		y = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for y, must be INT32")
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var width int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionImageGetWidth, 10, 32)
+		width = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for width, must be INT32")
+		}
+		if width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 1, true))
+		}
+		if width > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var height int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasRegionImageGetHeight, 10, 32)
+		height = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for height, must be INT32")
+		}
+		if height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 1, true))
+		}
+		if height > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var scale int32
+	{
+		if apiCanvasRegionImageGetScale != "" {
+			var v int64
+			v, err = strconv.ParseInt(apiCanvasRegionImageGetScale, 10, 32)
+			scale = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for scale, must be INT32")
+			}
+			if scale < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 1, true))
+			}
+			if scale > 16 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 16, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &api.CanvasRegionImageGetPayload{}
+	v.ID = id
+	v.X = x
+	v.Y = y
+	v.Width = width
+	v.Height = height
+	v.Scale = scale
+
+	return v, nil
+}
+
+// BuildCanvasTileGetPayload builds the payload for the api CanvasTileGet
+// endpoint from CLI flags.
+func BuildCanvasTileGetPayload(apiCanvasTileGetID string, apiCanvasTileGetZ string, apiCanvasTileGetX string, apiCanvasTileGetY string, apiCanvasTileGetIfNoneMatch string) (*api.CanvasTileGetPayload, error) {
+	var err error
+	var id string
+	{
+		id = apiCanvasTileGetID
+	}
+	var z int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasTileGetZ, 10, 32)
+		z = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for z, must be INT32")
+		}
+		if z < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("z", z, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var x int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasTileGetX, 10, 32)
+		x = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for x, must be INT32")
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var y int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiCanvasTileGetY, 10, 32)
+		y = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for y, must be INT32")
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var ifNoneMatch *string
+	{
+		if apiCanvasTileGetIfNoneMatch != "" {
+			ifNoneMatch = &apiCanvasTileGetIfNoneMatch
+		}
+	}
+	v := &api.CanvasTileGetPayload{}
+	v.ID = id
+	v.Z = z
+	v.X = x
+	v.Y = y
+	v.IfNoneMatch = ifNoneMatch
+
+	return v, nil
+}
+
+// BuildCanvasSubscribePayload builds the payload for the api CanvasSubscribe
+// endpoint from CLI flags.
+func BuildCanvasSubscribePayload(apiCanvasSubscribeID string, apiCanvasSubscribeSince string, apiCanvasSubscribeLastEventID string) (*api.CanvasSubscribePayload, error) {
+	var id string
+	{
+		id = apiCanvasSubscribeID
+	}
+	var since *int64
+	{
+		if apiCanvasSubscribeSince != "" {
+			val, err := strconv.ParseInt(apiCanvasSubscribeSince, 10, 64)
+			since = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for since, must be INT64")
+			}
+			if *since < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("since", *since, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var lastEventID *string
+	{
+		if apiCanvasSubscribeLastEventID != "" {
+			lastEventID = &apiCanvasSubscribeLastEventID
+		}
+	}
+	v := &api.CanvasSubscribePayload{}
+	v.ID = id
+	v.Since = since
+	v.LastEventID = lastEventID
+
+	return v, nil
+}
+
+// BuildCanvasSessionPayload builds the payload for the api CanvasSession
+// endpoint from CLI flags.
+func BuildCanvasSessionPayload(apiCanvasSessionID string, apiCanvasSessionToken string) (*api.CanvasSessionPayload, error) {
+	var id string
+	{
+		id = apiCanvasSessionID
+	}
+	var token string
+	{
+		token = apiCanvasSessionToken
+	}
+	v := &api.CanvasSessionPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPixelPlacePayload builds the payload for the api PixelPlace endpoint
+// from CLI flags.
+func BuildPixelPlacePayload(apiPixelPlaceBody string, apiPixelPlaceID string, apiPixelPlaceToken string) (*api.PixelPlacePayload, error) {
+	var err error
+	var body PixelPlaceRequestBody
+	{
+		err = json.Unmarshal([]byte(apiPixelPlaceBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"color\": 37,\n      \"x\": 920829531,\n      \"y\": 88867099\n   }'")
+		}
+		if body.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", body.X, 0, true))
+		}
+		if body.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", body.Y, 0, true))
+		}
+		if body.Color < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", body.Color, 0, true))
+		}
+		if body.Color > 255 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", body.Color, 255, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = apiPixelPlaceID
+	}
+	var token string
+	{
+		token = apiPixelPlaceToken
+	}
+	v := &api.PixelPlacePayload{
+		X:     body.X,
+		Y:     body.Y,
+		Color: body.Color,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPixelInfoGetPayload builds the payload for the api PixelInfoGet
+// endpoint from CLI flags.
+func BuildPixelInfoGetPayload(apiPixelInfoGetID string, apiPixelInfoGetX string, apiPixelInfoGetY string, apiPixelInfoGetLimit string) (*api.PixelInfoGetPayload, error) {
+	var err error
+	var id string
+	{
+		id = apiPixelInfoGetID
+	}
+	var x int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiPixelInfoGetX, 10, 32)
+		x = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for x, must be INT32")
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var y int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(apiPixelInfoGetY, 10, 32)
+		y = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for y, must be INT32")
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int32
+	{
+		if apiPixelInfoGetLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(apiPixelInfoGetLimit, 10, 32)
+			limit = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT32")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &api.PixelInfoGetPayload{}
+	v.ID = id
+	v.X = x
+	v.Y = y
+	v.Limit = limit
+
+	return v, nil
+}
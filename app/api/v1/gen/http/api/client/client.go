@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api client HTTP transport
 //
@@ -9,7 +9,9 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -17,18 +19,92 @@ import (
 
 // Client lists the api service endpoint HTTP clients.
 type Client struct {
+	// CanvasCreate Doer is the HTTP client used to make requests to the
+	// CanvasCreate endpoint.
+	CanvasCreateDoer goahttp.Doer
+
+	// CanvasList Doer is the HTTP client used to make requests to the CanvasList
+	// endpoint.
+	CanvasListDoer goahttp.Doer
+
 	// CanvasGet Doer is the HTTP client used to make requests to the CanvasGet
 	// endpoint.
 	CanvasGetDoer goahttp.Doer
 
+	// CanvasArchive Doer is the HTTP client used to make requests to the
+	// CanvasArchive endpoint.
+	CanvasArchiveDoer goahttp.Doer
+
+	// CanvasResize Doer is the HTTP client used to make requests to the
+	// CanvasResize endpoint.
+	CanvasResizeDoer goahttp.Doer
+
+	// ProtectedRegionCreate Doer is the HTTP client used to make requests to the
+	// ProtectedRegionCreate endpoint.
+	ProtectedRegionCreateDoer goahttp.Doer
+
+	// ProtectedRegionDelete Doer is the HTTP client used to make requests to the
+	// ProtectedRegionDelete endpoint.
+	ProtectedRegionDeleteDoer goahttp.Doer
+
+	// CanvasRollback Doer is the HTTP client used to make requests to the
+	// CanvasRollback endpoint.
+	CanvasRollbackDoer goahttp.Doer
+
+	// AuditLogGet Doer is the HTTP client used to make requests to the AuditLogGet
+	// endpoint.
+	AuditLogGetDoer goahttp.Doer
+
+	// CanvasPixelsGet Doer is the HTTP client used to make requests to the
+	// CanvasPixelsGet endpoint.
+	CanvasPixelsGetDoer goahttp.Doer
+
+	// CanvasChunksGet Doer is the HTTP client used to make requests to the
+	// CanvasChunksGet endpoint.
+	CanvasChunksGetDoer goahttp.Doer
+
+	// CanvasRegionGet Doer is the HTTP client used to make requests to the
+	// CanvasRegionGet endpoint.
+	CanvasRegionGetDoer goahttp.Doer
+
+	// CanvasImageGet Doer is the HTTP client used to make requests to the
+	// CanvasImageGet endpoint.
+	CanvasImageGetDoer goahttp.Doer
+
+	// CanvasRegionImageGet Doer is the HTTP client used to make requests to the
+	// CanvasRegionImageGet endpoint.
+	CanvasRegionImageGetDoer goahttp.Doer
+
+	// CanvasTileGet Doer is the HTTP client used to make requests to the
+	// CanvasTileGet endpoint.
+	CanvasTileGetDoer goahttp.Doer
+
+	// CanvasSubscribe Doer is the HTTP client used to make requests to the
+	// CanvasSubscribe endpoint.
+	CanvasSubscribeDoer goahttp.Doer
+
+	// CanvasSession Doer is the HTTP client used to make requests to the
+	// CanvasSession endpoint.
+	CanvasSessionDoer goahttp.Doer
+
+	// PixelPlace Doer is the HTTP client used to make requests to the PixelPlace
+	// endpoint.
+	PixelPlaceDoer goahttp.Doer
+
+	// PixelInfoGet Doer is the HTTP client used to make requests to the
+	// PixelInfoGet endpoint.
+	PixelInfoGetDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
 
-	scheme  string
-	host    string
-	encoder func(*http.Request) goahttp.Encoder
-	decoder func(*http.Response) goahttp.Decoder
+	scheme     string
+	host       string
+	encoder    func(*http.Request) goahttp.Encoder
+	decoder    func(*http.Response) goahttp.Decoder
+	dialer     goahttp.Dialer
+	configurer *ConnConfigurer
 }
 
 // NewClient instantiates HTTP clients for all the api service servers.
@@ -39,14 +115,87 @@ func NewClient(
 	enc func(*http.Request) goahttp.Encoder,
 	dec func(*http.Response) goahttp.Decoder,
 	restoreBody bool,
+	dialer goahttp.Dialer,
+	cfn *ConnConfigurer,
 ) *Client {
+	if cfn == nil {
+		cfn = &ConnConfigurer{}
+	}
 	return &Client{
-		CanvasGetDoer:       doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		CanvasCreateDoer:          doer,
+		CanvasListDoer:            doer,
+		CanvasGetDoer:             doer,
+		CanvasArchiveDoer:         doer,
+		CanvasResizeDoer:          doer,
+		ProtectedRegionCreateDoer: doer,
+		ProtectedRegionDeleteDoer: doer,
+		CanvasRollbackDoer:        doer,
+		AuditLogGetDoer:           doer,
+		CanvasPixelsGetDoer:       doer,
+		CanvasChunksGetDoer:       doer,
+		CanvasRegionGetDoer:       doer,
+		CanvasImageGetDoer:        doer,
+		CanvasRegionImageGetDoer:  doer,
+		CanvasTileGetDoer:         doer,
+		CanvasSubscribeDoer:       doer,
+		CanvasSessionDoer:         doer,
+		PixelPlaceDoer:            doer,
+		PixelInfoGetDoer:          doer,
+		RestoreResponseBody:       restoreBody,
+		scheme:                    scheme,
+		host:                      host,
+		decoder:                   dec,
+		encoder:                   enc,
+		dialer:                    dialer,
+		configurer:                cfn,
+	}
+}
+
+// CanvasCreate returns an endpoint that makes HTTP requests to the api service
+// CanvasCreate server.
+func (c *Client) CanvasCreate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasCreateRequest(c.encoder)
+		decodeResponse = DecodeCanvasCreateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasCreateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasCreateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasCreate", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasList returns an endpoint that makes HTTP requests to the api service
+// CanvasList server.
+func (c *Client) CanvasList() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasListRequest(c.encoder)
+		decodeResponse = DecodeCanvasListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasList", err)
+		}
+		return decodeResponse(resp)
 	}
 }
 
@@ -68,3 +217,401 @@ func (c *Client) CanvasGet() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// CanvasArchive returns an endpoint that makes HTTP requests to the api
+// service CanvasArchive server.
+func (c *Client) CanvasArchive() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasArchiveRequest(c.encoder)
+		decodeResponse = DecodeCanvasArchiveResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasArchiveRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasArchiveDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasArchive", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasResize returns an endpoint that makes HTTP requests to the api service
+// CanvasResize server.
+func (c *Client) CanvasResize() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasResizeRequest(c.encoder)
+		decodeResponse = DecodeCanvasResizeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasResizeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasResizeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasResize", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ProtectedRegionCreate returns an endpoint that makes HTTP requests to the
+// api service ProtectedRegionCreate server.
+func (c *Client) ProtectedRegionCreate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeProtectedRegionCreateRequest(c.encoder)
+		decodeResponse = DecodeProtectedRegionCreateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildProtectedRegionCreateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ProtectedRegionCreateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "ProtectedRegionCreate", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ProtectedRegionDelete returns an endpoint that makes HTTP requests to the
+// api service ProtectedRegionDelete server.
+func (c *Client) ProtectedRegionDelete() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeProtectedRegionDeleteRequest(c.encoder)
+		decodeResponse = DecodeProtectedRegionDeleteResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildProtectedRegionDeleteRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ProtectedRegionDeleteDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "ProtectedRegionDelete", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasRollback returns an endpoint that makes HTTP requests to the api
+// service CanvasRollback server.
+func (c *Client) CanvasRollback() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasRollbackRequest(c.encoder)
+		decodeResponse = DecodeCanvasRollbackResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasRollbackRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasRollbackDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasRollback", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AuditLogGet returns an endpoint that makes HTTP requests to the api service
+// AuditLogGet server.
+func (c *Client) AuditLogGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAuditLogGetRequest(c.encoder)
+		decodeResponse = DecodeAuditLogGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAuditLogGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AuditLogGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "AuditLogGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasPixelsGet returns an endpoint that makes HTTP requests to the api
+// service CanvasPixelsGet server.
+func (c *Client) CanvasPixelsGet() goa.Endpoint {
+	var (
+		decodeResponse = DecodeCanvasPixelsGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasPixelsGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasPixelsGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasPixelsGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasChunksGet returns an endpoint that makes HTTP requests to the api
+// service CanvasChunksGet server.
+func (c *Client) CanvasChunksGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasChunksGetRequest(c.encoder)
+		decodeResponse = DecodeCanvasChunksGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasChunksGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasChunksGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasChunksGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasRegionGet returns an endpoint that makes HTTP requests to the api
+// service CanvasRegionGet server.
+func (c *Client) CanvasRegionGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasRegionGetRequest(c.encoder)
+		decodeResponse = DecodeCanvasRegionGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasRegionGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasRegionGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasRegionGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasImageGet returns an endpoint that makes HTTP requests to the api
+// service CanvasImageGet server.
+func (c *Client) CanvasImageGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasImageGetRequest(c.encoder)
+		decodeResponse = DecodeCanvasImageGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasImageGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasImageGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasImageGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasRegionImageGet returns an endpoint that makes HTTP requests to the api
+// service CanvasRegionImageGet server.
+func (c *Client) CanvasRegionImageGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasRegionImageGetRequest(c.encoder)
+		decodeResponse = DecodeCanvasRegionImageGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasRegionImageGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasRegionImageGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasRegionImageGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasTileGet returns an endpoint that makes HTTP requests to the api
+// service CanvasTileGet server.
+func (c *Client) CanvasTileGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasTileGetRequest(c.encoder)
+		decodeResponse = DecodeCanvasTileGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasTileGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CanvasTileGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasTileGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CanvasSubscribe returns an endpoint that makes HTTP requests to the api
+// service CanvasSubscribe server.
+func (c *Client) CanvasSubscribe() goa.Endpoint {
+	var (
+		encodeRequest = EncodeCanvasSubscribeRequest(c.encoder)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasSubscribeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		// For SSE endpoints, connect and return a stream
+		resp, err := c.CanvasSubscribeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "CanvasSubscribe", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status from SSE endpoint: %d", resp.StatusCode)
+		}
+
+		contentType := resp.Header.Get("Content-Type")
+		if contentType != "" && !strings.HasPrefix(contentType, "text/event-stream") {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content type: %s (expected text/event-stream)", contentType)
+		}
+
+		return NewCanvasSubscribeStream(resp, c.decoder), nil
+	}
+}
+
+// CanvasSession returns an endpoint that makes HTTP requests to the api
+// service CanvasSession server.
+func (c *Client) CanvasSession() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCanvasSessionRequest(c.encoder)
+		decodeResponse = DecodeCanvasSessionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCanvasSessionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		conn, resp, err := c.dialer.DialContext(ctx, req.URL.String(), req.Header)
+		if err != nil {
+			if resp != nil {
+				return decodeResponse(resp)
+			}
+			return nil, goahttp.ErrRequestError("api", "CanvasSession", err)
+		}
+		if c.configurer.CanvasSessionFn != nil {
+			conn = c.configurer.CanvasSessionFn(conn, nil)
+		}
+		stream := &CanvasSessionClientStream{conn: conn}
+		return stream, nil
+	}
+}
+
+// PixelPlace returns an endpoint that makes HTTP requests to the api service
+// PixelPlace server.
+func (c *Client) PixelPlace() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePixelPlaceRequest(c.encoder)
+		decodeResponse = DecodePixelPlaceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPixelPlaceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PixelPlaceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "PixelPlace", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PixelInfoGet returns an endpoint that makes HTTP requests to the api service
+// PixelInfoGet server.
+func (c *Client) PixelInfoGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePixelInfoGetRequest(c.encoder)
+		decodeResponse = DecodePixelInfoGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPixelInfoGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PixelInfoGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("api", "PixelInfoGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api HTTP client encoders and decoders
 //
@@ -10,19 +10,280 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
 	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// BuildCanvasCreateRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "CanvasCreate" endpoint
+func (c *Client) BuildCanvasCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasCreateAPIPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasCreate", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasCreateRequest returns an encoder for requests sent to the api
+// CanvasCreate server.
+func EncodeCanvasCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasCreatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasCreate", "*api.CanvasCreatePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCanvasCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("api", "CanvasCreate", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCanvasCreateResponse returns a decoder for responses returned by the
+// api CanvasCreate endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCanvasCreateResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CanvasCreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasCreate", err)
+			}
+			p := NewCanvasCreateCanvasCreated(&body)
+			view := "default"
+			vres := &apiviews.Canvas{Projected: p, View: view}
+			if err = apiviews.ValidateCanvas(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasCreate", err)
+			}
+			res := api.NewCanvas(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasCreateUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasCreate", err)
+			}
+			err = ValidateCanvasCreateUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasCreate", err)
+			}
+			return nil, NewCanvasCreateUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasCreateAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasCreate", err)
+			}
+			err = ValidateCanvasCreateAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasCreate", err)
+			}
+			return nil, NewCanvasCreateAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasCreateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasCreate", err)
+			}
+			err = ValidateCanvasCreateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasCreate", err)
+			}
+			return nil, NewCanvasCreateNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasCreate", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasListRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "CanvasList" endpoint
+func (c *Client) BuildCanvasListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasListAPIPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasList", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasListRequest returns an encoder for requests sent to the api
+// CanvasList server.
+func EncodeCanvasListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasList", "*api.CanvasListPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("include_archived", fmt.Sprintf("%v", p.IncludeArchived))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCanvasListResponse returns a decoder for responses returned by the api
+// CanvasList endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+// DecodeCanvasListResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CanvasListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasList", err)
+			}
+			p := NewCanvasListCanvasesOK(&body)
+			view := "default"
+			vres := &apiviews.Canvases{Projected: p, View: view}
+			if err = apiviews.ValidateCanvases(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasList", err)
+			}
+			res := api.NewCanvases(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasListUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasList", err)
+			}
+			err = ValidateCanvasListUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasList", err)
+			}
+			return nil, NewCanvasListUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasListAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasList", err)
+			}
+			err = ValidateCanvasListAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasList", err)
+			}
+			return nil, NewCanvasListAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasList", err)
+			}
+			err = ValidateCanvasListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasList", err)
+			}
+			return nil, NewCanvasListNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasList", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildCanvasGetRequest instantiates a HTTP request object with method and
 // path set to call the "api" service "CanvasGet" endpoint
 func (c *Client) BuildCanvasGetRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasGetAPIPath()}
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasGet", "*api.CanvasGetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasGetAPIPath(id)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, goahttp.ErrInvalidURL("api", "CanvasGet", u.String(), err)
@@ -40,6 +301,7 @@ func (c *Client) BuildCanvasGetRequest(ctx context.Context, v any) (*http.Reques
 // DecodeCanvasGetResponse may return the following errors:
 //   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
 //   - error: internal error
 func DecodeCanvasGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -101,9 +363,2498 @@ func DecodeCanvasGetResponse(decoder func(*http.Response) goahttp.Decoder, resto
 				return nil, goahttp.ErrValidationError("api", "CanvasGet", err)
 			}
 			return nil, NewCanvasGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasGet", err)
+			}
+			err = ValidateCanvasGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasGet", err)
+			}
+			return nil, NewCanvasGetNotFound(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("api", "CanvasGet", resp.StatusCode, string(body))
 		}
 	}
 }
+
+// BuildCanvasArchiveRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "CanvasArchive" endpoint
+func (c *Client) BuildCanvasArchiveRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasArchivePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasArchive", "*api.CanvasArchivePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasArchiveAPIPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasArchive", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasArchiveRequest returns an encoder for requests sent to the api
+// CanvasArchive server.
+func EncodeCanvasArchiveRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasArchivePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasArchive", "*api.CanvasArchivePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeCanvasArchiveResponse returns a decoder for responses returned by the
+// api CanvasArchive endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCanvasArchiveResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasArchiveResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CanvasArchiveResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasArchive", err)
+			}
+			p := NewCanvasArchiveCanvasOK(&body)
+			view := "default"
+			vres := &apiviews.Canvas{Projected: p, View: view}
+			if err = apiviews.ValidateCanvas(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasArchive", err)
+			}
+			res := api.NewCanvas(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasArchiveUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasArchive", err)
+			}
+			err = ValidateCanvasArchiveUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasArchive", err)
+			}
+			return nil, NewCanvasArchiveUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasArchiveAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasArchive", err)
+			}
+			err = ValidateCanvasArchiveAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasArchive", err)
+			}
+			return nil, NewCanvasArchiveAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasArchiveNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasArchive", err)
+			}
+			err = ValidateCanvasArchiveNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasArchive", err)
+			}
+			return nil, NewCanvasArchiveNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasArchive", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasResizeRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "CanvasResize" endpoint
+func (c *Client) BuildCanvasResizeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasResizePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasResize", "*api.CanvasResizePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasResizeAPIPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasResize", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasResizeRequest returns an encoder for requests sent to the api
+// CanvasResize server.
+func EncodeCanvasResizeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasResizePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasResize", "*api.CanvasResizePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCanvasResizeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("api", "CanvasResize", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCanvasResizeResponse returns a decoder for responses returned by the
+// api CanvasResize endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCanvasResizeResponse may return the following errors:
+//   - "canvas_archived" (type *goa.ServiceError): http.StatusConflict
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasResizeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CanvasResizeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasResize", err)
+			}
+			p := NewCanvasResizeCanvasOK(&body)
+			view := "default"
+			vres := &apiviews.Canvas{Projected: p, View: view}
+			if err = apiviews.ValidateCanvas(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasResize", err)
+			}
+			res := api.NewCanvas(vres)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body CanvasResizeCanvasArchivedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasResize", err)
+			}
+			err = ValidateCanvasResizeCanvasArchivedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasResize", err)
+			}
+			return nil, NewCanvasResizeCanvasArchived(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CanvasResizeUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasResize", err)
+			}
+			err = ValidateCanvasResizeUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasResize", err)
+			}
+			return nil, NewCanvasResizeUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasResizeAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasResize", err)
+			}
+			err = ValidateCanvasResizeAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasResize", err)
+			}
+			return nil, NewCanvasResizeAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasResizeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasResize", err)
+			}
+			err = ValidateCanvasResizeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasResize", err)
+			}
+			return nil, NewCanvasResizeNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasResize", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildProtectedRegionCreateRequest instantiates a HTTP request object with
+// method and path set to call the "api" service "ProtectedRegionCreate"
+// endpoint
+func (c *Client) BuildProtectedRegionCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.ProtectedRegionCreatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "ProtectedRegionCreate", "*api.ProtectedRegionCreatePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ProtectedRegionCreateAPIPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "ProtectedRegionCreate", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeProtectedRegionCreateRequest returns an encoder for requests sent to
+// the api ProtectedRegionCreate server.
+func EncodeProtectedRegionCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.ProtectedRegionCreatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "ProtectedRegionCreate", "*api.ProtectedRegionCreatePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewProtectedRegionCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("api", "ProtectedRegionCreate", err)
+		}
+		return nil
+	}
+}
+
+// DecodeProtectedRegionCreateResponse returns a decoder for responses returned
+// by the api ProtectedRegionCreate endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeProtectedRegionCreateResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeProtectedRegionCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body ProtectedRegionCreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "ProtectedRegionCreate", err)
+			}
+			p := NewProtectedRegionCreateProtectedRegionCreated(&body)
+			view := "default"
+			vres := &apiviews.ProtectedRegion{Projected: p, View: view}
+			if err = apiviews.ValidateProtectedRegion(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "ProtectedRegionCreate", err)
+			}
+			res := api.NewProtectedRegion(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ProtectedRegionCreateUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "ProtectedRegionCreate", err)
+			}
+			err = ValidateProtectedRegionCreateUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "ProtectedRegionCreate", err)
+			}
+			return nil, NewProtectedRegionCreateUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body ProtectedRegionCreateAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "ProtectedRegionCreate", err)
+			}
+			err = ValidateProtectedRegionCreateAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "ProtectedRegionCreate", err)
+			}
+			return nil, NewProtectedRegionCreateAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body ProtectedRegionCreateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "ProtectedRegionCreate", err)
+			}
+			err = ValidateProtectedRegionCreateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "ProtectedRegionCreate", err)
+			}
+			return nil, NewProtectedRegionCreateNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "ProtectedRegionCreate", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildProtectedRegionDeleteRequest instantiates a HTTP request object with
+// method and path set to call the "api" service "ProtectedRegionDelete"
+// endpoint
+func (c *Client) BuildProtectedRegionDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id       string
+		regionID string
+	)
+	{
+		p, ok := v.(*api.ProtectedRegionDeletePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "ProtectedRegionDelete", "*api.ProtectedRegionDeletePayload", v)
+		}
+		id = p.ID
+		regionID = p.RegionID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ProtectedRegionDeleteAPIPath(id, regionID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "ProtectedRegionDelete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeProtectedRegionDeleteRequest returns an encoder for requests sent to
+// the api ProtectedRegionDelete server.
+func EncodeProtectedRegionDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.ProtectedRegionDeletePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "ProtectedRegionDelete", "*api.ProtectedRegionDeletePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeProtectedRegionDeleteResponse returns a decoder for responses returned
+// by the api ProtectedRegionDelete endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeProtectedRegionDeleteResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeProtectedRegionDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body ProtectedRegionDeleteUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "ProtectedRegionDelete", err)
+			}
+			err = ValidateProtectedRegionDeleteUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "ProtectedRegionDelete", err)
+			}
+			return nil, NewProtectedRegionDeleteUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body ProtectedRegionDeleteAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "ProtectedRegionDelete", err)
+			}
+			err = ValidateProtectedRegionDeleteAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "ProtectedRegionDelete", err)
+			}
+			return nil, NewProtectedRegionDeleteAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body ProtectedRegionDeleteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "ProtectedRegionDelete", err)
+			}
+			err = ValidateProtectedRegionDeleteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "ProtectedRegionDelete", err)
+			}
+			return nil, NewProtectedRegionDeleteNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "ProtectedRegionDelete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasRollbackRequest instantiates a HTTP request object with method
+// and path set to call the "api" service "CanvasRollback" endpoint
+func (c *Client) BuildCanvasRollbackRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasRollbackPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasRollback", "*api.CanvasRollbackPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasRollbackAPIPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasRollback", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasRollbackRequest returns an encoder for requests sent to the api
+// CanvasRollback server.
+func EncodeCanvasRollbackRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasRollbackPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasRollback", "*api.CanvasRollbackPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCanvasRollbackRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("api", "CanvasRollback", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCanvasRollbackResponse returns a decoder for responses returned by the
+// api CanvasRollback endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCanvasRollbackResponse may return the following errors:
+//   - "canvas_archived" (type *goa.ServiceError): http.StatusConflict
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasRollbackResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CanvasRollbackResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRollback", err)
+			}
+			p := NewCanvasRollbackRollbackOK(&body)
+			view := "default"
+			vres := &apiviews.Rollback{Projected: p, View: view}
+			if err = apiviews.ValidateRollback(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRollback", err)
+			}
+			res := api.NewRollback(vres)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body CanvasRollbackCanvasArchivedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRollback", err)
+			}
+			err = ValidateCanvasRollbackCanvasArchivedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRollback", err)
+			}
+			return nil, NewCanvasRollbackCanvasArchived(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CanvasRollbackUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRollback", err)
+			}
+			err = ValidateCanvasRollbackUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRollback", err)
+			}
+			return nil, NewCanvasRollbackUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasRollbackAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRollback", err)
+			}
+			err = ValidateCanvasRollbackAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRollback", err)
+			}
+			return nil, NewCanvasRollbackAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasRollbackNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRollback", err)
+			}
+			err = ValidateCanvasRollbackNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRollback", err)
+			}
+			return nil, NewCanvasRollbackNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasRollback", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAuditLogGetRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "AuditLogGet" endpoint
+func (c *Client) BuildAuditLogGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.AuditLogGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "AuditLogGet", "*api.AuditLogGetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AuditLogGetAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "AuditLogGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAuditLogGetRequest returns an encoder for requests sent to the api
+// AuditLogGet server.
+func EncodeAuditLogGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.AuditLogGetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "AuditLogGet", "*api.AuditLogGetPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeAuditLogGetResponse returns a decoder for responses returned by the
+// api AuditLogGet endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeAuditLogGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeAuditLogGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AuditLogGetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "AuditLogGet", err)
+			}
+			p := NewAuditLogGetAuditLogOK(&body)
+			view := "default"
+			vres := &apiviews.AuditLog{Projected: p, View: view}
+			if err = apiviews.ValidateAuditLog(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "AuditLogGet", err)
+			}
+			res := api.NewAuditLog(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body AuditLogGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "AuditLogGet", err)
+			}
+			err = ValidateAuditLogGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "AuditLogGet", err)
+			}
+			return nil, NewAuditLogGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body AuditLogGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "AuditLogGet", err)
+			}
+			err = ValidateAuditLogGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "AuditLogGet", err)
+			}
+			return nil, NewAuditLogGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body AuditLogGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "AuditLogGet", err)
+			}
+			err = ValidateAuditLogGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "AuditLogGet", err)
+			}
+			return nil, NewAuditLogGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "AuditLogGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasPixelsGetRequest instantiates a HTTP request object with method
+// and path set to call the "api" service "CanvasPixelsGet" endpoint
+func (c *Client) BuildCanvasPixelsGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasPixelsGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasPixelsGet", "*api.CanvasPixelsGetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasPixelsGetAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasPixelsGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeCanvasPixelsGetResponse returns a decoder for responses returned by
+// the api CanvasPixelsGet endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCanvasPixelsGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasPixelsGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasPixelsGet", err)
+			}
+			var (
+				width  int32
+				height int32
+			)
+			{
+				widthRaw := resp.Header.Get("X-Canvas-Width")
+				if widthRaw == "" {
+					return nil, goahttp.ErrValidationError("api", "CanvasPixelsGet", goa.MissingFieldError("width", "header"))
+				}
+				v, err2 := strconv.ParseInt(widthRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("width", widthRaw, "integer"))
+				}
+				width = int32(v)
+			}
+			{
+				heightRaw := resp.Header.Get("X-Canvas-Height")
+				if heightRaw == "" {
+					return nil, goahttp.ErrValidationError("api", "CanvasPixelsGet", goa.MissingFieldError("height", "header"))
+				}
+				v, err2 := strconv.ParseInt(heightRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("height", heightRaw, "integer"))
+				}
+				height = int32(v)
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasPixelsGet", err)
+			}
+			p := NewCanvasPixelsGetCanvasPixelsOK(body, width, height)
+			view := "default"
+			vres := &apiviews.CanvasPixels{Projected: p, View: view}
+			if err = apiviews.ValidateCanvasPixels(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasPixelsGet", err)
+			}
+			res := api.NewCanvasPixels(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasPixelsGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasPixelsGet", err)
+			}
+			err = ValidateCanvasPixelsGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasPixelsGet", err)
+			}
+			return nil, NewCanvasPixelsGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasPixelsGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasPixelsGet", err)
+			}
+			err = ValidateCanvasPixelsGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasPixelsGet", err)
+			}
+			return nil, NewCanvasPixelsGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasPixelsGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasPixelsGet", err)
+			}
+			err = ValidateCanvasPixelsGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasPixelsGet", err)
+			}
+			return nil, NewCanvasPixelsGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasPixelsGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasChunksGetRequest instantiates a HTTP request object with method
+// and path set to call the "api" service "CanvasChunksGet" endpoint
+func (c *Client) BuildCanvasChunksGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasChunksGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasChunksGet", "*api.CanvasChunksGetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasChunksGetAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasChunksGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasChunksGetRequest returns an encoder for requests sent to the api
+// CanvasChunksGet server.
+func EncodeCanvasChunksGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasChunksGetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasChunksGet", "*api.CanvasChunksGetPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("since", fmt.Sprintf("%v", p.Since))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCanvasChunksGetResponse returns a decoder for responses returned by
+// the api CanvasChunksGet endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCanvasChunksGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasChunksGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CanvasChunksGetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasChunksGet", err)
+			}
+			p := NewCanvasChunksGetCanvasChunksOK(&body)
+			view := "default"
+			vres := &apiviews.CanvasChunks{Projected: p, View: view}
+			if err = apiviews.ValidateCanvasChunks(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasChunksGet", err)
+			}
+			res := api.NewCanvasChunks(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasChunksGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasChunksGet", err)
+			}
+			err = ValidateCanvasChunksGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasChunksGet", err)
+			}
+			return nil, NewCanvasChunksGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasChunksGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasChunksGet", err)
+			}
+			err = ValidateCanvasChunksGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasChunksGet", err)
+			}
+			return nil, NewCanvasChunksGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasChunksGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasChunksGet", err)
+			}
+			err = ValidateCanvasChunksGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasChunksGet", err)
+			}
+			return nil, NewCanvasChunksGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasChunksGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasRegionGetRequest instantiates a HTTP request object with method
+// and path set to call the "api" service "CanvasRegionGet" endpoint
+func (c *Client) BuildCanvasRegionGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasRegionGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasRegionGet", "*api.CanvasRegionGetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasRegionGetAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasRegionGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasRegionGetRequest returns an encoder for requests sent to the api
+// CanvasRegionGet server.
+func EncodeCanvasRegionGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasRegionGetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasRegionGet", "*api.CanvasRegionGetPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("x", fmt.Sprintf("%v", p.X))
+		values.Add("y", fmt.Sprintf("%v", p.Y))
+		values.Add("width", fmt.Sprintf("%v", p.Width))
+		values.Add("height", fmt.Sprintf("%v", p.Height))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCanvasRegionGetResponse returns a decoder for responses returned by
+// the api CanvasRegionGet endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCanvasRegionGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasRegionGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionGet", err)
+			}
+			var (
+				x      int32
+				y      int32
+				width  int32
+				height int32
+			)
+			{
+				xRaw := resp.Header.Get("X-Region-X")
+				if xRaw == "" {
+					return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", goa.MissingFieldError("x", "header"))
+				}
+				v, err2 := strconv.ParseInt(xRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x", xRaw, "integer"))
+				}
+				x = int32(v)
+			}
+			{
+				yRaw := resp.Header.Get("X-Region-Y")
+				if yRaw == "" {
+					return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", goa.MissingFieldError("y", "header"))
+				}
+				v, err2 := strconv.ParseInt(yRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("y", yRaw, "integer"))
+				}
+				y = int32(v)
+			}
+			{
+				widthRaw := resp.Header.Get("X-Region-Width")
+				if widthRaw == "" {
+					return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", goa.MissingFieldError("width", "header"))
+				}
+				v, err2 := strconv.ParseInt(widthRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("width", widthRaw, "integer"))
+				}
+				width = int32(v)
+			}
+			{
+				heightRaw := resp.Header.Get("X-Region-Height")
+				if heightRaw == "" {
+					return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", goa.MissingFieldError("height", "header"))
+				}
+				v, err2 := strconv.ParseInt(heightRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("height", heightRaw, "integer"))
+				}
+				height = int32(v)
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", err)
+			}
+			p := NewCanvasRegionGetCanvasRegionOK(body, x, y, width, height)
+			view := "default"
+			vres := &apiviews.CanvasRegion{Projected: p, View: view}
+			if err = apiviews.ValidateCanvasRegion(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", err)
+			}
+			res := api.NewCanvasRegion(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasRegionGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionGet", err)
+			}
+			err = ValidateCanvasRegionGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", err)
+			}
+			return nil, NewCanvasRegionGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasRegionGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionGet", err)
+			}
+			err = ValidateCanvasRegionGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", err)
+			}
+			return nil, NewCanvasRegionGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasRegionGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionGet", err)
+			}
+			err = ValidateCanvasRegionGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionGet", err)
+			}
+			return nil, NewCanvasRegionGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasRegionGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasImageGetRequest instantiates a HTTP request object with method
+// and path set to call the "api" service "CanvasImageGet" endpoint
+func (c *Client) BuildCanvasImageGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasImageGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasImageGet", "*api.CanvasImageGetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasImageGetAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasImageGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasImageGetRequest returns an encoder for requests sent to the api
+// CanvasImageGet server.
+func EncodeCanvasImageGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasImageGetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasImageGet", "*api.CanvasImageGetPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("scale", fmt.Sprintf("%v", p.Scale))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCanvasImageGetResponse returns a decoder for responses returned by the
+// api CanvasImageGet endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCanvasImageGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasImageGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasImageGet", err)
+			}
+			return body, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasImageGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasImageGet", err)
+			}
+			err = ValidateCanvasImageGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasImageGet", err)
+			}
+			return nil, NewCanvasImageGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasImageGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasImageGet", err)
+			}
+			err = ValidateCanvasImageGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasImageGet", err)
+			}
+			return nil, NewCanvasImageGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasImageGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasImageGet", err)
+			}
+			err = ValidateCanvasImageGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasImageGet", err)
+			}
+			return nil, NewCanvasImageGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasImageGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasRegionImageGetRequest instantiates a HTTP request object with
+// method and path set to call the "api" service "CanvasRegionImageGet" endpoint
+func (c *Client) BuildCanvasRegionImageGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasRegionImageGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasRegionImageGet", "*api.CanvasRegionImageGetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasRegionImageGetAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasRegionImageGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasRegionImageGetRequest returns an encoder for requests sent to
+// the api CanvasRegionImageGet server.
+func EncodeCanvasRegionImageGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasRegionImageGetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasRegionImageGet", "*api.CanvasRegionImageGetPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("x", fmt.Sprintf("%v", p.X))
+		values.Add("y", fmt.Sprintf("%v", p.Y))
+		values.Add("width", fmt.Sprintf("%v", p.Width))
+		values.Add("height", fmt.Sprintf("%v", p.Height))
+		values.Add("scale", fmt.Sprintf("%v", p.Scale))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCanvasRegionImageGetResponse returns a decoder for responses returned
+// by the api CanvasRegionImageGet endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCanvasRegionImageGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasRegionImageGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionImageGet", err)
+			}
+			return body, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasRegionImageGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionImageGet", err)
+			}
+			err = ValidateCanvasRegionImageGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionImageGet", err)
+			}
+			return nil, NewCanvasRegionImageGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasRegionImageGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionImageGet", err)
+			}
+			err = ValidateCanvasRegionImageGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionImageGet", err)
+			}
+			return nil, NewCanvasRegionImageGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasRegionImageGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasRegionImageGet", err)
+			}
+			err = ValidateCanvasRegionImageGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasRegionImageGet", err)
+			}
+			return nil, NewCanvasRegionImageGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasRegionImageGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasTileGetRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "CanvasTileGet" endpoint
+func (c *Client) BuildCanvasTileGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+		z  int32
+		x  int32
+		y  int32
+	)
+	{
+		p, ok := v.(*api.CanvasTileGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasTileGet", "*api.CanvasTileGetPayload", v)
+		}
+		id = p.ID
+		z = p.Z
+		x = p.X
+		y = p.Y
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasTileGetAPIPath(id, z, x, y)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasTileGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasTileGetRequest returns an encoder for requests sent to the api
+// CanvasTileGet server.
+func EncodeCanvasTileGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasTileGetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasTileGet", "*api.CanvasTileGetPayload", v)
+		}
+		if p.IfNoneMatch != nil {
+			head := *p.IfNoneMatch
+			req.Header.Set("If-None-Match", head)
+		}
+		return nil
+	}
+}
+
+// DecodeCanvasTileGetResponse returns a decoder for responses returned by the
+// api CanvasTileGet endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCanvasTileGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasTileGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNotModified:
+			var (
+				etag         string
+				cacheControl string
+				err          error
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			cacheControlRaw := resp.Header.Get("Cache-Control")
+			if cacheControlRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("cache_control", "header"))
+			}
+			cacheControl = cacheControlRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasTileGet", err)
+			}
+			res := NewCanvasTileGetCanvasTileNotModified(etag, cacheControl)
+			res.Status = "not_modified"
+			return res, nil
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasTileGet", err)
+			}
+			var (
+				etag         string
+				cacheControl string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			cacheControlRaw := resp.Header.Get("Cache-Control")
+			if cacheControlRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("cache_control", "header"))
+			}
+			cacheControl = cacheControlRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasTileGet", err)
+			}
+			res := NewCanvasTileGetCanvasTileOK(body, etag, cacheControl)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasTileGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasTileGet", err)
+			}
+			err = ValidateCanvasTileGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasTileGet", err)
+			}
+			return nil, NewCanvasTileGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasTileGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasTileGet", err)
+			}
+			err = ValidateCanvasTileGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasTileGet", err)
+			}
+			return nil, NewCanvasTileGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasTileGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasTileGet", err)
+			}
+			err = ValidateCanvasTileGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasTileGet", err)
+			}
+			return nil, NewCanvasTileGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasTileGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasSubscribeRequest instantiates a HTTP request object with method
+// and path set to call the "api" service "CanvasSubscribe" endpoint
+func (c *Client) BuildCanvasSubscribeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasSubscribePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasSubscribe", "*api.CanvasSubscribePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CanvasSubscribeAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasSubscribe", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasSubscribeRequest returns an encoder for requests sent to the api
+// CanvasSubscribe server.
+func EncodeCanvasSubscribeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasSubscribePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasSubscribe", "*api.CanvasSubscribePayload", v)
+		}
+		if p.LastEventID != nil {
+			head := *p.LastEventID
+			req.Header.Set("Last-Event-ID", head)
+		}
+		values := req.URL.Query()
+		if p.Since != nil {
+			values.Add("since", fmt.Sprintf("%v", *p.Since))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCanvasSubscribeResponse returns a decoder for responses returned by
+// the api CanvasSubscribe endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCanvasSubscribeResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasSubscribeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CanvasSubscribeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSubscribe", err)
+			}
+			err = ValidateCanvasSubscribeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSubscribe", err)
+			}
+			res := NewCanvasSubscribeCanvasEventOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasSubscribeUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSubscribe", err)
+			}
+			err = ValidateCanvasSubscribeUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSubscribe", err)
+			}
+			return nil, NewCanvasSubscribeUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasSubscribeAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSubscribe", err)
+			}
+			err = ValidateCanvasSubscribeAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSubscribe", err)
+			}
+			return nil, NewCanvasSubscribeAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasSubscribeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSubscribe", err)
+			}
+			err = ValidateCanvasSubscribeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSubscribe", err)
+			}
+			return nil, NewCanvasSubscribeNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasSubscribe", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCanvasSessionRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "CanvasSession" endpoint
+func (c *Client) BuildCanvasSessionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.CanvasSessionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "CanvasSession", "*api.CanvasSessionPayload", v)
+		}
+		id = p.ID
+	}
+	scheme := c.scheme
+	switch c.scheme {
+	case "http":
+		scheme = "ws"
+	case "https":
+		scheme = "wss"
+	}
+	u := &url.URL{Scheme: scheme, Host: c.host, Path: CanvasSessionAPIPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "CanvasSession", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCanvasSessionRequest returns an encoder for requests sent to the api
+// CanvasSession server.
+func EncodeCanvasSessionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.CanvasSessionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "CanvasSession", "*api.CanvasSessionPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("access_token", p.Token)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCanvasSessionResponse returns a decoder for responses returned by the
+// api CanvasSession endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCanvasSessionResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCanvasSessionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CanvasSessionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSession", err)
+			}
+			err = ValidateCanvasSessionResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSession", err)
+			}
+			res := NewCanvasSessionSessionEventOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CanvasSessionUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSession", err)
+			}
+			err = ValidateCanvasSessionUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSession", err)
+			}
+			return nil, NewCanvasSessionUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body CanvasSessionAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSession", err)
+			}
+			err = ValidateCanvasSessionAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSession", err)
+			}
+			return nil, NewCanvasSessionAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body CanvasSessionNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "CanvasSession", err)
+			}
+			err = ValidateCanvasSessionNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "CanvasSession", err)
+			}
+			return nil, NewCanvasSessionNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "CanvasSession", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPixelPlaceRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "PixelPlace" endpoint
+func (c *Client) BuildPixelPlaceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*api.PixelPlacePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "PixelPlace", "*api.PixelPlacePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PixelPlaceAPIPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "PixelPlace", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePixelPlaceRequest returns an encoder for requests sent to the api
+// PixelPlace server.
+func EncodePixelPlaceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.PixelPlacePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "PixelPlace", "*api.PixelPlacePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewPixelPlaceRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("api", "PixelPlace", err)
+		}
+		return nil
+	}
+}
+
+// DecodePixelPlaceResponse returns a decoder for responses returned by the api
+// PixelPlace endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+// DecodePixelPlaceResponse may return the following errors:
+//   - "cooldown_active" (type *api.CooldownError): http.StatusTooManyRequests
+//   - "canvas_archived" (type *goa.ServiceError): http.StatusConflict
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodePixelPlaceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body PixelPlaceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelPlace", err)
+			}
+			p := NewPixelPlacePixelCreated(&body)
+			view := "default"
+			vres := &apiviews.Pixel{Projected: p, View: view}
+			if err = apiviews.ValidatePixel(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelPlace", err)
+			}
+			res := api.NewPixel(vres)
+			return res, nil
+		case http.StatusTooManyRequests:
+			var (
+				body PixelPlaceCooldownActiveResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelPlace", err)
+			}
+			err = ValidatePixelPlaceCooldownActiveResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelPlace", err)
+			}
+			var (
+				retryAfter int32
+			)
+			{
+				retryAfterRaw := resp.Header.Get("Retry-After")
+				if retryAfterRaw == "" {
+					return nil, goahttp.ErrValidationError("api", "PixelPlace", goa.MissingFieldError("retry_after", "header"))
+				}
+				v, err2 := strconv.ParseInt(retryAfterRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("retry_after", retryAfterRaw, "integer"))
+				}
+				retryAfter = int32(v)
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelPlace", err)
+			}
+			return nil, NewPixelPlaceCooldownActive(&body, retryAfter)
+		case http.StatusConflict:
+			var (
+				body PixelPlaceCanvasArchivedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelPlace", err)
+			}
+			err = ValidatePixelPlaceCanvasArchivedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelPlace", err)
+			}
+			return nil, NewPixelPlaceCanvasArchived(&body)
+		case http.StatusUnauthorized:
+			var (
+				body PixelPlaceUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelPlace", err)
+			}
+			err = ValidatePixelPlaceUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelPlace", err)
+			}
+			return nil, NewPixelPlaceUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body PixelPlaceAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelPlace", err)
+			}
+			err = ValidatePixelPlaceAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelPlace", err)
+			}
+			return nil, NewPixelPlaceAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body PixelPlaceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelPlace", err)
+			}
+			err = ValidatePixelPlaceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelPlace", err)
+			}
+			return nil, NewPixelPlaceNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "PixelPlace", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPixelInfoGetRequest instantiates a HTTP request object with method and
+// path set to call the "api" service "PixelInfoGet" endpoint
+func (c *Client) BuildPixelInfoGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+		x  int32
+		y  int32
+	)
+	{
+		p, ok := v.(*api.PixelInfoGetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("api", "PixelInfoGet", "*api.PixelInfoGetPayload", v)
+		}
+		id = p.ID
+		x = p.X
+		y = p.Y
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PixelInfoGetAPIPath(id, x, y)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("api", "PixelInfoGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePixelInfoGetRequest returns an encoder for requests sent to the api
+// PixelInfoGet server.
+func EncodePixelInfoGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*api.PixelInfoGetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("api", "PixelInfoGet", "*api.PixelInfoGetPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodePixelInfoGetResponse returns a decoder for responses returned by the
+// api PixelInfoGet endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodePixelInfoGetResponse may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "access_denied" (type *goa.ServiceError): http.StatusForbidden
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodePixelInfoGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PixelInfoGetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelInfoGet", err)
+			}
+			p := NewPixelInfoGetPixelInfoOK(&body)
+			view := "default"
+			vres := &apiviews.PixelInfo{Projected: p, View: view}
+			if err = apiviews.ValidatePixelInfo(vres); err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelInfoGet", err)
+			}
+			res := api.NewPixelInfo(vres)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body PixelInfoGetUnauthenticatedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelInfoGet", err)
+			}
+			err = ValidatePixelInfoGetUnauthenticatedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelInfoGet", err)
+			}
+			return nil, NewPixelInfoGetUnauthenticated(&body)
+		case http.StatusForbidden:
+			var (
+				body PixelInfoGetAccessDeniedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelInfoGet", err)
+			}
+			err = ValidatePixelInfoGetAccessDeniedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelInfoGet", err)
+			}
+			return nil, NewPixelInfoGetAccessDenied(&body)
+		case http.StatusNotFound:
+			var (
+				body PixelInfoGetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("api", "PixelInfoGet", err)
+			}
+			err = ValidatePixelInfoGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("api", "PixelInfoGet", err)
+			}
+			return nil, NewPixelInfoGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("api", "PixelInfoGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalProtectedRegionResponseBodyToApiviewsProtectedRegionView builds a
+// value of type *apiviews.ProtectedRegionView from a value of type
+// *ProtectedRegionResponseBody.
+func unmarshalProtectedRegionResponseBodyToApiviewsProtectedRegionView(v *ProtectedRegionResponseBody) *apiviews.ProtectedRegionView {
+	res := &apiviews.ProtectedRegionView{
+		ID:        v.ID,
+		X:         v.X,
+		Y:         v.Y,
+		Width:     v.Width,
+		Height:    v.Height,
+		Reason:    v.Reason,
+		CreatedAt: v.CreatedAt,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// unmarshalCanvasResponseBodyToApiviewsCanvasView builds a value of type
+// *apiviews.CanvasView from a value of type *CanvasResponseBody.
+func unmarshalCanvasResponseBodyToApiviewsCanvasView(v *CanvasResponseBody) *apiviews.CanvasView {
+	res := &apiviews.CanvasView{
+		ID:         v.ID,
+		Width:      v.Width,
+		Height:     v.Height,
+		CreatedAt:  v.CreatedAt,
+		ArchivedAt: v.ArchivedAt,
+		Version:    v.Version,
+	}
+	res.Palette = make([]string, len(v.Palette))
+	for i, val := range v.Palette {
+		res.Palette[i] = val
+	}
+	res.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(v.ProtectedRegions))
+	for i, val := range v.ProtectedRegions {
+		res.ProtectedRegions[i] = unmarshalProtectedRegionResponseBodyToApiviewsProtectedRegionView(val)
+	}
+
+	return res
+}
+
+// unmarshalAuditEntryResponseBodyToApiviewsAuditEntryView builds a value of
+// type *apiviews.AuditEntryView from a value of type *AuditEntryResponseBody.
+func unmarshalAuditEntryResponseBodyToApiviewsAuditEntryView(v *AuditEntryResponseBody) *apiviews.AuditEntryView {
+	res := &apiviews.AuditEntryView{
+		Action:    v.Action,
+		UserID:    v.UserID,
+		Details:   v.Details,
+		CreatedAt: v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalCanvasChunkResponseBodyToApiviewsCanvasChunkView builds a value of
+// type *apiviews.CanvasChunkView from a value of type *CanvasChunkResponseBody.
+func unmarshalCanvasChunkResponseBodyToApiviewsCanvasChunkView(v *CanvasChunkResponseBody) *apiviews.CanvasChunkView {
+	res := &apiviews.CanvasChunkView{
+		X:       v.X,
+		Y:       v.Y,
+		Width:   v.Width,
+		Height:  v.Height,
+		Version: v.Version,
+		Pixels:  v.Pixels,
+	}
+
+	return res
+}
+
+// unmarshalPixelEventResponseBodyToAPIPixelEvent builds a value of type
+// *api.PixelEvent from a value of type *PixelEventResponseBody.
+func unmarshalPixelEventResponseBodyToAPIPixelEvent(v *PixelEventResponseBody) *api.PixelEvent {
+	if v == nil {
+		return nil
+	}
+	res := &api.PixelEvent{
+		X:        *v.X,
+		Y:        *v.Y,
+		Color:    *v.Color,
+		UserID:   *v.UserID,
+		PlacedAt: *v.PlacedAt,
+		Seq:      *v.Seq,
+	}
+
+	return res
+}
+
+// unmarshalCanvasSnapshotResponseBodyToAPICanvasSnapshot builds a value of
+// type *api.CanvasSnapshot from a value of type *CanvasSnapshotResponseBody.
+func unmarshalCanvasSnapshotResponseBodyToAPICanvasSnapshot(v *CanvasSnapshotResponseBody) *api.CanvasSnapshot {
+	if v == nil {
+		return nil
+	}
+	res := &api.CanvasSnapshot{
+		Seq:    *v.Seq,
+		Width:  *v.Width,
+		Height: *v.Height,
+		Pixels: v.Pixels,
+	}
+
+	return res
+}
+
+// unmarshalCanvasResponseBodyToAPICanvas builds a value of type *api.Canvas
+// from a value of type *CanvasResponseBody.
+func unmarshalCanvasResponseBodyToAPICanvas(v *CanvasResponseBody) *api.Canvas {
+	if v == nil {
+		return nil
+	}
+	res := &api.Canvas{
+		ID:         *v.ID,
+		Width:      *v.Width,
+		Height:     *v.Height,
+		CreatedAt:  *v.CreatedAt,
+		ArchivedAt: v.ArchivedAt,
+		Version:    *v.Version,
+	}
+	res.Palette = make([]string, len(v.Palette))
+	for i, val := range v.Palette {
+		res.Palette[i] = val
+	}
+	res.ProtectedRegions = make([]*api.ProtectedRegion, len(v.ProtectedRegions))
+	for i, val := range v.ProtectedRegions {
+		res.ProtectedRegions[i] = unmarshalProtectedRegionResponseBodyToAPIProtectedRegion(val)
+	}
+
+	return res
+}
+
+// unmarshalProtectedRegionResponseBodyToAPIProtectedRegion builds a value of
+// type *api.ProtectedRegion from a value of type *ProtectedRegionResponseBody.
+func unmarshalProtectedRegionResponseBodyToAPIProtectedRegion(v *ProtectedRegionResponseBody) *api.ProtectedRegion {
+	res := &api.ProtectedRegion{
+		ID:        *v.ID,
+		X:         *v.X,
+		Y:         *v.Y,
+		Width:     *v.Width,
+		Height:    *v.Height,
+		Reason:    *v.Reason,
+		CreatedAt: *v.CreatedAt,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// unmarshalPlacementRejectionResponseBodyToAPIPlacementRejection builds a
+// value of type *api.PlacementRejection from a value of type
+// *PlacementRejectionResponseBody.
+func unmarshalPlacementRejectionResponseBodyToAPIPlacementRejection(v *PlacementRejectionResponseBody) *api.PlacementRejection {
+	if v == nil {
+		return nil
+	}
+	res := &api.PlacementRejection{
+		X:          v.X,
+		Y:          v.Y,
+		Name:       *v.Name,
+		Message:    *v.Message,
+		RetryAfter: v.RetryAfter,
+	}
+
+	return res
+}
+
+// unmarshalPixelHistoryEntryResponseBodyToApiviewsPixelHistoryEntryView builds
+// a value of type *apiviews.PixelHistoryEntryView from a value of type
+// *PixelHistoryEntryResponseBody.
+func unmarshalPixelHistoryEntryResponseBodyToApiviewsPixelHistoryEntryView(v *PixelHistoryEntryResponseBody) *apiviews.PixelHistoryEntryView {
+	res := &apiviews.PixelHistoryEntryView{
+		UserID:   v.UserID,
+		Color:    v.Color,
+		PlacedAt: v.PlacedAt,
+		Seq:      v.Seq,
+	}
+
+	return res
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api HTTP client types
 //
@@ -8,16 +8,346 @@
 package client
 
 import (
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CanvasCreateRequestBody is the type of the "api" service "CanvasCreate"
+// endpoint HTTP request body.
+type CanvasCreateRequestBody struct {
+	Width  int32 `form:"width" json:"width" xml:"width"`
+	Height int32 `form:"height" json:"height" xml:"height"`
+	// Ordered list of colors available on the canvas, defaults to the standard
+	// palette if empty.
+	Palette []string `form:"palette,omitempty" json:"palette,omitempty" xml:"palette,omitempty"`
+}
+
+// CanvasResizeRequestBody is the type of the "api" service "CanvasResize"
+// endpoint HTTP request body.
+type CanvasResizeRequestBody struct {
+	Left   int32 `form:"left" json:"left" xml:"left"`
+	Top    int32 `form:"top" json:"top" xml:"top"`
+	Right  int32 `form:"right" json:"right" xml:"right"`
+	Bottom int32 `form:"bottom" json:"bottom" xml:"bottom"`
+	// Color of the pixels added to the canvas.
+	Fill int32 `form:"fill" json:"fill" xml:"fill"`
+}
+
+// ProtectedRegionCreateRequestBody is the type of the "api" service
+// "ProtectedRegionCreate" endpoint HTTP request body.
+type ProtectedRegionCreateRequestBody struct {
+	X      int32 `form:"x" json:"x" xml:"x"`
+	Y      int32 `form:"y" json:"y" xml:"y"`
+	Width  int32 `form:"width" json:"width" xml:"width"`
+	Height int32 `form:"height" json:"height" xml:"height"`
+	// Why the region is protected, e.g. a sponsor's logo.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Number of seconds to protect the region for. The region is protected until
+	// deleted if unset.
+	Duration *int32 `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+}
+
+// CanvasRollbackRequestBody is the type of the "api" service "CanvasRollback"
+// endpoint HTTP request body.
+type CanvasRollbackRequestBody struct {
+	// Revert pixels placed by this user.
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	X      *int32  `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y      *int32  `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	// Revert pixels within this region, along with x, y and height.
+	Width  *int32 `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32 `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Revert pixels placed at or after this time.
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Revert pixels placed before this time.
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// CanvasSessionStreamingBody is the type of the "api" service "CanvasSession"
+// endpoint HTTP request body.
+type CanvasSessionStreamingBody PixelPlacementStreamingBody
+
+// PixelPlaceRequestBody is the type of the "api" service "PixelPlace" endpoint
+// HTTP request body.
+type PixelPlaceRequestBody struct {
+	X     int32 `form:"x" json:"x" xml:"x"`
+	Y     int32 `form:"y" json:"y" xml:"y"`
+	Color int32 `form:"color" json:"color" xml:"color"`
+}
+
+// CanvasCreateResponseBody is the type of the "api" service "CanvasCreate"
+// endpoint HTTP response body.
+type CanvasCreateResponseBody struct {
+	ID     *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Width  *int32  `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32  `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette,omitempty" json:"palette,omitempty" xml:"palette,omitempty"`
+	CreatedAt *string  `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions,omitempty" json:"protected_regions,omitempty" xml:"protected_regions,omitempty"`
+}
+
+// CanvasListResponseBody is the type of the "api" service "CanvasList"
+// endpoint HTTP response body.
+type CanvasListResponseBody struct {
+	// Canvases in the order they were created.
+	Canvases []*CanvasResponseBody `form:"canvases,omitempty" json:"canvases,omitempty" xml:"canvases,omitempty"`
+}
+
 // CanvasGetResponseBody is the type of the "api" service "CanvasGet" endpoint
 // HTTP response body.
 type CanvasGetResponseBody struct {
 	ID     *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 	Width  *int32  `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
 	Height *int32  `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette,omitempty" json:"palette,omitempty" xml:"palette,omitempty"`
+	CreatedAt *string  `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions,omitempty" json:"protected_regions,omitempty" xml:"protected_regions,omitempty"`
+}
+
+// CanvasArchiveResponseBody is the type of the "api" service "CanvasArchive"
+// endpoint HTTP response body.
+type CanvasArchiveResponseBody struct {
+	ID     *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Width  *int32  `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32  `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette,omitempty" json:"palette,omitempty" xml:"palette,omitempty"`
+	CreatedAt *string  `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions,omitempty" json:"protected_regions,omitempty" xml:"protected_regions,omitempty"`
+}
+
+// CanvasResizeResponseBody is the type of the "api" service "CanvasResize"
+// endpoint HTTP response body.
+type CanvasResizeResponseBody struct {
+	ID     *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Width  *int32  `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32  `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette,omitempty" json:"palette,omitempty" xml:"palette,omitempty"`
+	CreatedAt *string  `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions,omitempty" json:"protected_regions,omitempty" xml:"protected_regions,omitempty"`
+}
+
+// ProtectedRegionCreateResponseBody is the type of the "api" service
+// "ProtectedRegionCreate" endpoint HTTP response body.
+type ProtectedRegionCreateResponseBody struct {
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	X         *int32  `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y         *int32  `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	Width     *int32  `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height    *int32  `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	Reason    *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Set if the region is only protected until then.
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// CanvasRollbackResponseBody is the type of the "api" service "CanvasRollback"
+// endpoint HTTP response body.
+type CanvasRollbackResponseBody struct {
+	// Number of pixels reverted.
+	Reverted *int32 `form:"reverted,omitempty" json:"reverted,omitempty" xml:"reverted,omitempty"`
+}
+
+// AuditLogGetResponseBody is the type of the "api" service "AuditLogGet"
+// endpoint HTTP response body.
+type AuditLogGetResponseBody struct {
+	// Latest actions taken by moderators on the canvas, most recent first.
+	Entries []*AuditEntryResponseBody `form:"entries,omitempty" json:"entries,omitempty" xml:"entries,omitempty"`
+}
+
+// CanvasChunksGetResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body.
+type CanvasChunksGetResponseBody struct {
+	// Sequence number of the latest placement on the canvas, to pass as since to
+	// fetch only later changes.
+	Version *int64 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Width and height of each chunk, except those on the right and bottom edges
+	// of the canvas.
+	ChunkSize *int32 `form:"chunk_size,omitempty" json:"chunk_size,omitempty" xml:"chunk_size,omitempty"`
+	// Chunks modified after the requested version.
+	Chunks []*CanvasChunkResponseBody `form:"chunks,omitempty" json:"chunks,omitempty" xml:"chunks,omitempty"`
+}
+
+// CanvasSubscribeResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body.
+type CanvasSubscribeResponseBody struct {
+	// Sequence number the canvas is at once the event is applied, used to resume
+	// the stream.
+	ID    *string                 `json:"id"`
+	Type  *string                 `json:"type"`
+	Pixel *PixelEventResponseBody `json:"pixel,omitempty"`
+	// Sent instead of replaying missed placements when there are too many of them,
+	// or when the canvas has been resized.
+	Snapshot *CanvasSnapshotResponseBody `json:"snapshot,omitempty"`
+}
+
+// CanvasSessionResponseBody is the type of the "api" service "CanvasSession"
+// endpoint HTTP response body.
+type CanvasSessionResponseBody struct {
+	// The canvas the session is for, sent when the session starts and again
+	// whenever the canvas is resized.
+	Canvas *CanvasResponseBody `form:"canvas,omitempty" json:"canvas,omitempty" xml:"canvas,omitempty"`
+	// A pixel placed on the canvas by any user.
+	Pixel *PixelEventResponseBody `form:"pixel,omitempty" json:"pixel,omitempty" xml:"pixel,omitempty"`
+	// A placement sent by the client that was rejected.
+	Rejection *PlacementRejectionResponseBody `form:"rejection,omitempty" json:"rejection,omitempty" xml:"rejection,omitempty"`
+}
+
+// PixelPlaceResponseBody is the type of the "api" service "PixelPlace"
+// endpoint HTTP response body.
+type PixelPlaceResponseBody struct {
+	X     *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y     *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	Color *int32 `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
+}
+
+// PixelInfoGetResponseBody is the type of the "api" service "PixelInfoGet"
+// endpoint HTTP response body.
+type PixelInfoGetResponseBody struct {
+	X *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	// Latest placements at the coordinate, most recent first.
+	Placements []*PixelHistoryEntryResponseBody `form:"placements,omitempty" json:"placements,omitempty" xml:"placements,omitempty"`
+}
+
+// CanvasCreateUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasCreate" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasCreateUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasCreateAccessDeniedResponseBody is the type of the "api" service
+// "CanvasCreate" endpoint HTTP response body for the "access_denied" error.
+type CanvasCreateAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasCreateNotFoundResponseBody is the type of the "api" service
+// "CanvasCreate" endpoint HTTP response body for the "not_found" error.
+type CanvasCreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasListUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasList" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasListUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasListAccessDeniedResponseBody is the type of the "api" service
+// "CanvasList" endpoint HTTP response body for the "access_denied" error.
+type CanvasListAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasListNotFoundResponseBody is the type of the "api" service "CanvasList"
+// endpoint HTTP response body for the "not_found" error.
+type CanvasListNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
 // CanvasGetUnauthenticatedResponseBody is the type of the "api" service
@@ -38,69 +368,3827 @@ type CanvasGetUnauthenticatedResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CanvasGetAccessDeniedResponseBody is the type of the "api" service
-// "CanvasGet" endpoint HTTP response body for the "access_denied" error.
-type CanvasGetAccessDeniedResponseBody struct {
-	// Name is the name of this class of errors.
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// ID is a unique identifier for this particular occurrence of the problem.
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Message is a human-readable explanation specific to this occurrence of the
-	// problem.
-	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
-	// Is the error temporary?
-	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
-	// Is the error a timeout?
-	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
-	// Is the error a server-side fault?
-	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+// CanvasGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasGetNotFoundResponseBody is the type of the "api" service "CanvasGet"
+// endpoint HTTP response body for the "not_found" error.
+type CanvasGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasArchiveUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasArchive" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasArchiveUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasArchiveAccessDeniedResponseBody is the type of the "api" service
+// "CanvasArchive" endpoint HTTP response body for the "access_denied" error.
+type CanvasArchiveAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasArchiveNotFoundResponseBody is the type of the "api" service
+// "CanvasArchive" endpoint HTTP response body for the "not_found" error.
+type CanvasArchiveNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasResizeCanvasArchivedResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "canvas_archived" error.
+type CanvasResizeCanvasArchivedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasResizeUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasResizeUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasResizeAccessDeniedResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "access_denied" error.
+type CanvasResizeAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasResizeNotFoundResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "not_found" error.
+type CanvasResizeNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ProtectedRegionCreateUnauthenticatedResponseBody is the type of the "api"
+// service "ProtectedRegionCreate" endpoint HTTP response body for the
+// "unauthenticated" error.
+type ProtectedRegionCreateUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ProtectedRegionCreateAccessDeniedResponseBody is the type of the "api"
+// service "ProtectedRegionCreate" endpoint HTTP response body for the
+// "access_denied" error.
+type ProtectedRegionCreateAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ProtectedRegionCreateNotFoundResponseBody is the type of the "api" service
+// "ProtectedRegionCreate" endpoint HTTP response body for the "not_found"
+// error.
+type ProtectedRegionCreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ProtectedRegionDeleteUnauthenticatedResponseBody is the type of the "api"
+// service "ProtectedRegionDelete" endpoint HTTP response body for the
+// "unauthenticated" error.
+type ProtectedRegionDeleteUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ProtectedRegionDeleteAccessDeniedResponseBody is the type of the "api"
+// service "ProtectedRegionDelete" endpoint HTTP response body for the
+// "access_denied" error.
+type ProtectedRegionDeleteAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ProtectedRegionDeleteNotFoundResponseBody is the type of the "api" service
+// "ProtectedRegionDelete" endpoint HTTP response body for the "not_found"
+// error.
+type ProtectedRegionDeleteNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRollbackCanvasArchivedResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "canvas_archived" error.
+type CanvasRollbackCanvasArchivedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRollbackUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasRollbackUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRollbackAccessDeniedResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "access_denied" error.
+type CanvasRollbackAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRollbackNotFoundResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "not_found" error.
+type CanvasRollbackNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AuditLogGetUnauthenticatedResponseBody is the type of the "api" service
+// "AuditLogGet" endpoint HTTP response body for the "unauthenticated" error.
+type AuditLogGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AuditLogGetAccessDeniedResponseBody is the type of the "api" service
+// "AuditLogGet" endpoint HTTP response body for the "access_denied" error.
+type AuditLogGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AuditLogGetNotFoundResponseBody is the type of the "api" service
+// "AuditLogGet" endpoint HTTP response body for the "not_found" error.
+type AuditLogGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasPixelsGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasPixelsGet" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasPixelsGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasPixelsGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasPixelsGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasPixelsGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasPixelsGetNotFoundResponseBody is the type of the "api" service
+// "CanvasPixelsGet" endpoint HTTP response body for the "not_found" error.
+type CanvasPixelsGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasChunksGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasChunksGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasChunksGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasChunksGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasChunksGetNotFoundResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body for the "not_found" error.
+type CanvasChunksGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRegionGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasRegionGet" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasRegionGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRegionGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasRegionGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasRegionGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRegionGetNotFoundResponseBody is the type of the "api" service
+// "CanvasRegionGet" endpoint HTTP response body for the "not_found" error.
+type CanvasRegionGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasImageGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasImageGet" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasImageGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasImageGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasImageGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasImageGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasImageGetNotFoundResponseBody is the type of the "api" service
+// "CanvasImageGet" endpoint HTTP response body for the "not_found" error.
+type CanvasImageGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRegionImageGetUnauthenticatedResponseBody is the type of the "api"
+// service "CanvasRegionImageGet" endpoint HTTP response body for the
+// "unauthenticated" error.
+type CanvasRegionImageGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRegionImageGetAccessDeniedResponseBody is the type of the "api"
+// service "CanvasRegionImageGet" endpoint HTTP response body for the
+// "access_denied" error.
+type CanvasRegionImageGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasRegionImageGetNotFoundResponseBody is the type of the "api" service
+// "CanvasRegionImageGet" endpoint HTTP response body for the "not_found" error.
+type CanvasRegionImageGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasTileGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasTileGet" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasTileGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasTileGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasTileGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasTileGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasTileGetNotFoundResponseBody is the type of the "api" service
+// "CanvasTileGet" endpoint HTTP response body for the "not_found" error.
+type CanvasTileGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasSubscribeUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasSubscribeUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasSubscribeAccessDeniedResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body for the "access_denied" error.
+type CanvasSubscribeAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasSubscribeNotFoundResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body for the "not_found" error.
+type CanvasSubscribeNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasSessionUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasSession" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasSessionUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasSessionAccessDeniedResponseBody is the type of the "api" service
+// "CanvasSession" endpoint HTTP response body for the "access_denied" error.
+type CanvasSessionAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CanvasSessionNotFoundResponseBody is the type of the "api" service
+// "CanvasSession" endpoint HTTP response body for the "not_found" error.
+type CanvasSessionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PixelPlaceCooldownActiveResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "cooldown_active" error.
+type PixelPlaceCooldownActiveResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PixelPlaceCanvasArchivedResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "canvas_archived" error.
+type PixelPlaceCanvasArchivedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PixelPlaceUnauthenticatedResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "unauthenticated" error.
+type PixelPlaceUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PixelPlaceAccessDeniedResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "access_denied" error.
+type PixelPlaceAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PixelPlaceNotFoundResponseBody is the type of the "api" service "PixelPlace"
+// endpoint HTTP response body for the "not_found" error.
+type PixelPlaceNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PixelInfoGetUnauthenticatedResponseBody is the type of the "api" service
+// "PixelInfoGet" endpoint HTTP response body for the "unauthenticated" error.
+type PixelInfoGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PixelInfoGetAccessDeniedResponseBody is the type of the "api" service
+// "PixelInfoGet" endpoint HTTP response body for the "access_denied" error.
+type PixelInfoGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PixelInfoGetNotFoundResponseBody is the type of the "api" service
+// "PixelInfoGet" endpoint HTTP response body for the "not_found" error.
+type PixelInfoGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ProtectedRegionResponseBody is used to define fields on response body types.
+type ProtectedRegionResponseBody struct {
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	X         *int32  `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y         *int32  `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	Width     *int32  `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height    *int32  `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	Reason    *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Set if the region is only protected until then.
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// CanvasResponseBody is used to define fields on response body types.
+type CanvasResponseBody struct {
+	ID     *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Width  *int32  `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32  `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette,omitempty" json:"palette,omitempty" xml:"palette,omitempty"`
+	CreatedAt *string  `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions,omitempty" json:"protected_regions,omitempty" xml:"protected_regions,omitempty"`
+}
+
+// AuditEntryResponseBody is used to define fields on response body types.
+type AuditEntryResponseBody struct {
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// ID of the moderator who took the action.
+	UserID    *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	Details   *string `form:"details,omitempty" json:"details,omitempty" xml:"details,omitempty"`
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// CanvasChunkResponseBody is used to define fields on response body types.
+type CanvasChunkResponseBody struct {
+	// X coordinate of the chunk, in chunks.
+	X *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	// Y coordinate of the chunk, in chunks.
+	Y      *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	Width  *int32 `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32 `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Sequence number of the latest placement in the chunk.
+	Version *int64 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte `form:"pixels,omitempty" json:"pixels,omitempty" xml:"pixels,omitempty"`
+}
+
+// PixelEventResponseBody is used to define fields on response body types.
+type PixelEventResponseBody struct {
+	X     *int32 `json:"x"`
+	Y     *int32 `json:"y"`
+	Color *int32 `json:"color"`
+	// ID of the user who placed the pixel.
+	UserID   *string `json:"user_id"`
+	PlacedAt *string `json:"placed_at"`
+	// Sequence number of the placement on the canvas.
+	Seq *int64 `json:"seq"`
+}
+
+// CanvasSnapshotResponseBody is used to define fields on response body types.
+type CanvasSnapshotResponseBody struct {
+	Seq    *int64 `json:"seq"`
+	Width  *int32 `json:"width"`
+	Height *int32 `json:"height"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte `json:"pixels"`
+}
+
+// PixelPlacementStreamingBody is used to define fields on request body types.
+type PixelPlacementStreamingBody struct {
+	X     int32 `form:"x" json:"x" xml:"x"`
+	Y     int32 `form:"y" json:"y" xml:"y"`
+	Color int32 `form:"color" json:"color" xml:"color"`
+}
+
+// PlacementRejectionResponseBody is used to define fields on response body
+// types.
+type PlacementRejectionResponseBody struct {
+	// X coordinate of the placement, if it could be decoded.
+	X *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	// Y coordinate of the placement, if it could be decoded.
+	Y *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	// Name of the error, e.g. cooldown_active.
+	Name    *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Number of seconds to wait before placing another pixel, if on cooldown.
+	RetryAfter *int32 `form:"retry_after,omitempty" json:"retry_after,omitempty" xml:"retry_after,omitempty"`
+}
+
+// PixelHistoryEntryResponseBody is used to define fields on response body
+// types.
+type PixelHistoryEntryResponseBody struct {
+	// ID of the user who placed the pixel.
+	UserID   *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	Color    *int32  `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
+	PlacedAt *string `form:"placed_at,omitempty" json:"placed_at,omitempty" xml:"placed_at,omitempty"`
+	// Sequence number of the placement on the canvas.
+	Seq *int64 `form:"seq,omitempty" json:"seq,omitempty" xml:"seq,omitempty"`
+}
+
+// NewCanvasCreateRequestBody builds the HTTP request body from the payload of
+// the "CanvasCreate" endpoint of the "api" service.
+func NewCanvasCreateRequestBody(p *api.CanvasCreatePayload) *CanvasCreateRequestBody {
+	body := &CanvasCreateRequestBody{
+		Width:  p.Width,
+		Height: p.Height,
+	}
+	if p.Palette != nil {
+		body.Palette = make([]string, len(p.Palette))
+		for i, val := range p.Palette {
+			body.Palette[i] = val
+		}
+	}
+	return body
+}
+
+// NewCanvasResizeRequestBody builds the HTTP request body from the payload of
+// the "CanvasResize" endpoint of the "api" service.
+func NewCanvasResizeRequestBody(p *api.CanvasResizePayload) *CanvasResizeRequestBody {
+	body := &CanvasResizeRequestBody{
+		Left:   p.Left,
+		Top:    p.Top,
+		Right:  p.Right,
+		Bottom: p.Bottom,
+		Fill:   p.Fill,
+	}
+	{
+		var zero int32
+		if body.Left == zero {
+			body.Left = 0
+		}
+	}
+	{
+		var zero int32
+		if body.Top == zero {
+			body.Top = 0
+		}
+	}
+	{
+		var zero int32
+		if body.Right == zero {
+			body.Right = 0
+		}
+	}
+	{
+		var zero int32
+		if body.Bottom == zero {
+			body.Bottom = 0
+		}
+	}
+	{
+		var zero int32
+		if body.Fill == zero {
+			body.Fill = 0
+		}
+	}
+	return body
+}
+
+// NewProtectedRegionCreateRequestBody builds the HTTP request body from the
+// payload of the "ProtectedRegionCreate" endpoint of the "api" service.
+func NewProtectedRegionCreateRequestBody(p *api.ProtectedRegionCreatePayload) *ProtectedRegionCreateRequestBody {
+	body := &ProtectedRegionCreateRequestBody{
+		X:        p.X,
+		Y:        p.Y,
+		Width:    p.Width,
+		Height:   p.Height,
+		Reason:   p.Reason,
+		Duration: p.Duration,
+	}
+	return body
+}
+
+// NewCanvasRollbackRequestBody builds the HTTP request body from the payload
+// of the "CanvasRollback" endpoint of the "api" service.
+func NewCanvasRollbackRequestBody(p *api.CanvasRollbackPayload) *CanvasRollbackRequestBody {
+	body := &CanvasRollbackRequestBody{
+		UserID: p.UserID,
+		X:      p.X,
+		Y:      p.Y,
+		Width:  p.Width,
+		Height: p.Height,
+		From:   p.From,
+		To:     p.To,
+	}
+	return body
+}
+
+// NewCanvasSessionStreamingBody builds the HTTP request body from the payload
+// of the "CanvasSession" endpoint of the "api" service.
+func NewCanvasSessionStreamingBody(p *api.PixelPlacement) *CanvasSessionStreamingBody {
+	body := &CanvasSessionStreamingBody{
+		X:     p.X,
+		Y:     p.Y,
+		Color: p.Color,
+	}
+	return body
+}
+
+// NewPixelPlaceRequestBody builds the HTTP request body from the payload of
+// the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceRequestBody(p *api.PixelPlacePayload) *PixelPlaceRequestBody {
+	body := &PixelPlaceRequestBody{
+		X:     p.X,
+		Y:     p.Y,
+		Color: p.Color,
+	}
+	return body
+}
+
+// NewCanvasCreateCanvasCreated builds a "api" service "CanvasCreate" endpoint
+// result from a HTTP "Created" response.
+func NewCanvasCreateCanvasCreated(body *CanvasCreateResponseBody) *apiviews.CanvasView {
+	v := &apiviews.CanvasView{
+		ID:         body.ID,
+		Width:      body.Width,
+		Height:     body.Height,
+		CreatedAt:  body.CreatedAt,
+		ArchivedAt: body.ArchivedAt,
+		Version:    body.Version,
+	}
+	v.Palette = make([]string, len(body.Palette))
+	for i, val := range body.Palette {
+		v.Palette[i] = val
+	}
+	v.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(body.ProtectedRegions))
+	for i, val := range body.ProtectedRegions {
+		v.ProtectedRegions[i] = unmarshalProtectedRegionResponseBodyToApiviewsProtectedRegionView(val)
+	}
+
+	return v
+}
+
+// NewCanvasCreateUnauthenticated builds a api service CanvasCreate endpoint
+// unauthenticated error.
+func NewCanvasCreateUnauthenticated(body *CanvasCreateUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasCreateAccessDenied builds a api service CanvasCreate endpoint
+// access_denied error.
+func NewCanvasCreateAccessDenied(body *CanvasCreateAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasCreateNotFound builds a api service CanvasCreate endpoint not_found
+// error.
+func NewCanvasCreateNotFound(body *CanvasCreateNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasListCanvasesOK builds a "api" service "CanvasList" endpoint result
+// from a HTTP "OK" response.
+func NewCanvasListCanvasesOK(body *CanvasListResponseBody) *apiviews.CanvasesView {
+	v := &apiviews.CanvasesView{}
+	v.Canvases = make([]*apiviews.CanvasView, len(body.Canvases))
+	for i, val := range body.Canvases {
+		v.Canvases[i] = unmarshalCanvasResponseBodyToApiviewsCanvasView(val)
+	}
+
+	return v
+}
+
+// NewCanvasListUnauthenticated builds a api service CanvasList endpoint
+// unauthenticated error.
+func NewCanvasListUnauthenticated(body *CanvasListUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasListAccessDenied builds a api service CanvasList endpoint
+// access_denied error.
+func NewCanvasListAccessDenied(body *CanvasListAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasListNotFound builds a api service CanvasList endpoint not_found
+// error.
+func NewCanvasListNotFound(body *CanvasListNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasGetCanvasOK builds a "api" service "CanvasGet" endpoint result from
+// a HTTP "OK" response.
+func NewCanvasGetCanvasOK(body *CanvasGetResponseBody) *apiviews.CanvasView {
+	v := &apiviews.CanvasView{
+		ID:         body.ID,
+		Width:      body.Width,
+		Height:     body.Height,
+		CreatedAt:  body.CreatedAt,
+		ArchivedAt: body.ArchivedAt,
+		Version:    body.Version,
+	}
+	v.Palette = make([]string, len(body.Palette))
+	for i, val := range body.Palette {
+		v.Palette[i] = val
+	}
+	v.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(body.ProtectedRegions))
+	for i, val := range body.ProtectedRegions {
+		v.ProtectedRegions[i] = unmarshalProtectedRegionResponseBodyToApiviewsProtectedRegionView(val)
+	}
+
+	return v
+}
+
+// NewCanvasGetUnauthenticated builds a api service CanvasGet endpoint
+// unauthenticated error.
+func NewCanvasGetUnauthenticated(body *CanvasGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasGetAccessDenied builds a api service CanvasGet endpoint
+// access_denied error.
+func NewCanvasGetAccessDenied(body *CanvasGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasGetNotFound builds a api service CanvasGet endpoint not_found error.
+func NewCanvasGetNotFound(body *CanvasGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasArchiveCanvasOK builds a "api" service "CanvasArchive" endpoint
+// result from a HTTP "OK" response.
+func NewCanvasArchiveCanvasOK(body *CanvasArchiveResponseBody) *apiviews.CanvasView {
+	v := &apiviews.CanvasView{
+		ID:         body.ID,
+		Width:      body.Width,
+		Height:     body.Height,
+		CreatedAt:  body.CreatedAt,
+		ArchivedAt: body.ArchivedAt,
+		Version:    body.Version,
+	}
+	v.Palette = make([]string, len(body.Palette))
+	for i, val := range body.Palette {
+		v.Palette[i] = val
+	}
+	v.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(body.ProtectedRegions))
+	for i, val := range body.ProtectedRegions {
+		v.ProtectedRegions[i] = unmarshalProtectedRegionResponseBodyToApiviewsProtectedRegionView(val)
+	}
+
+	return v
+}
+
+// NewCanvasArchiveUnauthenticated builds a api service CanvasArchive endpoint
+// unauthenticated error.
+func NewCanvasArchiveUnauthenticated(body *CanvasArchiveUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasArchiveAccessDenied builds a api service CanvasArchive endpoint
+// access_denied error.
+func NewCanvasArchiveAccessDenied(body *CanvasArchiveAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasArchiveNotFound builds a api service CanvasArchive endpoint
+// not_found error.
+func NewCanvasArchiveNotFound(body *CanvasArchiveNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasResizeCanvasOK builds a "api" service "CanvasResize" endpoint
+// result from a HTTP "OK" response.
+func NewCanvasResizeCanvasOK(body *CanvasResizeResponseBody) *apiviews.CanvasView {
+	v := &apiviews.CanvasView{
+		ID:         body.ID,
+		Width:      body.Width,
+		Height:     body.Height,
+		CreatedAt:  body.CreatedAt,
+		ArchivedAt: body.ArchivedAt,
+		Version:    body.Version,
+	}
+	v.Palette = make([]string, len(body.Palette))
+	for i, val := range body.Palette {
+		v.Palette[i] = val
+	}
+	v.ProtectedRegions = make([]*apiviews.ProtectedRegionView, len(body.ProtectedRegions))
+	for i, val := range body.ProtectedRegions {
+		v.ProtectedRegions[i] = unmarshalProtectedRegionResponseBodyToApiviewsProtectedRegionView(val)
+	}
+
+	return v
+}
+
+// NewCanvasResizeCanvasArchived builds a api service CanvasResize endpoint
+// canvas_archived error.
+func NewCanvasResizeCanvasArchived(body *CanvasResizeCanvasArchivedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasResizeUnauthenticated builds a api service CanvasResize endpoint
+// unauthenticated error.
+func NewCanvasResizeUnauthenticated(body *CanvasResizeUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasResizeAccessDenied builds a api service CanvasResize endpoint
+// access_denied error.
+func NewCanvasResizeAccessDenied(body *CanvasResizeAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasResizeNotFound builds a api service CanvasResize endpoint not_found
+// error.
+func NewCanvasResizeNotFound(body *CanvasResizeNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewProtectedRegionCreateProtectedRegionCreated builds a "api" service
+// "ProtectedRegionCreate" endpoint result from a HTTP "Created" response.
+func NewProtectedRegionCreateProtectedRegionCreated(body *ProtectedRegionCreateResponseBody) *apiviews.ProtectedRegionView {
+	v := &apiviews.ProtectedRegionView{
+		ID:        body.ID,
+		X:         body.X,
+		Y:         body.Y,
+		Width:     body.Width,
+		Height:    body.Height,
+		Reason:    body.Reason,
+		CreatedAt: body.CreatedAt,
+		ExpiresAt: body.ExpiresAt,
+	}
+
+	return v
+}
+
+// NewProtectedRegionCreateUnauthenticated builds a api service
+// ProtectedRegionCreate endpoint unauthenticated error.
+func NewProtectedRegionCreateUnauthenticated(body *ProtectedRegionCreateUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewProtectedRegionCreateAccessDenied builds a api service
+// ProtectedRegionCreate endpoint access_denied error.
+func NewProtectedRegionCreateAccessDenied(body *ProtectedRegionCreateAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewProtectedRegionCreateNotFound builds a api service ProtectedRegionCreate
+// endpoint not_found error.
+func NewProtectedRegionCreateNotFound(body *ProtectedRegionCreateNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewProtectedRegionDeleteUnauthenticated builds a api service
+// ProtectedRegionDelete endpoint unauthenticated error.
+func NewProtectedRegionDeleteUnauthenticated(body *ProtectedRegionDeleteUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewProtectedRegionDeleteAccessDenied builds a api service
+// ProtectedRegionDelete endpoint access_denied error.
+func NewProtectedRegionDeleteAccessDenied(body *ProtectedRegionDeleteAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewProtectedRegionDeleteNotFound builds a api service ProtectedRegionDelete
+// endpoint not_found error.
+func NewProtectedRegionDeleteNotFound(body *ProtectedRegionDeleteNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRollbackRollbackOK builds a "api" service "CanvasRollback" endpoint
+// result from a HTTP "OK" response.
+func NewCanvasRollbackRollbackOK(body *CanvasRollbackResponseBody) *apiviews.RollbackView {
+	v := &apiviews.RollbackView{
+		Reverted: body.Reverted,
+	}
+
+	return v
+}
+
+// NewCanvasRollbackCanvasArchived builds a api service CanvasRollback endpoint
+// canvas_archived error.
+func NewCanvasRollbackCanvasArchived(body *CanvasRollbackCanvasArchivedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRollbackUnauthenticated builds a api service CanvasRollback
+// endpoint unauthenticated error.
+func NewCanvasRollbackUnauthenticated(body *CanvasRollbackUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRollbackAccessDenied builds a api service CanvasRollback endpoint
+// access_denied error.
+func NewCanvasRollbackAccessDenied(body *CanvasRollbackAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRollbackNotFound builds a api service CanvasRollback endpoint
+// not_found error.
+func NewCanvasRollbackNotFound(body *CanvasRollbackNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAuditLogGetAuditLogOK builds a "api" service "AuditLogGet" endpoint
+// result from a HTTP "OK" response.
+func NewAuditLogGetAuditLogOK(body *AuditLogGetResponseBody) *apiviews.AuditLogView {
+	v := &apiviews.AuditLogView{}
+	v.Entries = make([]*apiviews.AuditEntryView, len(body.Entries))
+	for i, val := range body.Entries {
+		v.Entries[i] = unmarshalAuditEntryResponseBodyToApiviewsAuditEntryView(val)
+	}
+
+	return v
+}
+
+// NewAuditLogGetUnauthenticated builds a api service AuditLogGet endpoint
+// unauthenticated error.
+func NewAuditLogGetUnauthenticated(body *AuditLogGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAuditLogGetAccessDenied builds a api service AuditLogGet endpoint
+// access_denied error.
+func NewAuditLogGetAccessDenied(body *AuditLogGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAuditLogGetNotFound builds a api service AuditLogGet endpoint not_found
+// error.
+func NewAuditLogGetNotFound(body *AuditLogGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasPixelsGetCanvasPixelsOK builds a "api" service "CanvasPixelsGet"
+// endpoint result from a HTTP "OK" response.
+func NewCanvasPixelsGetCanvasPixelsOK(body []byte, width int32, height int32) *apiviews.CanvasPixelsView {
+	v := body
+	res := &apiviews.CanvasPixelsView{
+		Pixels: v,
+	}
+	res.Width = &width
+	res.Height = &height
+
+	return res
+}
+
+// NewCanvasPixelsGetUnauthenticated builds a api service CanvasPixelsGet
+// endpoint unauthenticated error.
+func NewCanvasPixelsGetUnauthenticated(body *CanvasPixelsGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasPixelsGetAccessDenied builds a api service CanvasPixelsGet endpoint
+// access_denied error.
+func NewCanvasPixelsGetAccessDenied(body *CanvasPixelsGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasPixelsGetNotFound builds a api service CanvasPixelsGet endpoint
+// not_found error.
+func NewCanvasPixelsGetNotFound(body *CanvasPixelsGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasChunksGetCanvasChunksOK builds a "api" service "CanvasChunksGet"
+// endpoint result from a HTTP "OK" response.
+func NewCanvasChunksGetCanvasChunksOK(body *CanvasChunksGetResponseBody) *apiviews.CanvasChunksView {
+	v := &apiviews.CanvasChunksView{
+		Version:   body.Version,
+		ChunkSize: body.ChunkSize,
+	}
+	v.Chunks = make([]*apiviews.CanvasChunkView, len(body.Chunks))
+	for i, val := range body.Chunks {
+		v.Chunks[i] = unmarshalCanvasChunkResponseBodyToApiviewsCanvasChunkView(val)
+	}
+
+	return v
+}
+
+// NewCanvasChunksGetUnauthenticated builds a api service CanvasChunksGet
+// endpoint unauthenticated error.
+func NewCanvasChunksGetUnauthenticated(body *CanvasChunksGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasChunksGetAccessDenied builds a api service CanvasChunksGet endpoint
+// access_denied error.
+func NewCanvasChunksGetAccessDenied(body *CanvasChunksGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasChunksGetNotFound builds a api service CanvasChunksGet endpoint
+// not_found error.
+func NewCanvasChunksGetNotFound(body *CanvasChunksGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRegionGetCanvasRegionOK builds a "api" service "CanvasRegionGet"
+// endpoint result from a HTTP "OK" response.
+func NewCanvasRegionGetCanvasRegionOK(body []byte, x int32, y int32, width int32, height int32) *apiviews.CanvasRegionView {
+	v := body
+	res := &apiviews.CanvasRegionView{
+		Pixels: v,
+	}
+	res.X = &x
+	res.Y = &y
+	res.Width = &width
+	res.Height = &height
+
+	return res
+}
+
+// NewCanvasRegionGetUnauthenticated builds a api service CanvasRegionGet
+// endpoint unauthenticated error.
+func NewCanvasRegionGetUnauthenticated(body *CanvasRegionGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRegionGetAccessDenied builds a api service CanvasRegionGet endpoint
+// access_denied error.
+func NewCanvasRegionGetAccessDenied(body *CanvasRegionGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRegionGetNotFound builds a api service CanvasRegionGet endpoint
+// not_found error.
+func NewCanvasRegionGetNotFound(body *CanvasRegionGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasImageGetUnauthenticated builds a api service CanvasImageGet
+// endpoint unauthenticated error.
+func NewCanvasImageGetUnauthenticated(body *CanvasImageGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasImageGetAccessDenied builds a api service CanvasImageGet endpoint
+// access_denied error.
+func NewCanvasImageGetAccessDenied(body *CanvasImageGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasImageGetNotFound builds a api service CanvasImageGet endpoint
+// not_found error.
+func NewCanvasImageGetNotFound(body *CanvasImageGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRegionImageGetUnauthenticated builds a api service
+// CanvasRegionImageGet endpoint unauthenticated error.
+func NewCanvasRegionImageGetUnauthenticated(body *CanvasRegionImageGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRegionImageGetAccessDenied builds a api service
+// CanvasRegionImageGet endpoint access_denied error.
+func NewCanvasRegionImageGetAccessDenied(body *CanvasRegionImageGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasRegionImageGetNotFound builds a api service CanvasRegionImageGet
+// endpoint not_found error.
+func NewCanvasRegionImageGetNotFound(body *CanvasRegionImageGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasTileGetCanvasTileNotModified builds a "api" service "CanvasTileGet"
+// endpoint result from a HTTP "NotModified" response.
+func NewCanvasTileGetCanvasTileNotModified(etag string, cacheControl string) *api.CanvasTile {
+	v := &api.CanvasTile{}
+	v.Etag = etag
+	v.CacheControl = cacheControl
+
+	return v
+}
+
+// NewCanvasTileGetCanvasTileOK builds a "api" service "CanvasTileGet" endpoint
+// result from a HTTP "OK" response.
+func NewCanvasTileGetCanvasTileOK(body []byte, etag string, cacheControl string) *api.CanvasTile {
+	v := body
+	res := &api.CanvasTile{
+		Image: v,
+	}
+	res.Etag = etag
+	res.CacheControl = cacheControl
+
+	return res
+}
+
+// NewCanvasTileGetUnauthenticated builds a api service CanvasTileGet endpoint
+// unauthenticated error.
+func NewCanvasTileGetUnauthenticated(body *CanvasTileGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasTileGetAccessDenied builds a api service CanvasTileGet endpoint
+// access_denied error.
+func NewCanvasTileGetAccessDenied(body *CanvasTileGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasTileGetNotFound builds a api service CanvasTileGet endpoint
+// not_found error.
+func NewCanvasTileGetNotFound(body *CanvasTileGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasSubscribeCanvasEventOK builds a "api" service "CanvasSubscribe"
+// endpoint result from a HTTP "OK" response.
+func NewCanvasSubscribeCanvasEventOK(body *CanvasSubscribeResponseBody) *api.CanvasEvent {
+	v := &api.CanvasEvent{
+		ID:   *body.ID,
+		Type: *body.Type,
+	}
+	if body.Pixel != nil {
+		v.Pixel = unmarshalPixelEventResponseBodyToAPIPixelEvent(body.Pixel)
+	}
+	if body.Snapshot != nil {
+		v.Snapshot = unmarshalCanvasSnapshotResponseBodyToAPICanvasSnapshot(body.Snapshot)
+	}
+
+	return v
+}
+
+// NewCanvasSubscribeUnauthenticated builds a api service CanvasSubscribe
+// endpoint unauthenticated error.
+func NewCanvasSubscribeUnauthenticated(body *CanvasSubscribeUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasSubscribeAccessDenied builds a api service CanvasSubscribe endpoint
+// access_denied error.
+func NewCanvasSubscribeAccessDenied(body *CanvasSubscribeAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasSubscribeNotFound builds a api service CanvasSubscribe endpoint
+// not_found error.
+func NewCanvasSubscribeNotFound(body *CanvasSubscribeNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasSessionSessionEventOK builds a "api" service "CanvasSession"
+// endpoint result from a HTTP "OK" response.
+func NewCanvasSessionSessionEventOK(body *CanvasSessionResponseBody) *api.SessionEvent {
+	v := &api.SessionEvent{}
+	if body.Canvas != nil {
+		v.Canvas = unmarshalCanvasResponseBodyToAPICanvas(body.Canvas)
+	}
+	if body.Pixel != nil {
+		v.Pixel = unmarshalPixelEventResponseBodyToAPIPixelEvent(body.Pixel)
+	}
+	if body.Rejection != nil {
+		v.Rejection = unmarshalPlacementRejectionResponseBodyToAPIPlacementRejection(body.Rejection)
+	}
+
+	return v
+}
+
+// NewCanvasSessionUnauthenticated builds a api service CanvasSession endpoint
+// unauthenticated error.
+func NewCanvasSessionUnauthenticated(body *CanvasSessionUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasSessionAccessDenied builds a api service CanvasSession endpoint
+// access_denied error.
+func NewCanvasSessionAccessDenied(body *CanvasSessionAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCanvasSessionNotFound builds a api service CanvasSession endpoint
+// not_found error.
+func NewCanvasSessionNotFound(body *CanvasSessionNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPixelPlacePixelCreated builds a "api" service "PixelPlace" endpoint
+// result from a HTTP "Created" response.
+func NewPixelPlacePixelCreated(body *PixelPlaceResponseBody) *apiviews.PixelView {
+	v := &apiviews.PixelView{
+		X:     body.X,
+		Y:     body.Y,
+		Color: body.Color,
+	}
+
+	return v
+}
+
+// NewPixelPlaceCooldownActive builds a api service PixelPlace endpoint
+// cooldown_active error.
+func NewPixelPlaceCooldownActive(body *PixelPlaceCooldownActiveResponseBody, retryAfter int32) *api.CooldownError {
+	v := &api.CooldownError{
+		Message: *body.Message,
+	}
+	v.RetryAfter = retryAfter
+
+	return v
+}
+
+// NewPixelPlaceCanvasArchived builds a api service PixelPlace endpoint
+// canvas_archived error.
+func NewPixelPlaceCanvasArchived(body *PixelPlaceCanvasArchivedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPixelPlaceUnauthenticated builds a api service PixelPlace endpoint
+// unauthenticated error.
+func NewPixelPlaceUnauthenticated(body *PixelPlaceUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPixelPlaceAccessDenied builds a api service PixelPlace endpoint
+// access_denied error.
+func NewPixelPlaceAccessDenied(body *PixelPlaceAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPixelPlaceNotFound builds a api service PixelPlace endpoint not_found
+// error.
+func NewPixelPlaceNotFound(body *PixelPlaceNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPixelInfoGetPixelInfoOK builds a "api" service "PixelInfoGet" endpoint
+// result from a HTTP "OK" response.
+func NewPixelInfoGetPixelInfoOK(body *PixelInfoGetResponseBody) *apiviews.PixelInfoView {
+	v := &apiviews.PixelInfoView{
+		X: body.X,
+		Y: body.Y,
+	}
+	v.Placements = make([]*apiviews.PixelHistoryEntryView, len(body.Placements))
+	for i, val := range body.Placements {
+		v.Placements[i] = unmarshalPixelHistoryEntryResponseBodyToApiviewsPixelHistoryEntryView(val)
+	}
+
+	return v
+}
+
+// NewPixelInfoGetUnauthenticated builds a api service PixelInfoGet endpoint
+// unauthenticated error.
+func NewPixelInfoGetUnauthenticated(body *PixelInfoGetUnauthenticatedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPixelInfoGetAccessDenied builds a api service PixelInfoGet endpoint
+// access_denied error.
+func NewPixelInfoGetAccessDenied(body *PixelInfoGetAccessDeniedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPixelInfoGetNotFound builds a api service PixelInfoGet endpoint not_found
+// error.
+func NewPixelInfoGetNotFound(body *PixelInfoGetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateCanvasSubscribeResponseBody runs the validations defined on
+// CanvasSubscribeResponseBody
+func ValidateCanvasSubscribeResponseBody(body *CanvasSubscribeResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "pixel" || *body.Type == "snapshot") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"pixel", "snapshot"}))
+		}
+	}
+	if body.Pixel != nil {
+		if err2 := ValidatePixelEventResponseBody(body.Pixel); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Snapshot != nil {
+		if err2 := ValidateCanvasSnapshotResponseBody(body.Snapshot); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCanvasSessionResponseBody runs the validations defined on
+// CanvasSessionResponseBody
+func ValidateCanvasSessionResponseBody(body *CanvasSessionResponseBody) (err error) {
+	if body.Canvas != nil {
+		if err2 := ValidateCanvasResponseBody(body.Canvas); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Pixel != nil {
+		if err2 := ValidatePixelEventResponseBody(body.Pixel); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Rejection != nil {
+		if err2 := ValidatePlacementRejectionResponseBody(body.Rejection); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCanvasCreateUnauthenticatedResponseBody runs the validations defined
+// on CanvasCreate_unauthenticated_Response_Body
+func ValidateCanvasCreateUnauthenticatedResponseBody(body *CanvasCreateUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasCreateAccessDeniedResponseBody runs the validations defined on
+// CanvasCreate_access_denied_Response_Body
+func ValidateCanvasCreateAccessDeniedResponseBody(body *CanvasCreateAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasCreateNotFoundResponseBody runs the validations defined on
+// CanvasCreate_not_found_Response_Body
+func ValidateCanvasCreateNotFoundResponseBody(body *CanvasCreateNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasListUnauthenticatedResponseBody runs the validations defined
+// on CanvasList_unauthenticated_Response_Body
+func ValidateCanvasListUnauthenticatedResponseBody(body *CanvasListUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasListAccessDeniedResponseBody runs the validations defined on
+// CanvasList_access_denied_Response_Body
+func ValidateCanvasListAccessDeniedResponseBody(body *CanvasListAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasListNotFoundResponseBody runs the validations defined on
+// CanvasList_not_found_Response_Body
+func ValidateCanvasListNotFoundResponseBody(body *CanvasListNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasGetUnauthenticatedResponseBody runs the validations defined on
+// CanvasGet_unauthenticated_Response_Body
+func ValidateCanvasGetUnauthenticatedResponseBody(body *CanvasGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasGetAccessDeniedResponseBody runs the validations defined on
+// CanvasGet_access_denied_Response_Body
+func ValidateCanvasGetAccessDeniedResponseBody(body *CanvasGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasGetNotFoundResponseBody runs the validations defined on
+// CanvasGet_not_found_Response_Body
+func ValidateCanvasGetNotFoundResponseBody(body *CanvasGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasArchiveUnauthenticatedResponseBody runs the validations
+// defined on CanvasArchive_unauthenticated_Response_Body
+func ValidateCanvasArchiveUnauthenticatedResponseBody(body *CanvasArchiveUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasArchiveAccessDeniedResponseBody runs the validations defined
+// on CanvasArchive_access_denied_Response_Body
+func ValidateCanvasArchiveAccessDeniedResponseBody(body *CanvasArchiveAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasArchiveNotFoundResponseBody runs the validations defined on
+// CanvasArchive_not_found_Response_Body
+func ValidateCanvasArchiveNotFoundResponseBody(body *CanvasArchiveNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasResizeCanvasArchivedResponseBody runs the validations defined
+// on CanvasResize_canvas_archived_Response_Body
+func ValidateCanvasResizeCanvasArchivedResponseBody(body *CanvasResizeCanvasArchivedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasResizeUnauthenticatedResponseBody runs the validations defined
+// on CanvasResize_unauthenticated_Response_Body
+func ValidateCanvasResizeUnauthenticatedResponseBody(body *CanvasResizeUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasResizeAccessDeniedResponseBody runs the validations defined on
+// CanvasResize_access_denied_Response_Body
+func ValidateCanvasResizeAccessDeniedResponseBody(body *CanvasResizeAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasResizeNotFoundResponseBody runs the validations defined on
+// CanvasResize_not_found_Response_Body
+func ValidateCanvasResizeNotFoundResponseBody(body *CanvasResizeNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateProtectedRegionCreateUnauthenticatedResponseBody runs the
+// validations defined on ProtectedRegionCreate_unauthenticated_Response_Body
+func ValidateProtectedRegionCreateUnauthenticatedResponseBody(body *ProtectedRegionCreateUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateProtectedRegionCreateAccessDeniedResponseBody runs the validations
+// defined on ProtectedRegionCreate_access_denied_Response_Body
+func ValidateProtectedRegionCreateAccessDeniedResponseBody(body *ProtectedRegionCreateAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateProtectedRegionCreateNotFoundResponseBody runs the validations
+// defined on ProtectedRegionCreate_not_found_Response_Body
+func ValidateProtectedRegionCreateNotFoundResponseBody(body *ProtectedRegionCreateNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateProtectedRegionDeleteUnauthenticatedResponseBody runs the
+// validations defined on ProtectedRegionDelete_unauthenticated_Response_Body
+func ValidateProtectedRegionDeleteUnauthenticatedResponseBody(body *ProtectedRegionDeleteUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateProtectedRegionDeleteAccessDeniedResponseBody runs the validations
+// defined on ProtectedRegionDelete_access_denied_Response_Body
+func ValidateProtectedRegionDeleteAccessDeniedResponseBody(body *ProtectedRegionDeleteAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateProtectedRegionDeleteNotFoundResponseBody runs the validations
+// defined on ProtectedRegionDelete_not_found_Response_Body
+func ValidateProtectedRegionDeleteNotFoundResponseBody(body *ProtectedRegionDeleteNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRollbackCanvasArchivedResponseBody runs the validations
+// defined on CanvasRollback_canvas_archived_Response_Body
+func ValidateCanvasRollbackCanvasArchivedResponseBody(body *CanvasRollbackCanvasArchivedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRollbackUnauthenticatedResponseBody runs the validations
+// defined on CanvasRollback_unauthenticated_Response_Body
+func ValidateCanvasRollbackUnauthenticatedResponseBody(body *CanvasRollbackUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRollbackAccessDeniedResponseBody runs the validations defined
+// on CanvasRollback_access_denied_Response_Body
+func ValidateCanvasRollbackAccessDeniedResponseBody(body *CanvasRollbackAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRollbackNotFoundResponseBody runs the validations defined on
+// CanvasRollback_not_found_Response_Body
+func ValidateCanvasRollbackNotFoundResponseBody(body *CanvasRollbackNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAuditLogGetUnauthenticatedResponseBody runs the validations defined
+// on AuditLogGet_unauthenticated_Response_Body
+func ValidateAuditLogGetUnauthenticatedResponseBody(body *AuditLogGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAuditLogGetAccessDeniedResponseBody runs the validations defined on
+// AuditLogGet_access_denied_Response_Body
+func ValidateAuditLogGetAccessDeniedResponseBody(body *AuditLogGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAuditLogGetNotFoundResponseBody runs the validations defined on
+// AuditLogGet_not_found_Response_Body
+func ValidateAuditLogGetNotFoundResponseBody(body *AuditLogGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasPixelsGetUnauthenticatedResponseBody runs the validations
+// defined on CanvasPixelsGet_unauthenticated_Response_Body
+func ValidateCanvasPixelsGetUnauthenticatedResponseBody(body *CanvasPixelsGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasPixelsGetAccessDeniedResponseBody runs the validations defined
+// on CanvasPixelsGet_access_denied_Response_Body
+func ValidateCanvasPixelsGetAccessDeniedResponseBody(body *CanvasPixelsGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasPixelsGetNotFoundResponseBody runs the validations defined on
+// CanvasPixelsGet_not_found_Response_Body
+func ValidateCanvasPixelsGetNotFoundResponseBody(body *CanvasPixelsGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasChunksGetUnauthenticatedResponseBody runs the validations
+// defined on CanvasChunksGet_unauthenticated_Response_Body
+func ValidateCanvasChunksGetUnauthenticatedResponseBody(body *CanvasChunksGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasChunksGetAccessDeniedResponseBody runs the validations defined
+// on CanvasChunksGet_access_denied_Response_Body
+func ValidateCanvasChunksGetAccessDeniedResponseBody(body *CanvasChunksGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasChunksGetNotFoundResponseBody runs the validations defined on
+// CanvasChunksGet_not_found_Response_Body
+func ValidateCanvasChunksGetNotFoundResponseBody(body *CanvasChunksGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRegionGetUnauthenticatedResponseBody runs the validations
+// defined on CanvasRegionGet_unauthenticated_Response_Body
+func ValidateCanvasRegionGetUnauthenticatedResponseBody(body *CanvasRegionGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRegionGetAccessDeniedResponseBody runs the validations defined
+// on CanvasRegionGet_access_denied_Response_Body
+func ValidateCanvasRegionGetAccessDeniedResponseBody(body *CanvasRegionGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRegionGetNotFoundResponseBody runs the validations defined on
+// CanvasRegionGet_not_found_Response_Body
+func ValidateCanvasRegionGetNotFoundResponseBody(body *CanvasRegionGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasImageGetUnauthenticatedResponseBody runs the validations
+// defined on CanvasImageGet_unauthenticated_Response_Body
+func ValidateCanvasImageGetUnauthenticatedResponseBody(body *CanvasImageGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasImageGetAccessDeniedResponseBody runs the validations defined
+// on CanvasImageGet_access_denied_Response_Body
+func ValidateCanvasImageGetAccessDeniedResponseBody(body *CanvasImageGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasImageGetNotFoundResponseBody runs the validations defined on
+// CanvasImageGet_not_found_Response_Body
+func ValidateCanvasImageGetNotFoundResponseBody(body *CanvasImageGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRegionImageGetUnauthenticatedResponseBody runs the validations
+// defined on CanvasRegionImageGet_unauthenticated_Response_Body
+func ValidateCanvasRegionImageGetUnauthenticatedResponseBody(body *CanvasRegionImageGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRegionImageGetAccessDeniedResponseBody runs the validations
+// defined on CanvasRegionImageGet_access_denied_Response_Body
+func ValidateCanvasRegionImageGetAccessDeniedResponseBody(body *CanvasRegionImageGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasRegionImageGetNotFoundResponseBody runs the validations
+// defined on CanvasRegionImageGet_not_found_Response_Body
+func ValidateCanvasRegionImageGetNotFoundResponseBody(body *CanvasRegionImageGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasTileGetUnauthenticatedResponseBody runs the validations
+// defined on CanvasTileGet_unauthenticated_Response_Body
+func ValidateCanvasTileGetUnauthenticatedResponseBody(body *CanvasTileGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasTileGetAccessDeniedResponseBody runs the validations defined
+// on CanvasTileGet_access_denied_Response_Body
+func ValidateCanvasTileGetAccessDeniedResponseBody(body *CanvasTileGetAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasTileGetNotFoundResponseBody runs the validations defined on
+// CanvasTileGet_not_found_Response_Body
+func ValidateCanvasTileGetNotFoundResponseBody(body *CanvasTileGetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasSubscribeUnauthenticatedResponseBody runs the validations
+// defined on CanvasSubscribe_unauthenticated_Response_Body
+func ValidateCanvasSubscribeUnauthenticatedResponseBody(body *CanvasSubscribeUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasSubscribeAccessDeniedResponseBody runs the validations defined
+// on CanvasSubscribe_access_denied_Response_Body
+func ValidateCanvasSubscribeAccessDeniedResponseBody(body *CanvasSubscribeAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasSubscribeNotFoundResponseBody runs the validations defined on
+// CanvasSubscribe_not_found_Response_Body
+func ValidateCanvasSubscribeNotFoundResponseBody(body *CanvasSubscribeNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasSessionUnauthenticatedResponseBody runs the validations
+// defined on CanvasSession_unauthenticated_Response_Body
+func ValidateCanvasSessionUnauthenticatedResponseBody(body *CanvasSessionUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasSessionAccessDeniedResponseBody runs the validations defined
+// on CanvasSession_access_denied_Response_Body
+func ValidateCanvasSessionAccessDeniedResponseBody(body *CanvasSessionAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCanvasSessionNotFoundResponseBody runs the validations defined on
+// CanvasSession_not_found_Response_Body
+func ValidateCanvasSessionNotFoundResponseBody(body *CanvasSessionNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCanvasGetCanvasOK builds a "api" service "CanvasGet" endpoint result from
-// a HTTP "OK" response.
-func NewCanvasGetCanvasOK(body *CanvasGetResponseBody) *apiviews.CanvasView {
-	v := &apiviews.CanvasView{
-		ID:     body.ID,
-		Width:  body.Width,
-		Height: body.Height,
+// ValidatePixelPlaceCooldownActiveResponseBody runs the validations defined on
+// PixelPlace_cooldown_active_Response_Body
+func ValidatePixelPlaceCooldownActiveResponseBody(body *PixelPlaceCooldownActiveResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidatePixelPlaceCanvasArchivedResponseBody runs the validations defined on
+// PixelPlace_canvas_archived_Response_Body
+func ValidatePixelPlaceCanvasArchivedResponseBody(body *PixelPlaceCanvasArchivedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCanvasGetUnauthenticated builds a api service CanvasGet endpoint
-// unauthenticated error.
-func NewCanvasGetUnauthenticated(body *CanvasGetUnauthenticatedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidatePixelPlaceUnauthenticatedResponseBody runs the validations defined
+// on PixelPlace_unauthenticated_Response_Body
+func ValidatePixelPlaceUnauthenticatedResponseBody(body *PixelPlaceUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
 
-	return v
+// ValidatePixelPlaceAccessDeniedResponseBody runs the validations defined on
+// PixelPlace_access_denied_Response_Body
+func ValidatePixelPlaceAccessDeniedResponseBody(body *PixelPlaceAccessDeniedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCanvasGetAccessDenied builds a api service CanvasGet endpoint
-// access_denied error.
-func NewCanvasGetAccessDenied(body *CanvasGetAccessDeniedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidatePixelPlaceNotFoundResponseBody runs the validations defined on
+// PixelPlace_not_found_Response_Body
+func ValidatePixelPlaceNotFoundResponseBody(body *PixelPlaceNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidatePixelInfoGetUnauthenticatedResponseBody runs the validations defined
+// on PixelInfoGet_unauthenticated_Response_Body
+func ValidatePixelInfoGetUnauthenticatedResponseBody(body *PixelInfoGetUnauthenticatedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateCanvasGetUnauthenticatedResponseBody runs the validations defined on
-// CanvasGet_unauthenticated_Response_Body
-func ValidateCanvasGetUnauthenticatedResponseBody(body *CanvasGetUnauthenticatedResponseBody) (err error) {
+// ValidatePixelInfoGetAccessDeniedResponseBody runs the validations defined on
+// PixelInfoGet_access_denied_Response_Body
+func ValidatePixelInfoGetAccessDeniedResponseBody(body *PixelInfoGetAccessDeniedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -122,9 +4210,9 @@ func ValidateCanvasGetUnauthenticatedResponseBody(body *CanvasGetUnauthenticated
 	return
 }
 
-// ValidateCanvasGetAccessDeniedResponseBody runs the validations defined on
-// CanvasGet_access_denied_Response_Body
-func ValidateCanvasGetAccessDeniedResponseBody(body *CanvasGetAccessDeniedResponseBody) (err error) {
+// ValidatePixelInfoGetNotFoundResponseBody runs the validations defined on
+// PixelInfoGet_not_found_Response_Body
+func ValidatePixelInfoGetNotFoundResponseBody(body *PixelInfoGetNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -145,3 +4233,224 @@ func ValidateCanvasGetAccessDeniedResponseBody(body *CanvasGetAccessDeniedRespon
 	}
 	return
 }
+
+// ValidateProtectedRegionResponseBody runs the validations defined on
+// ProtectedRegionResponseBody
+func ValidateProtectedRegionResponseBody(body *ProtectedRegionResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "body"))
+	}
+	if body.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "body"))
+	}
+	if body.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "body"))
+	}
+	if body.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCanvasResponseBody runs the validations defined on CanvasResponseBody
+func ValidateCanvasResponseBody(body *CanvasResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "body"))
+	}
+	if body.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "body"))
+	}
+	if body.Palette == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("palette", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.ProtectedRegions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protected_regions", "body"))
+	}
+	for _, e := range body.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.palette[*]", e, "^#[0-9A-F]{6}$"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ArchivedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.archived_at", *body.ArchivedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.ProtectedRegions {
+		if e != nil {
+			if err2 := ValidateProtectedRegionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAuditEntryResponseBody runs the validations defined on
+// AuditEntryResponseBody
+func ValidateAuditEntryResponseBody(body *AuditEntryResponseBody) (err error) {
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Details == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("details", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Action != nil {
+		if !(*body.Action == "rollback") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"rollback"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCanvasChunkResponseBody runs the validations defined on
+// CanvasChunkResponseBody
+func ValidateCanvasChunkResponseBody(body *CanvasChunkResponseBody) (err error) {
+	if body.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "body"))
+	}
+	if body.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "body"))
+	}
+	if body.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "body"))
+	}
+	if body.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Pixels == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pixels", "body"))
+	}
+	return
+}
+
+// ValidatePixelEventResponseBody runs the validations defined on
+// PixelEventResponseBody
+func ValidatePixelEventResponseBody(body *PixelEventResponseBody) (err error) {
+	if body.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "body"))
+	}
+	if body.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "body"))
+	}
+	if body.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.PlacedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_at", "body"))
+	}
+	if body.Seq == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("seq", "body"))
+	}
+	if body.PlacedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.placed_at", *body.PlacedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCanvasSnapshotResponseBody runs the validations defined on
+// CanvasSnapshotResponseBody
+func ValidateCanvasSnapshotResponseBody(body *CanvasSnapshotResponseBody) (err error) {
+	if body.Seq == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("seq", "body"))
+	}
+	if body.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "body"))
+	}
+	if body.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "body"))
+	}
+	if body.Pixels == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pixels", "body"))
+	}
+	return
+}
+
+// ValidatePixelPlacementStreamingBody runs the validations defined on
+// PixelPlacementStreamingBody
+func ValidatePixelPlacementStreamingBody(body *PixelPlacementStreamingBody) (err error) {
+	if body.X < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", body.X, 0, true))
+	}
+	if body.Y < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", body.Y, 0, true))
+	}
+	if body.Color < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", body.Color, 0, true))
+	}
+	if body.Color > 255 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", body.Color, 255, false))
+	}
+	return
+}
+
+// ValidatePlacementRejectionResponseBody runs the validations defined on
+// PlacementRejectionResponseBody
+func ValidatePlacementRejectionResponseBody(body *PlacementRejectionResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePixelHistoryEntryResponseBody runs the validations defined on
+// PixelHistoryEntryResponseBody
+func ValidatePixelHistoryEntryResponseBody(body *PixelHistoryEntryResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "body"))
+	}
+	if body.PlacedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("placed_at", "body"))
+	}
+	if body.Seq == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("seq", "body"))
+	}
+	if body.PlacedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.placed_at", *body.PlacedAt, goa.FormatDateTime))
+	}
+	return
+}
@@ -0,0 +1,90 @@
+// Code generated by goa v3.22.6, DO NOT EDIT.
+//
+// api WebSocket client streaming
+//
+// Command:
+// $ goa gen github.com/jace-ys/pikcel/api/v1 -o api/v1
+
+package client
+
+import (
+	"context"
+	"io"
+
+	"github.com/gorilla/websocket"
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// ConnConfigurer holds the websocket connection configurer functions for the
+// streaming endpoints in "api" service.
+type ConnConfigurer struct {
+	CanvasSessionFn goahttp.ConnConfigureFunc
+}
+
+// CanvasSessionClientStream implements the api.CanvasSessionClientStream
+// interface.
+type CanvasSessionClientStream struct {
+	// conn is the underlying websocket connection.
+	conn *websocket.Conn
+}
+
+// NewConnConfigurer initializes the websocket connection configurer function
+// with fn for all the streaming endpoints in "api" service.
+func NewConnConfigurer(fn goahttp.ConnConfigureFunc) *ConnConfigurer {
+	return &ConnConfigurer{
+		CanvasSessionFn: fn,
+	}
+}
+
+// Recv reads instances of "api.SessionEvent" from the "CanvasSession" endpoint
+// websocket connection.
+func (s *CanvasSessionClientStream) Recv() (*api.SessionEvent, error) {
+	var (
+		rv   *api.SessionEvent
+		body CanvasSessionResponseBody
+		err  error
+	)
+	err = s.conn.ReadJSON(&body)
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		return rv, io.EOF
+	}
+	if err != nil {
+		return rv, err
+	}
+	err = ValidateCanvasSessionResponseBody(&body)
+	if err != nil {
+		return rv, err
+	}
+	res := NewCanvasSessionSessionEventOK(&body)
+	return res, nil
+}
+
+// RecvWithContext reads instances of "api.SessionEvent" from the
+// "CanvasSession" endpoint websocket connection with context.
+func (s *CanvasSessionClientStream) RecvWithContext(ctx context.Context) (*api.SessionEvent, error) {
+	return s.Recv()
+}
+
+// Send streams instances of "api.PixelPlacement" to the "CanvasSession"
+// endpoint websocket connection.
+func (s *CanvasSessionClientStream) Send(v *api.PixelPlacement) error {
+	body := NewCanvasSessionStreamingBody(v)
+	return s.conn.WriteJSON(body)
+}
+
+// SendWithContext streams instances of "api.PixelPlacement" to the
+// "CanvasSession" endpoint websocket connection with context.
+func (s *CanvasSessionClientStream) SendWithContext(ctx context.Context, v *api.PixelPlacement) error {
+	return s.Send(v)
+}
+
+// Close closes the "CanvasSession" endpoint websocket connection.
+func (s *CanvasSessionClientStream) Close() error {
+	var err error
+	// Send a nil payload to the server implying client closing connection.
+	if err = s.conn.WriteJSON(nil); err != nil {
+		return err
+	}
+	return s.conn.Close()
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api HTTP server encoders and decoders
 //
@@ -10,13 +10,223 @@ package server
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
 
+// EncodeCanvasCreateResponse returns an encoder for responses returned by the
+// api CanvasCreate endpoint.
+func EncodeCanvasCreateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.Canvas)
+		enc := encoder(ctx, w)
+		body := NewCanvasCreateResponseBody(res.Projected)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasCreateRequest returns a decoder for requests sent to the api
+// CanvasCreate endpoint.
+func DecodeCanvasCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasCreatePayload, error) {
+	return func(r *http.Request) (*api.CanvasCreatePayload, error) {
+		var (
+			body CanvasCreateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCanvasCreateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasCreatePayload(&body, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasCreateError returns an encoder for errors returned by the
+// CanvasCreate api endpoint.
+func EncodeCanvasCreateError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasCreateUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasCreateAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasCreateNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasListResponse returns an encoder for responses returned by the
+// api CanvasList endpoint.
+func EncodeCanvasListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.Canvases)
+		enc := encoder(ctx, w)
+		body := NewCanvasListResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasListRequest returns a decoder for requests sent to the api
+// CanvasList endpoint.
+func DecodeCanvasListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasListPayload, error) {
+	return func(r *http.Request) (*api.CanvasListPayload, error) {
+		var (
+			includeArchived bool
+			err             error
+		)
+		{
+			includeArchivedRaw := r.URL.Query().Get("include_archived")
+			if includeArchivedRaw != "" {
+				v, err2 := strconv.ParseBool(includeArchivedRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_archived", includeArchivedRaw, "boolean"))
+				}
+				includeArchived = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasListPayload(includeArchived)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasListError returns an encoder for errors returned by the
+// CanvasList api endpoint.
+func EncodeCanvasListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasListUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasListAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasListNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeCanvasGetResponse returns an encoder for responses returned by the api
 // CanvasGet endpoint.
 func EncodeCanvasGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -29,6 +239,22 @@ func EncodeCanvasGetResponse(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
+// DecodeCanvasGetRequest returns a decoder for requests sent to the api
+// CanvasGet endpoint.
+func DecodeCanvasGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasGetPayload, error) {
+	return func(r *http.Request) (*api.CanvasGetPayload, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewCanvasGetPayload(id)
+
+		return payload, nil
+	}
+}
+
 // EncodeCanvasGetError returns an encoder for errors returned by the CanvasGet
 // api endpoint.
 func EncodeCanvasGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
@@ -65,8 +291,2151 @@ func EncodeCanvasGetError(encoder func(context.Context, http.ResponseWriter) goa
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusForbidden)
 			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasArchiveResponse returns an encoder for responses returned by the
+// api CanvasArchive endpoint.
+func EncodeCanvasArchiveResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.Canvas)
+		enc := encoder(ctx, w)
+		body := NewCanvasArchiveResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasArchiveRequest returns a decoder for requests sent to the api
+// CanvasArchive endpoint.
+func DecodeCanvasArchiveRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasArchivePayload, error) {
+	return func(r *http.Request) (*api.CanvasArchivePayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasArchivePayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasArchiveError returns an encoder for errors returned by the
+// CanvasArchive api endpoint.
+func EncodeCanvasArchiveError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasArchiveUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasArchiveAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasArchiveNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
+
+// EncodeCanvasResizeResponse returns an encoder for responses returned by the
+// api CanvasResize endpoint.
+func EncodeCanvasResizeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.Canvas)
+		enc := encoder(ctx, w)
+		body := NewCanvasResizeResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasResizeRequest returns a decoder for requests sent to the api
+// CanvasResize endpoint.
+func DecodeCanvasResizeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasResizePayload, error) {
+	return func(r *http.Request) (*api.CanvasResizePayload, error) {
+		var (
+			body CanvasResizeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCanvasResizeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasResizePayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasResizeError returns an encoder for errors returned by the
+// CanvasResize api endpoint.
+func EncodeCanvasResizeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "canvas_archived":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasResizeCanvasArchivedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasResizeUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasResizeAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasResizeNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeProtectedRegionCreateResponse returns an encoder for responses
+// returned by the api ProtectedRegionCreate endpoint.
+func EncodeProtectedRegionCreateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.ProtectedRegion)
+		enc := encoder(ctx, w)
+		body := NewProtectedRegionCreateResponseBody(res.Projected)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeProtectedRegionCreateRequest returns a decoder for requests sent to
+// the api ProtectedRegionCreate endpoint.
+func DecodeProtectedRegionCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.ProtectedRegionCreatePayload, error) {
+	return func(r *http.Request) (*api.ProtectedRegionCreatePayload, error) {
+		var (
+			body ProtectedRegionCreateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateProtectedRegionCreateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewProtectedRegionCreatePayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeProtectedRegionCreateError returns an encoder for errors returned by
+// the ProtectedRegionCreate api endpoint.
+func EncodeProtectedRegionCreateError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewProtectedRegionCreateUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewProtectedRegionCreateAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewProtectedRegionCreateNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeProtectedRegionDeleteResponse returns an encoder for responses
+// returned by the api ProtectedRegionDelete endpoint.
+func EncodeProtectedRegionDeleteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeProtectedRegionDeleteRequest returns a decoder for requests sent to
+// the api ProtectedRegionDelete endpoint.
+func DecodeProtectedRegionDeleteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.ProtectedRegionDeletePayload, error) {
+	return func(r *http.Request) (*api.ProtectedRegionDeletePayload, error) {
+		var (
+			id       string
+			regionID string
+			token    string
+			err      error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		regionID = params["region_id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewProtectedRegionDeletePayload(id, regionID, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeProtectedRegionDeleteError returns an encoder for errors returned by
+// the ProtectedRegionDelete api endpoint.
+func EncodeProtectedRegionDeleteError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewProtectedRegionDeleteUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewProtectedRegionDeleteAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewProtectedRegionDeleteNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasRollbackResponse returns an encoder for responses returned by
+// the api CanvasRollback endpoint.
+func EncodeCanvasRollbackResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.Rollback)
+		enc := encoder(ctx, w)
+		body := NewCanvasRollbackResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasRollbackRequest returns a decoder for requests sent to the api
+// CanvasRollback endpoint.
+func DecodeCanvasRollbackRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasRollbackPayload, error) {
+	return func(r *http.Request) (*api.CanvasRollbackPayload, error) {
+		var (
+			body CanvasRollbackRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCanvasRollbackRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasRollbackPayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasRollbackError returns an encoder for errors returned by the
+// CanvasRollback api endpoint.
+func EncodeCanvasRollbackError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "canvas_archived":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRollbackCanvasArchivedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRollbackUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRollbackAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRollbackNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAuditLogGetResponse returns an encoder for responses returned by the
+// api AuditLogGet endpoint.
+func EncodeAuditLogGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.AuditLog)
+		enc := encoder(ctx, w)
+		body := NewAuditLogGetResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAuditLogGetRequest returns a decoder for requests sent to the api
+// AuditLogGet endpoint.
+func DecodeAuditLogGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.AuditLogGetPayload, error) {
+	return func(r *http.Request) (*api.AuditLogGetPayload, error) {
+		var (
+			id    string
+			limit int32
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int32(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAuditLogGetPayload(id, limit, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeAuditLogGetError returns an encoder for errors returned by the
+// AuditLogGet api endpoint.
+func EncodeAuditLogGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAuditLogGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAuditLogGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAuditLogGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasPixelsGetResponse returns an encoder for responses returned by
+// the api CanvasPixelsGet endpoint.
+func EncodeCanvasPixelsGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.CanvasPixels)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/octet-stream")
+		enc := encoder(ctx, w)
+		body := res.Projected.Pixels
+		if res.Projected.Width != nil {
+			val := res.Projected.Width
+			widths := strconv.FormatInt(int64(*val), 10)
+			w.Header().Set("X-Canvas-Width", widths)
+		}
+		if res.Projected.Height != nil {
+			val := res.Projected.Height
+			heights := strconv.FormatInt(int64(*val), 10)
+			w.Header().Set("X-Canvas-Height", heights)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasPixelsGetRequest returns a decoder for requests sent to the api
+// CanvasPixelsGet endpoint.
+func DecodeCanvasPixelsGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasPixelsGetPayload, error) {
+	return func(r *http.Request) (*api.CanvasPixelsGetPayload, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewCanvasPixelsGetPayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasPixelsGetError returns an encoder for errors returned by the
+// CanvasPixelsGet api endpoint.
+func EncodeCanvasPixelsGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasPixelsGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasPixelsGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasPixelsGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasChunksGetResponse returns an encoder for responses returned by
+// the api CanvasChunksGet endpoint.
+func EncodeCanvasChunksGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.CanvasChunks)
+		enc := encoder(ctx, w)
+		body := NewCanvasChunksGetResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasChunksGetRequest returns a decoder for requests sent to the api
+// CanvasChunksGet endpoint.
+func DecodeCanvasChunksGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasChunksGetPayload, error) {
+	return func(r *http.Request) (*api.CanvasChunksGetPayload, error) {
+		var (
+			id    string
+			since int64
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			sinceRaw := r.URL.Query().Get("since")
+			if sinceRaw != "" {
+				v, err2 := strconv.ParseInt(sinceRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("since", sinceRaw, "integer"))
+				}
+				since = v
+			}
+		}
+		if since < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("since", since, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasChunksGetPayload(id, since)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasChunksGetError returns an encoder for errors returned by the
+// CanvasChunksGet api endpoint.
+func EncodeCanvasChunksGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasChunksGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasChunksGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasChunksGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasRegionGetResponse returns an encoder for responses returned by
+// the api CanvasRegionGet endpoint.
+func EncodeCanvasRegionGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.CanvasRegion)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/octet-stream")
+		enc := encoder(ctx, w)
+		body := res.Projected.Pixels
+		if res.Projected.X != nil {
+			val := res.Projected.X
+			xs := strconv.FormatInt(int64(*val), 10)
+			w.Header().Set("X-Region-X", xs)
+		}
+		if res.Projected.Y != nil {
+			val := res.Projected.Y
+			ys := strconv.FormatInt(int64(*val), 10)
+			w.Header().Set("X-Region-Y", ys)
+		}
+		if res.Projected.Width != nil {
+			val := res.Projected.Width
+			widths := strconv.FormatInt(int64(*val), 10)
+			w.Header().Set("X-Region-Width", widths)
+		}
+		if res.Projected.Height != nil {
+			val := res.Projected.Height
+			heights := strconv.FormatInt(int64(*val), 10)
+			w.Header().Set("X-Region-Height", heights)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasRegionGetRequest returns a decoder for requests sent to the api
+// CanvasRegionGet endpoint.
+func DecodeCanvasRegionGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasRegionGetPayload, error) {
+	return func(r *http.Request) (*api.CanvasRegionGetPayload, error) {
+		var (
+			id     string
+			x      int32
+			y      int32
+			width  int32
+			height int32
+			err    error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		qp := r.URL.Query()
+		{
+			xRaw := qp.Get("x")
+			if xRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("x", "query string"))
+			}
+			v, err2 := strconv.ParseInt(xRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x", xRaw, "integer"))
+			}
+			x = int32(v)
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		{
+			yRaw := qp.Get("y")
+			if yRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("y", "query string"))
+			}
+			v, err2 := strconv.ParseInt(yRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("y", yRaw, "integer"))
+			}
+			y = int32(v)
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		{
+			widthRaw := qp.Get("width")
+			if widthRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("width", "query string"))
+			}
+			v, err2 := strconv.ParseInt(widthRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("width", widthRaw, "integer"))
+			}
+			width = int32(v)
+		}
+		if width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 1, true))
+		}
+		if width > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 256, false))
+		}
+		{
+			heightRaw := qp.Get("height")
+			if heightRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("height", "query string"))
+			}
+			v, err2 := strconv.ParseInt(heightRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("height", heightRaw, "integer"))
+			}
+			height = int32(v)
+		}
+		if height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 1, true))
+		}
+		if height > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasRegionGetPayload(id, x, y, width, height)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasRegionGetError returns an encoder for errors returned by the
+// CanvasRegionGet api endpoint.
+func EncodeCanvasRegionGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRegionGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRegionGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRegionGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasImageGetResponse returns an encoder for responses returned by
+// the api CanvasImageGet endpoint.
+func EncodeCanvasImageGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]byte)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "image/png")
+		enc := encoder(ctx, w)
+		body := res
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasImageGetRequest returns a decoder for requests sent to the api
+// CanvasImageGet endpoint.
+func DecodeCanvasImageGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasImageGetPayload, error) {
+	return func(r *http.Request) (*api.CanvasImageGetPayload, error) {
+		var (
+			id    string
+			scale int32
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			scaleRaw := r.URL.Query().Get("scale")
+			if scaleRaw == "" {
+				scale = 1
+			} else {
+				v, err2 := strconv.ParseInt(scaleRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("scale", scaleRaw, "integer"))
+				}
+				scale = int32(v)
+			}
+		}
+		if scale < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 1, true))
+		}
+		if scale > 16 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 16, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasImageGetPayload(id, scale)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasImageGetError returns an encoder for errors returned by the
+// CanvasImageGet api endpoint.
+func EncodeCanvasImageGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasImageGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasImageGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasImageGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasRegionImageGetResponse returns an encoder for responses returned
+// by the api CanvasRegionImageGet endpoint.
+func EncodeCanvasRegionImageGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]byte)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "image/png")
+		enc := encoder(ctx, w)
+		body := res
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasRegionImageGetRequest returns a decoder for requests sent to the
+// api CanvasRegionImageGet endpoint.
+func DecodeCanvasRegionImageGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasRegionImageGetPayload, error) {
+	return func(r *http.Request) (*api.CanvasRegionImageGetPayload, error) {
+		var (
+			id     string
+			x      int32
+			y      int32
+			width  int32
+			height int32
+			scale  int32
+			err    error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		qp := r.URL.Query()
+		{
+			xRaw := qp.Get("x")
+			if xRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("x", "query string"))
+			}
+			v, err2 := strconv.ParseInt(xRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x", xRaw, "integer"))
+			}
+			x = int32(v)
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		{
+			yRaw := qp.Get("y")
+			if yRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("y", "query string"))
+			}
+			v, err2 := strconv.ParseInt(yRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("y", yRaw, "integer"))
+			}
+			y = int32(v)
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		{
+			widthRaw := qp.Get("width")
+			if widthRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("width", "query string"))
+			}
+			v, err2 := strconv.ParseInt(widthRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("width", widthRaw, "integer"))
+			}
+			width = int32(v)
+		}
+		if width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 1, true))
+		}
+		if width > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("width", width, 256, false))
+		}
+		{
+			heightRaw := qp.Get("height")
+			if heightRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("height", "query string"))
+			}
+			v, err2 := strconv.ParseInt(heightRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("height", heightRaw, "integer"))
+			}
+			height = int32(v)
+		}
+		if height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 1, true))
+		}
+		if height > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("height", height, 256, false))
+		}
+		{
+			scaleRaw := qp.Get("scale")
+			if scaleRaw == "" {
+				scale = 1
+			} else {
+				v, err2 := strconv.ParseInt(scaleRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("scale", scaleRaw, "integer"))
+				}
+				scale = int32(v)
+			}
+		}
+		if scale < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 1, true))
+		}
+		if scale > 16 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("scale", scale, 16, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasRegionImageGetPayload(id, x, y, width, height, scale)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasRegionImageGetError returns an encoder for errors returned by
+// the CanvasRegionImageGet api endpoint.
+func EncodeCanvasRegionImageGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRegionImageGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRegionImageGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasRegionImageGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasTileGetResponse returns an encoder for responses returned by the
+// api CanvasTileGet endpoint.
+func EncodeCanvasTileGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*api.CanvasTile)
+		if res.Status == "not_modified" {
+			w.Header().Set("Etag", res.Etag)
+			w.Header().Set("Cache-Control", res.CacheControl)
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "image/png")
+		enc := encoder(ctx, w)
+		body := res.Image
+		w.Header().Set("Etag", res.Etag)
+		w.Header().Set("Cache-Control", res.CacheControl)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasTileGetRequest returns a decoder for requests sent to the api
+// CanvasTileGet endpoint.
+func DecodeCanvasTileGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasTileGetPayload, error) {
+	return func(r *http.Request) (*api.CanvasTileGetPayload, error) {
+		var (
+			id          string
+			z           int32
+			x           int32
+			y           int32
+			ifNoneMatch *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			zRaw := params["z"]
+			v, err2 := strconv.ParseInt(zRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("z", zRaw, "integer"))
+			}
+			z = int32(v)
+		}
+		if z < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("z", z, 0, true))
+		}
+		{
+			xRaw := params["x"]
+			v, err2 := strconv.ParseInt(xRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x", xRaw, "integer"))
+			}
+			x = int32(v)
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		{
+			yRaw := params["y"]
+			v, err2 := strconv.ParseInt(yRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("y", yRaw, "integer"))
+			}
+			y = int32(v)
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		ifNoneMatchRaw := r.Header.Get("If-None-Match")
+		if ifNoneMatchRaw != "" {
+			ifNoneMatch = &ifNoneMatchRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasTileGetPayload(id, z, x, y, ifNoneMatch)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasTileGetError returns an encoder for errors returned by the
+// CanvasTileGet api endpoint.
+func EncodeCanvasTileGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasTileGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasTileGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasTileGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCanvasSubscribeResponse returns an encoder for responses returned by
+// the api CanvasSubscribe endpoint.
+func EncodeCanvasSubscribeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*api.CanvasEvent)
+		enc := encoder(ctx, w)
+		body := NewCanvasSubscribeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCanvasSubscribeRequest returns a decoder for requests sent to the api
+// CanvasSubscribe endpoint.
+func DecodeCanvasSubscribeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasSubscribePayload, error) {
+	return func(r *http.Request) (*api.CanvasSubscribePayload, error) {
+		var (
+			id          string
+			since       *int64
+			lastEventID *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			sinceRaw := r.URL.Query().Get("since")
+			if sinceRaw != "" {
+				v, err2 := strconv.ParseInt(sinceRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("since", sinceRaw, "integer"))
+				}
+				since = &v
+			}
+		}
+		if since != nil {
+			if *since < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("since", *since, 0, true))
+			}
+		}
+		lastEventIDRaw := r.Header.Get("Last-Event-ID")
+		if lastEventIDRaw != "" {
+			lastEventID = &lastEventIDRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasSubscribePayload(id, since, lastEventID)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasSubscribeError returns an encoder for errors returned by the
+// CanvasSubscribe api endpoint.
+func EncodeCanvasSubscribeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasSubscribeUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasSubscribeAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasSubscribeNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// DecodeCanvasSessionRequest returns a decoder for requests sent to the api
+// CanvasSession endpoint.
+func DecodeCanvasSessionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.CanvasSessionPayload, error) {
+	return func(r *http.Request) (*api.CanvasSessionPayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.URL.Query().Get("access_token")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "query string"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCanvasSessionPayload(id, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeCanvasSessionError returns an encoder for errors returned by the
+// CanvasSession api endpoint.
+func EncodeCanvasSessionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasSessionUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasSessionAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCanvasSessionNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePixelPlaceResponse returns an encoder for responses returned by the
+// api PixelPlace endpoint.
+func EncodePixelPlaceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.Pixel)
+		enc := encoder(ctx, w)
+		body := NewPixelPlaceResponseBody(res.Projected)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePixelPlaceRequest returns a decoder for requests sent to the api
+// PixelPlace endpoint.
+func DecodePixelPlaceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.PixelPlacePayload, error) {
+	return func(r *http.Request) (*api.PixelPlacePayload, error) {
+		var (
+			body PixelPlaceRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePixelPlaceRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPixelPlacePayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodePixelPlaceError returns an encoder for errors returned by the
+// PixelPlace api endpoint.
+func EncodePixelPlaceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "cooldown_active":
+			var res *api.CooldownError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelPlaceCooldownActiveResponseBody(res)
+			}
+			{
+				val := res.RetryAfter
+				retryAfters := strconv.FormatInt(int64(val), 10)
+				w.Header().Set("Retry-After", retryAfters)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
+		case "canvas_archived":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelPlaceCanvasArchivedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelPlaceUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelPlaceAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelPlaceNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePixelInfoGetResponse returns an encoder for responses returned by the
+// api PixelInfoGet endpoint.
+func EncodePixelInfoGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*apiviews.PixelInfo)
+		enc := encoder(ctx, w)
+		body := NewPixelInfoGetResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePixelInfoGetRequest returns a decoder for requests sent to the api
+// PixelInfoGet endpoint.
+func DecodePixelInfoGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*api.PixelInfoGetPayload, error) {
+	return func(r *http.Request) (*api.PixelInfoGetPayload, error) {
+		var (
+			id    string
+			x     int32
+			y     int32
+			limit int32
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			xRaw := params["x"]
+			v, err2 := strconv.ParseInt(xRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x", xRaw, "integer"))
+			}
+			x = int32(v)
+		}
+		if x < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("x", x, 0, true))
+		}
+		{
+			yRaw := params["y"]
+			v, err2 := strconv.ParseInt(yRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("y", yRaw, "integer"))
+			}
+			y = int32(v)
+		}
+		if y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("y", y, 0, true))
+		}
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 10
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int32(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPixelInfoGetPayload(id, x, y, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodePixelInfoGetError returns an encoder for errors returned by the
+// PixelInfoGet api endpoint.
+func EncodePixelInfoGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthenticated":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelInfoGetUnauthenticatedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "access_denied":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelInfoGetAccessDeniedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPixelInfoGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalApiviewsProtectedRegionViewToProtectedRegionResponseBody builds a
+// value of type *ProtectedRegionResponseBody from a value of type
+// *apiviews.ProtectedRegionView.
+func marshalApiviewsProtectedRegionViewToProtectedRegionResponseBody(v *apiviews.ProtectedRegionView) *ProtectedRegionResponseBody {
+	res := &ProtectedRegionResponseBody{
+		ID:        *v.ID,
+		X:         *v.X,
+		Y:         *v.Y,
+		Width:     *v.Width,
+		Height:    *v.Height,
+		Reason:    *v.Reason,
+		CreatedAt: *v.CreatedAt,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// marshalApiviewsCanvasViewToCanvasResponseBody builds a value of type
+// *CanvasResponseBody from a value of type *apiviews.CanvasView.
+func marshalApiviewsCanvasViewToCanvasResponseBody(v *apiviews.CanvasView) *CanvasResponseBody {
+	res := &CanvasResponseBody{
+		ID:         *v.ID,
+		Width:      *v.Width,
+		Height:     *v.Height,
+		CreatedAt:  *v.CreatedAt,
+		ArchivedAt: v.ArchivedAt,
+		Version:    *v.Version,
+	}
+	if v.Palette != nil {
+		res.Palette = make([]string, len(v.Palette))
+		for i, val := range v.Palette {
+			res.Palette[i] = val
+		}
+	} else {
+		res.Palette = []string{}
+	}
+	if v.ProtectedRegions != nil {
+		res.ProtectedRegions = make([]*ProtectedRegionResponseBody, len(v.ProtectedRegions))
+		for i, val := range v.ProtectedRegions {
+			res.ProtectedRegions[i] = marshalApiviewsProtectedRegionViewToProtectedRegionResponseBody(val)
+		}
+	} else {
+		res.ProtectedRegions = []*ProtectedRegionResponseBody{}
+	}
+
+	return res
+}
+
+// marshalApiviewsAuditEntryViewToAuditEntryResponseBody builds a value of type
+// *AuditEntryResponseBody from a value of type *apiviews.AuditEntryView.
+func marshalApiviewsAuditEntryViewToAuditEntryResponseBody(v *apiviews.AuditEntryView) *AuditEntryResponseBody {
+	res := &AuditEntryResponseBody{
+		Action:    *v.Action,
+		UserID:    *v.UserID,
+		Details:   *v.Details,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// marshalApiviewsCanvasChunkViewToCanvasChunkResponseBody builds a value of
+// type *CanvasChunkResponseBody from a value of type *apiviews.CanvasChunkView.
+func marshalApiviewsCanvasChunkViewToCanvasChunkResponseBody(v *apiviews.CanvasChunkView) *CanvasChunkResponseBody {
+	res := &CanvasChunkResponseBody{
+		X:       *v.X,
+		Y:       *v.Y,
+		Width:   *v.Width,
+		Height:  *v.Height,
+		Version: *v.Version,
+		Pixels:  v.Pixels,
+	}
+
+	return res
+}
+
+// marshalAPIPixelEventToPixelEventResponseBody builds a value of type
+// *PixelEventResponseBody from a value of type *api.PixelEvent.
+func marshalAPIPixelEventToPixelEventResponseBody(v *api.PixelEvent) *PixelEventResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &PixelEventResponseBody{
+		X:        v.X,
+		Y:        v.Y,
+		Color:    v.Color,
+		UserID:   v.UserID,
+		PlacedAt: v.PlacedAt,
+		Seq:      v.Seq,
+	}
+
+	return res
+}
+
+// marshalAPICanvasSnapshotToCanvasSnapshotResponseBody builds a value of type
+// *CanvasSnapshotResponseBody from a value of type *api.CanvasSnapshot.
+func marshalAPICanvasSnapshotToCanvasSnapshotResponseBody(v *api.CanvasSnapshot) *CanvasSnapshotResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &CanvasSnapshotResponseBody{
+		Seq:    v.Seq,
+		Width:  v.Width,
+		Height: v.Height,
+		Pixels: v.Pixels,
+	}
+
+	return res
+}
+
+// marshalAPICanvasToCanvasResponseBody builds a value of type
+// *CanvasResponseBody from a value of type *api.Canvas.
+func marshalAPICanvasToCanvasResponseBody(v *api.Canvas) *CanvasResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &CanvasResponseBody{
+		ID:         v.ID,
+		Width:      v.Width,
+		Height:     v.Height,
+		CreatedAt:  v.CreatedAt,
+		ArchivedAt: v.ArchivedAt,
+		Version:    v.Version,
+	}
+	if v.Palette != nil {
+		res.Palette = make([]string, len(v.Palette))
+		for i, val := range v.Palette {
+			res.Palette[i] = val
+		}
+	} else {
+		res.Palette = []string{}
+	}
+	if v.ProtectedRegions != nil {
+		res.ProtectedRegions = make([]*ProtectedRegionResponseBody, len(v.ProtectedRegions))
+		for i, val := range v.ProtectedRegions {
+			res.ProtectedRegions[i] = marshalAPIProtectedRegionToProtectedRegionResponseBody(val)
+		}
+	} else {
+		res.ProtectedRegions = []*ProtectedRegionResponseBody{}
+	}
+
+	return res
+}
+
+// marshalAPIProtectedRegionToProtectedRegionResponseBody builds a value of
+// type *ProtectedRegionResponseBody from a value of type *api.ProtectedRegion.
+func marshalAPIProtectedRegionToProtectedRegionResponseBody(v *api.ProtectedRegion) *ProtectedRegionResponseBody {
+	res := &ProtectedRegionResponseBody{
+		ID:        v.ID,
+		X:         v.X,
+		Y:         v.Y,
+		Width:     v.Width,
+		Height:    v.Height,
+		Reason:    v.Reason,
+		CreatedAt: v.CreatedAt,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// marshalAPIPlacementRejectionToPlacementRejectionResponseBody builds a value
+// of type *PlacementRejectionResponseBody from a value of type
+// *api.PlacementRejection.
+func marshalAPIPlacementRejectionToPlacementRejectionResponseBody(v *api.PlacementRejection) *PlacementRejectionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &PlacementRejectionResponseBody{
+		X:          v.X,
+		Y:          v.Y,
+		Name:       v.Name,
+		Message:    v.Message,
+		RetryAfter: v.RetryAfter,
+	}
+
+	return res
+}
+
+// marshalApiviewsPixelHistoryEntryViewToPixelHistoryEntryResponseBody builds a
+// value of type *PixelHistoryEntryResponseBody from a value of type
+// *apiviews.PixelHistoryEntryView.
+func marshalApiviewsPixelHistoryEntryViewToPixelHistoryEntryResponseBody(v *apiviews.PixelHistoryEntryView) *PixelHistoryEntryResponseBody {
+	res := &PixelHistoryEntryResponseBody{
+		UserID:   *v.UserID,
+		Color:    *v.Color,
+		PlacedAt: *v.PlacedAt,
+		Seq:      *v.Seq,
+	}
+
+	return res
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // HTTP request path constructors for the api service.
 //
@@ -7,7 +7,101 @@
 
 package server
 
+import (
+	"fmt"
+)
+
+// CanvasCreateAPIPath returns the URL path to the api service CanvasCreate HTTP endpoint.
+func CanvasCreateAPIPath() string {
+	return "/api/v1/canvases"
+}
+
+// CanvasListAPIPath returns the URL path to the api service CanvasList HTTP endpoint.
+func CanvasListAPIPath() string {
+	return "/api/v1/canvases"
+}
+
 // CanvasGetAPIPath returns the URL path to the api service CanvasGet HTTP endpoint.
-func CanvasGetAPIPath() string {
-	return "/api/v1/canvas"
+func CanvasGetAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v", id)
+}
+
+// CanvasArchiveAPIPath returns the URL path to the api service CanvasArchive HTTP endpoint.
+func CanvasArchiveAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/archive", id)
+}
+
+// CanvasResizeAPIPath returns the URL path to the api service CanvasResize HTTP endpoint.
+func CanvasResizeAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/resize", id)
+}
+
+// ProtectedRegionCreateAPIPath returns the URL path to the api service ProtectedRegionCreate HTTP endpoint.
+func ProtectedRegionCreateAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/protected-regions", id)
+}
+
+// ProtectedRegionDeleteAPIPath returns the URL path to the api service ProtectedRegionDelete HTTP endpoint.
+func ProtectedRegionDeleteAPIPath(id string, regionID string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/protected-regions/%v", id, regionID)
+}
+
+// CanvasRollbackAPIPath returns the URL path to the api service CanvasRollback HTTP endpoint.
+func CanvasRollbackAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/rollback", id)
+}
+
+// AuditLogGetAPIPath returns the URL path to the api service AuditLogGet HTTP endpoint.
+func AuditLogGetAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/audit-log", id)
+}
+
+// CanvasPixelsGetAPIPath returns the URL path to the api service CanvasPixelsGet HTTP endpoint.
+func CanvasPixelsGetAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/pixels", id)
+}
+
+// CanvasChunksGetAPIPath returns the URL path to the api service CanvasChunksGet HTTP endpoint.
+func CanvasChunksGetAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/chunks", id)
+}
+
+// CanvasRegionGetAPIPath returns the URL path to the api service CanvasRegionGet HTTP endpoint.
+func CanvasRegionGetAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/region", id)
+}
+
+// CanvasImageGetAPIPath returns the URL path to the api service CanvasImageGet HTTP endpoint.
+func CanvasImageGetAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/image.png", id)
+}
+
+// CanvasRegionImageGetAPIPath returns the URL path to the api service CanvasRegionImageGet HTTP endpoint.
+func CanvasRegionImageGetAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/region.png", id)
+}
+
+// CanvasTileGetAPIPath returns the URL path to the api service CanvasTileGet HTTP endpoint.
+func CanvasTileGetAPIPath(id string, z int32, x int32, y int32) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/tiles/%v/%v/%v.png", id, z, x, y)
+}
+
+// CanvasSubscribeAPIPath returns the URL path to the api service CanvasSubscribe HTTP endpoint.
+func CanvasSubscribeAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/events", id)
+}
+
+// CanvasSessionAPIPath returns the URL path to the api service CanvasSession HTTP endpoint.
+func CanvasSessionAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/session", id)
+}
+
+// PixelPlaceAPIPath returns the URL path to the api service PixelPlace HTTP endpoint.
+func PixelPlaceAPIPath(id string) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/pixels", id)
+}
+
+// PixelInfoGetAPIPath returns the URL path to the api service PixelInfoGet HTTP endpoint.
+func PixelInfoGetAPIPath(id string, x int32, y int32) string {
+	return fmt.Sprintf("/api/v1/canvases/%v/pixels/%v/%v", id, x, y)
 }
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api HTTP server
 //
@@ -19,9 +19,27 @@ import (
 
 // Server lists the api service endpoint HTTP handlers.
 type Server struct {
-	Mounts              []*MountPoint
-	CanvasGet           http.Handler
-	GenHTTPOpenapi3JSON http.Handler
+	Mounts                []*MountPoint
+	CanvasCreate          http.Handler
+	CanvasList            http.Handler
+	CanvasGet             http.Handler
+	CanvasArchive         http.Handler
+	CanvasResize          http.Handler
+	ProtectedRegionCreate http.Handler
+	ProtectedRegionDelete http.Handler
+	CanvasRollback        http.Handler
+	AuditLogGet           http.Handler
+	CanvasPixelsGet       http.Handler
+	CanvasChunksGet       http.Handler
+	CanvasRegionGet       http.Handler
+	CanvasImageGet        http.Handler
+	CanvasRegionImageGet  http.Handler
+	CanvasTileGet         http.Handler
+	CanvasSubscribe       http.Handler
+	CanvasSession         http.Handler
+	PixelPlace            http.Handler
+	PixelInfoGet          http.Handler
+	GenHTTPOpenapi3JSON   http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -48,19 +66,60 @@ func New(
 	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
 	errhandler func(context.Context, http.ResponseWriter, error),
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	upgrader goahttp.Upgrader,
+	configurer *ConnConfigurer,
 	fileSystemGenHTTPOpenapi3JSON http.FileSystem,
 ) *Server {
+	if configurer == nil {
+		configurer = &ConnConfigurer{}
+	}
 	if fileSystemGenHTTPOpenapi3JSON == nil {
 		fileSystemGenHTTPOpenapi3JSON = http.Dir(".")
 	}
 	fileSystemGenHTTPOpenapi3JSON = appendPrefix(fileSystemGenHTTPOpenapi3JSON, "/gen/http")
 	return &Server{
 		Mounts: []*MountPoint{
-			{"CanvasGet", "GET", "/api/v1/canvas"},
+			{"CanvasCreate", "POST", "/api/v1/canvases"},
+			{"CanvasList", "GET", "/api/v1/canvases"},
+			{"CanvasGet", "GET", "/api/v1/canvases/{id}"},
+			{"CanvasArchive", "POST", "/api/v1/canvases/{id}/archive"},
+			{"CanvasResize", "POST", "/api/v1/canvases/{id}/resize"},
+			{"ProtectedRegionCreate", "POST", "/api/v1/canvases/{id}/protected-regions"},
+			{"ProtectedRegionDelete", "DELETE", "/api/v1/canvases/{id}/protected-regions/{region_id}"},
+			{"CanvasRollback", "POST", "/api/v1/canvases/{id}/rollback"},
+			{"AuditLogGet", "GET", "/api/v1/canvases/{id}/audit-log"},
+			{"CanvasPixelsGet", "GET", "/api/v1/canvases/{id}/pixels"},
+			{"CanvasChunksGet", "GET", "/api/v1/canvases/{id}/chunks"},
+			{"CanvasRegionGet", "GET", "/api/v1/canvases/{id}/region"},
+			{"CanvasImageGet", "GET", "/api/v1/canvases/{id}/image.png"},
+			{"CanvasRegionImageGet", "GET", "/api/v1/canvases/{id}/region.png"},
+			{"CanvasTileGet", "GET", "/api/v1/canvases/{id}/tiles/{z}/{x}/{y}.png"},
+			{"CanvasSubscribe", "GET", "/api/v1/canvases/{id}/events"},
+			{"CanvasSession", "GET", "/api/v1/canvases/{id}/session"},
+			{"PixelPlace", "POST", "/api/v1/canvases/{id}/pixels"},
+			{"PixelInfoGet", "GET", "/api/v1/canvases/{id}/pixels/{x}/{y}"},
 			{"Serve gen/http/openapi3.json", "GET", "/api/v1/openapi.json"},
 		},
-		CanvasGet:           NewCanvasGetHandler(e.CanvasGet, mux, decoder, encoder, errhandler, formatter),
-		GenHTTPOpenapi3JSON: http.FileServer(fileSystemGenHTTPOpenapi3JSON),
+		CanvasCreate:          NewCanvasCreateHandler(e.CanvasCreate, mux, decoder, encoder, errhandler, formatter),
+		CanvasList:            NewCanvasListHandler(e.CanvasList, mux, decoder, encoder, errhandler, formatter),
+		CanvasGet:             NewCanvasGetHandler(e.CanvasGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasArchive:         NewCanvasArchiveHandler(e.CanvasArchive, mux, decoder, encoder, errhandler, formatter),
+		CanvasResize:          NewCanvasResizeHandler(e.CanvasResize, mux, decoder, encoder, errhandler, formatter),
+		ProtectedRegionCreate: NewProtectedRegionCreateHandler(e.ProtectedRegionCreate, mux, decoder, encoder, errhandler, formatter),
+		ProtectedRegionDelete: NewProtectedRegionDeleteHandler(e.ProtectedRegionDelete, mux, decoder, encoder, errhandler, formatter),
+		CanvasRollback:        NewCanvasRollbackHandler(e.CanvasRollback, mux, decoder, encoder, errhandler, formatter),
+		AuditLogGet:           NewAuditLogGetHandler(e.AuditLogGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasPixelsGet:       NewCanvasPixelsGetHandler(e.CanvasPixelsGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasChunksGet:       NewCanvasChunksGetHandler(e.CanvasChunksGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasRegionGet:       NewCanvasRegionGetHandler(e.CanvasRegionGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasImageGet:        NewCanvasImageGetHandler(e.CanvasImageGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasRegionImageGet:  NewCanvasRegionImageGetHandler(e.CanvasRegionImageGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasTileGet:         NewCanvasTileGetHandler(e.CanvasTileGet, mux, decoder, encoder, errhandler, formatter),
+		CanvasSubscribe:       NewCanvasSubscribeHandler(e.CanvasSubscribe, mux, decoder, encoder, errhandler, formatter),
+		CanvasSession:         NewCanvasSessionHandler(e.CanvasSession, mux, decoder, encoder, errhandler, formatter, upgrader, configurer.CanvasSessionFn),
+		PixelPlace:            NewPixelPlaceHandler(e.PixelPlace, mux, decoder, encoder, errhandler, formatter),
+		PixelInfoGet:          NewPixelInfoGetHandler(e.PixelInfoGet, mux, decoder, encoder, errhandler, formatter),
+		GenHTTPOpenapi3JSON:   http.FileServer(fileSystemGenHTTPOpenapi3JSON),
 	}
 }
 
@@ -69,7 +128,25 @@ func (s *Server) Service() string { return "api" }
 
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.CanvasCreate = m(s.CanvasCreate)
+	s.CanvasList = m(s.CanvasList)
 	s.CanvasGet = m(s.CanvasGet)
+	s.CanvasArchive = m(s.CanvasArchive)
+	s.CanvasResize = m(s.CanvasResize)
+	s.ProtectedRegionCreate = m(s.ProtectedRegionCreate)
+	s.ProtectedRegionDelete = m(s.ProtectedRegionDelete)
+	s.CanvasRollback = m(s.CanvasRollback)
+	s.AuditLogGet = m(s.AuditLogGet)
+	s.CanvasPixelsGet = m(s.CanvasPixelsGet)
+	s.CanvasChunksGet = m(s.CanvasChunksGet)
+	s.CanvasRegionGet = m(s.CanvasRegionGet)
+	s.CanvasImageGet = m(s.CanvasImageGet)
+	s.CanvasRegionImageGet = m(s.CanvasRegionImageGet)
+	s.CanvasTileGet = m(s.CanvasTileGet)
+	s.CanvasSubscribe = m(s.CanvasSubscribe)
+	s.CanvasSession = m(s.CanvasSession)
+	s.PixelPlace = m(s.PixelPlace)
+	s.PixelInfoGet = m(s.PixelInfoGet)
 }
 
 // MethodNames returns the methods served.
@@ -77,7 +154,25 @@ func (s *Server) MethodNames() []string { return api.MethodNames[:] }
 
 // Mount configures the mux to serve the api endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
+	MountCanvasCreateHandler(mux, h.CanvasCreate)
+	MountCanvasListHandler(mux, h.CanvasList)
 	MountCanvasGetHandler(mux, h.CanvasGet)
+	MountCanvasArchiveHandler(mux, h.CanvasArchive)
+	MountCanvasResizeHandler(mux, h.CanvasResize)
+	MountProtectedRegionCreateHandler(mux, h.ProtectedRegionCreate)
+	MountProtectedRegionDeleteHandler(mux, h.ProtectedRegionDelete)
+	MountCanvasRollbackHandler(mux, h.CanvasRollback)
+	MountAuditLogGetHandler(mux, h.AuditLogGet)
+	MountCanvasPixelsGetHandler(mux, h.CanvasPixelsGet)
+	MountCanvasChunksGetHandler(mux, h.CanvasChunksGet)
+	MountCanvasRegionGetHandler(mux, h.CanvasRegionGet)
+	MountCanvasImageGetHandler(mux, h.CanvasImageGet)
+	MountCanvasRegionImageGetHandler(mux, h.CanvasRegionImageGet)
+	MountCanvasTileGetHandler(mux, h.CanvasTileGet)
+	MountCanvasSubscribeHandler(mux, h.CanvasSubscribe)
+	MountCanvasSessionHandler(mux, h.CanvasSession)
+	MountPixelPlaceHandler(mux, h.PixelPlace)
+	MountPixelInfoGetHandler(mux, h.PixelInfoGet)
 	MountGenHTTPOpenapi3JSON(mux, http.StripPrefix("/api/v1", h.GenHTTPOpenapi3JSON))
 }
 
@@ -86,6 +181,112 @@ func (s *Server) Mount(mux goahttp.Muxer) {
 	Mount(mux, s)
 }
 
+// MountCanvasCreateHandler configures the mux to serve the "api" service
+// "CanvasCreate" endpoint.
+func MountCanvasCreateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/canvases", f)
+}
+
+// NewCanvasCreateHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "CanvasCreate" endpoint.
+func NewCanvasCreateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasCreateRequest(mux, decoder)
+		encodeResponse = EncodeCanvasCreateResponse(encoder)
+		encodeError    = EncodeCanvasCreateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasCreate")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasListHandler configures the mux to serve the "api" service
+// "CanvasList" endpoint.
+func MountCanvasListHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases", f)
+}
+
+// NewCanvasListHandler creates a HTTP handler which loads the HTTP request and
+// calls the "api" service "CanvasList" endpoint.
+func NewCanvasListHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasListRequest(mux, decoder)
+		encodeResponse = EncodeCanvasListResponse(encoder)
+		encodeError    = EncodeCanvasListError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasList")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountCanvasGetHandler configures the mux to serve the "api" service
 // "CanvasGet" endpoint.
 func MountCanvasGetHandler(mux goahttp.Muxer, h http.Handler) {
@@ -95,7 +296,7 @@ func MountCanvasGetHandler(mux goahttp.Muxer, h http.Handler) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/api/v1/canvas", f)
+	mux.Handle("GET", "/api/v1/canvases/{id}", f)
 }
 
 // NewCanvasGetHandler creates a HTTP handler which loads the HTTP request and
@@ -109,6 +310,7 @@ func NewCanvasGetHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
+		decodeRequest  = DecodeCanvasGetRequest(mux, decoder)
 		encodeResponse = EncodeCanvasGetResponse(encoder)
 		encodeError    = EncodeCanvasGetError(encoder, formatter)
 	)
@@ -116,8 +318,884 @@ func NewCanvasGetHandler(
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
 		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasGet")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
-		var err error
-		res, err := endpoint(ctx, nil)
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasArchiveHandler configures the mux to serve the "api" service
+// "CanvasArchive" endpoint.
+func MountCanvasArchiveHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/canvases/{id}/archive", f)
+}
+
+// NewCanvasArchiveHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "CanvasArchive" endpoint.
+func NewCanvasArchiveHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasArchiveRequest(mux, decoder)
+		encodeResponse = EncodeCanvasArchiveResponse(encoder)
+		encodeError    = EncodeCanvasArchiveError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasArchive")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasResizeHandler configures the mux to serve the "api" service
+// "CanvasResize" endpoint.
+func MountCanvasResizeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/canvases/{id}/resize", f)
+}
+
+// NewCanvasResizeHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "CanvasResize" endpoint.
+func NewCanvasResizeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasResizeRequest(mux, decoder)
+		encodeResponse = EncodeCanvasResizeResponse(encoder)
+		encodeError    = EncodeCanvasResizeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasResize")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountProtectedRegionCreateHandler configures the mux to serve the "api"
+// service "ProtectedRegionCreate" endpoint.
+func MountProtectedRegionCreateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/canvases/{id}/protected-regions", f)
+}
+
+// NewProtectedRegionCreateHandler creates a HTTP handler which loads the HTTP
+// request and calls the "api" service "ProtectedRegionCreate" endpoint.
+func NewProtectedRegionCreateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeProtectedRegionCreateRequest(mux, decoder)
+		encodeResponse = EncodeProtectedRegionCreateResponse(encoder)
+		encodeError    = EncodeProtectedRegionCreateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ProtectedRegionCreate")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountProtectedRegionDeleteHandler configures the mux to serve the "api"
+// service "ProtectedRegionDelete" endpoint.
+func MountProtectedRegionDeleteHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/v1/canvases/{id}/protected-regions/{region_id}", f)
+}
+
+// NewProtectedRegionDeleteHandler creates a HTTP handler which loads the HTTP
+// request and calls the "api" service "ProtectedRegionDelete" endpoint.
+func NewProtectedRegionDeleteHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeProtectedRegionDeleteRequest(mux, decoder)
+		encodeResponse = EncodeProtectedRegionDeleteResponse(encoder)
+		encodeError    = EncodeProtectedRegionDeleteError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ProtectedRegionDelete")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasRollbackHandler configures the mux to serve the "api" service
+// "CanvasRollback" endpoint.
+func MountCanvasRollbackHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/canvases/{id}/rollback", f)
+}
+
+// NewCanvasRollbackHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "CanvasRollback" endpoint.
+func NewCanvasRollbackHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasRollbackRequest(mux, decoder)
+		encodeResponse = EncodeCanvasRollbackResponse(encoder)
+		encodeError    = EncodeCanvasRollbackError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasRollback")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAuditLogGetHandler configures the mux to serve the "api" service
+// "AuditLogGet" endpoint.
+func MountAuditLogGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/audit-log", f)
+}
+
+// NewAuditLogGetHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "AuditLogGet" endpoint.
+func NewAuditLogGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAuditLogGetRequest(mux, decoder)
+		encodeResponse = EncodeAuditLogGetResponse(encoder)
+		encodeError    = EncodeAuditLogGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "AuditLogGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasPixelsGetHandler configures the mux to serve the "api" service
+// "CanvasPixelsGet" endpoint.
+func MountCanvasPixelsGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/pixels", f)
+}
+
+// NewCanvasPixelsGetHandler creates a HTTP handler which loads the HTTP
+// request and calls the "api" service "CanvasPixelsGet" endpoint.
+func NewCanvasPixelsGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasPixelsGetRequest(mux, decoder)
+		encodeResponse = EncodeCanvasPixelsGetResponse(encoder)
+		encodeError    = EncodeCanvasPixelsGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasPixelsGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasChunksGetHandler configures the mux to serve the "api" service
+// "CanvasChunksGet" endpoint.
+func MountCanvasChunksGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/chunks", f)
+}
+
+// NewCanvasChunksGetHandler creates a HTTP handler which loads the HTTP
+// request and calls the "api" service "CanvasChunksGet" endpoint.
+func NewCanvasChunksGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasChunksGetRequest(mux, decoder)
+		encodeResponse = EncodeCanvasChunksGetResponse(encoder)
+		encodeError    = EncodeCanvasChunksGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasChunksGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasRegionGetHandler configures the mux to serve the "api" service
+// "CanvasRegionGet" endpoint.
+func MountCanvasRegionGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/region", f)
+}
+
+// NewCanvasRegionGetHandler creates a HTTP handler which loads the HTTP
+// request and calls the "api" service "CanvasRegionGet" endpoint.
+func NewCanvasRegionGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasRegionGetRequest(mux, decoder)
+		encodeResponse = EncodeCanvasRegionGetResponse(encoder)
+		encodeError    = EncodeCanvasRegionGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasRegionGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasImageGetHandler configures the mux to serve the "api" service
+// "CanvasImageGet" endpoint.
+func MountCanvasImageGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/image.png", f)
+}
+
+// NewCanvasImageGetHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "CanvasImageGet" endpoint.
+func NewCanvasImageGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasImageGetRequest(mux, decoder)
+		encodeResponse = EncodeCanvasImageGetResponse(encoder)
+		encodeError    = EncodeCanvasImageGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasImageGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasRegionImageGetHandler configures the mux to serve the "api"
+// service "CanvasRegionImageGet" endpoint.
+func MountCanvasRegionImageGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/region.png", f)
+}
+
+// NewCanvasRegionImageGetHandler creates a HTTP handler which loads the HTTP
+// request and calls the "api" service "CanvasRegionImageGet" endpoint.
+func NewCanvasRegionImageGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasRegionImageGetRequest(mux, decoder)
+		encodeResponse = EncodeCanvasRegionImageGetResponse(encoder)
+		encodeError    = EncodeCanvasRegionImageGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasRegionImageGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasTileGetHandler configures the mux to serve the "api" service
+// "CanvasTileGet" endpoint.
+func MountCanvasTileGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/tiles/{z}/{x}/{y}.png", f)
+}
+
+// NewCanvasTileGetHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "CanvasTileGet" endpoint.
+func NewCanvasTileGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCanvasTileGetRequest(mux, decoder)
+		encodeResponse = EncodeCanvasTileGetResponse(encoder)
+		encodeError    = EncodeCanvasTileGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasTileGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCanvasSubscribeHandler configures the mux to serve the "api" service
+// "CanvasSubscribe" endpoint.
+func MountCanvasSubscribeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/events", f)
+}
+
+// NewCanvasSubscribeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "api" service "CanvasSubscribe" endpoint.
+func NewCanvasSubscribeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest = DecodeCanvasSubscribeRequest(mux, decoder)
+		encodeError   = EncodeCanvasSubscribeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasSubscribe")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		v := &api.CanvasSubscribeEndpointInput{
+			Stream: &CanvasSubscribeServerStream{
+				w: w,
+				r: r,
+			},
+			Payload: payload,
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountCanvasSessionHandler configures the mux to serve the "api" service
+// "CanvasSession" endpoint.
+func MountCanvasSessionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/session", f)
+}
+
+// NewCanvasSessionHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "CanvasSession" endpoint.
+func NewCanvasSessionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	upgrader goahttp.Upgrader,
+	configurer goahttp.ConnConfigureFunc,
+) http.Handler {
+	var (
+		decodeRequest = DecodeCanvasSessionRequest(mux, decoder)
+		encodeError   = EncodeCanvasSessionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CanvasSession")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		v := &api.CanvasSessionEndpointInput{
+			Stream: &CanvasSessionServerStream{
+				upgrader:   upgrader,
+				configurer: configurer,
+				cancel:     cancel,
+				w:          w,
+				r:          r,
+			},
+			Payload: payload,
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			var stream *CanvasSessionServerStream
+			if wrapper, ok := v.Stream.(interface{ Unwrap() any }); ok {
+				stream = wrapper.Unwrap().(*CanvasSessionServerStream)
+			} else {
+				stream = v.Stream.(*CanvasSessionServerStream)
+			}
+			if stream != nil && stream.conn != nil {
+				// Response writer has been hijacked, do not encode the error
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountPixelPlaceHandler configures the mux to serve the "api" service
+// "PixelPlace" endpoint.
+func MountPixelPlaceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/canvases/{id}/pixels", f)
+}
+
+// NewPixelPlaceHandler creates a HTTP handler which loads the HTTP request and
+// calls the "api" service "PixelPlace" endpoint.
+func NewPixelPlaceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePixelPlaceRequest(mux, decoder)
+		encodeResponse = EncodePixelPlaceResponse(encoder)
+		encodeError    = EncodePixelPlaceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "PixelPlace")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPixelInfoGetHandler configures the mux to serve the "api" service
+// "PixelInfoGet" endpoint.
+func MountPixelInfoGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/canvases/{id}/pixels/{x}/{y}", f)
+}
+
+// NewPixelInfoGetHandler creates a HTTP handler which loads the HTTP request
+// and calls the "api" service "PixelInfoGet" endpoint.
+func NewPixelInfoGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePixelInfoGetRequest(mux, decoder)
+		encodeResponse = EncodePixelInfoGetResponse(encoder)
+		encodeError    = EncodePixelInfoGetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "PixelInfoGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "api")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // api HTTP server types
 //
@@ -8,16 +8,348 @@
 package server
 
 import (
+	"unicode/utf8"
+
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apiviews "github.com/jace-ys/pikcel/api/v1/gen/api/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CanvasCreateRequestBody is the type of the "api" service "CanvasCreate"
+// endpoint HTTP request body.
+type CanvasCreateRequestBody struct {
+	Width  *int32 `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32 `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Ordered list of colors available on the canvas, defaults to the standard
+	// palette if empty.
+	Palette []string `form:"palette,omitempty" json:"palette,omitempty" xml:"palette,omitempty"`
+}
+
+// CanvasResizeRequestBody is the type of the "api" service "CanvasResize"
+// endpoint HTTP request body.
+type CanvasResizeRequestBody struct {
+	Left   *int32 `form:"left,omitempty" json:"left,omitempty" xml:"left,omitempty"`
+	Top    *int32 `form:"top,omitempty" json:"top,omitempty" xml:"top,omitempty"`
+	Right  *int32 `form:"right,omitempty" json:"right,omitempty" xml:"right,omitempty"`
+	Bottom *int32 `form:"bottom,omitempty" json:"bottom,omitempty" xml:"bottom,omitempty"`
+	// Color of the pixels added to the canvas.
+	Fill *int32 `form:"fill,omitempty" json:"fill,omitempty" xml:"fill,omitempty"`
+}
+
+// ProtectedRegionCreateRequestBody is the type of the "api" service
+// "ProtectedRegionCreate" endpoint HTTP request body.
+type ProtectedRegionCreateRequestBody struct {
+	X      *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y      *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	Width  *int32 `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32 `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Why the region is protected, e.g. a sponsor's logo.
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Number of seconds to protect the region for. The region is protected until
+	// deleted if unset.
+	Duration *int32 `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+}
+
+// CanvasRollbackRequestBody is the type of the "api" service "CanvasRollback"
+// endpoint HTTP request body.
+type CanvasRollbackRequestBody struct {
+	// Revert pixels placed by this user.
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	X      *int32  `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y      *int32  `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	// Revert pixels within this region, along with x, y and height.
+	Width  *int32 `form:"width,omitempty" json:"width,omitempty" xml:"width,omitempty"`
+	Height *int32 `form:"height,omitempty" json:"height,omitempty" xml:"height,omitempty"`
+	// Revert pixels placed at or after this time.
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Revert pixels placed before this time.
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// CanvasSessionStreamingBody is the type of the "api" service "CanvasSession"
+// endpoint HTTP request body.
+type CanvasSessionStreamingBody PixelPlacementStreamingBody
+
+// PixelPlaceRequestBody is the type of the "api" service "PixelPlace" endpoint
+// HTTP request body.
+type PixelPlaceRequestBody struct {
+	X     *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y     *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	Color *int32 `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
+}
+
+// CanvasCreateResponseBody is the type of the "api" service "CanvasCreate"
+// endpoint HTTP response body.
+type CanvasCreateResponseBody struct {
+	ID     string `form:"id" json:"id" xml:"id"`
+	Width  int32  `form:"width" json:"width" xml:"width"`
+	Height int32  `form:"height" json:"height" xml:"height"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette" json:"palette" xml:"palette"`
+	CreatedAt string   `form:"created_at" json:"created_at" xml:"created_at"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `form:"version" json:"version" xml:"version"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions" json:"protected_regions" xml:"protected_regions"`
+}
+
+// CanvasListResponseBody is the type of the "api" service "CanvasList"
+// endpoint HTTP response body.
+type CanvasListResponseBody struct {
+	// Canvases in the order they were created.
+	Canvases []*CanvasResponseBody `form:"canvases" json:"canvases" xml:"canvases"`
+}
+
 // CanvasGetResponseBody is the type of the "api" service "CanvasGet" endpoint
 // HTTP response body.
 type CanvasGetResponseBody struct {
 	ID     string `form:"id" json:"id" xml:"id"`
 	Width  int32  `form:"width" json:"width" xml:"width"`
 	Height int32  `form:"height" json:"height" xml:"height"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette" json:"palette" xml:"palette"`
+	CreatedAt string   `form:"created_at" json:"created_at" xml:"created_at"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `form:"version" json:"version" xml:"version"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions" json:"protected_regions" xml:"protected_regions"`
+}
+
+// CanvasArchiveResponseBody is the type of the "api" service "CanvasArchive"
+// endpoint HTTP response body.
+type CanvasArchiveResponseBody struct {
+	ID     string `form:"id" json:"id" xml:"id"`
+	Width  int32  `form:"width" json:"width" xml:"width"`
+	Height int32  `form:"height" json:"height" xml:"height"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette" json:"palette" xml:"palette"`
+	CreatedAt string   `form:"created_at" json:"created_at" xml:"created_at"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `form:"version" json:"version" xml:"version"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions" json:"protected_regions" xml:"protected_regions"`
+}
+
+// CanvasResizeResponseBody is the type of the "api" service "CanvasResize"
+// endpoint HTTP response body.
+type CanvasResizeResponseBody struct {
+	ID     string `form:"id" json:"id" xml:"id"`
+	Width  int32  `form:"width" json:"width" xml:"width"`
+	Height int32  `form:"height" json:"height" xml:"height"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette" json:"palette" xml:"palette"`
+	CreatedAt string   `form:"created_at" json:"created_at" xml:"created_at"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `form:"version" json:"version" xml:"version"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions" json:"protected_regions" xml:"protected_regions"`
+}
+
+// ProtectedRegionCreateResponseBody is the type of the "api" service
+// "ProtectedRegionCreate" endpoint HTTP response body.
+type ProtectedRegionCreateResponseBody struct {
+	ID        string `form:"id" json:"id" xml:"id"`
+	X         int32  `form:"x" json:"x" xml:"x"`
+	Y         int32  `form:"y" json:"y" xml:"y"`
+	Width     int32  `form:"width" json:"width" xml:"width"`
+	Height    int32  `form:"height" json:"height" xml:"height"`
+	Reason    string `form:"reason" json:"reason" xml:"reason"`
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Set if the region is only protected until then.
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// CanvasRollbackResponseBody is the type of the "api" service "CanvasRollback"
+// endpoint HTTP response body.
+type CanvasRollbackResponseBody struct {
+	// Number of pixels reverted.
+	Reverted int32 `form:"reverted" json:"reverted" xml:"reverted"`
+}
+
+// AuditLogGetResponseBody is the type of the "api" service "AuditLogGet"
+// endpoint HTTP response body.
+type AuditLogGetResponseBody struct {
+	// Latest actions taken by moderators on the canvas, most recent first.
+	Entries []*AuditEntryResponseBody `form:"entries" json:"entries" xml:"entries"`
+}
+
+// CanvasChunksGetResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body.
+type CanvasChunksGetResponseBody struct {
+	// Sequence number of the latest placement on the canvas, to pass as since to
+	// fetch only later changes.
+	Version int64 `form:"version" json:"version" xml:"version"`
+	// Width and height of each chunk, except those on the right and bottom edges
+	// of the canvas.
+	ChunkSize int32 `form:"chunk_size" json:"chunk_size" xml:"chunk_size"`
+	// Chunks modified after the requested version.
+	Chunks []*CanvasChunkResponseBody `form:"chunks" json:"chunks" xml:"chunks"`
+}
+
+// CanvasSubscribeResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body.
+type CanvasSubscribeResponseBody struct {
+	// Sequence number the canvas is at once the event is applied, used to resume
+	// the stream.
+	ID    string                  `json:"id"`
+	Type  string                  `json:"type"`
+	Pixel *PixelEventResponseBody `json:"pixel,omitempty"`
+	// Sent instead of replaying missed placements when there are too many of them,
+	// or when the canvas has been resized.
+	Snapshot *CanvasSnapshotResponseBody `json:"snapshot,omitempty"`
+}
+
+// CanvasSessionResponseBody is the type of the "api" service "CanvasSession"
+// endpoint HTTP response body.
+type CanvasSessionResponseBody struct {
+	// The canvas the session is for, sent when the session starts and again
+	// whenever the canvas is resized.
+	Canvas *CanvasResponseBody `form:"canvas,omitempty" json:"canvas,omitempty" xml:"canvas,omitempty"`
+	// A pixel placed on the canvas by any user.
+	Pixel *PixelEventResponseBody `form:"pixel,omitempty" json:"pixel,omitempty" xml:"pixel,omitempty"`
+	// A placement sent by the client that was rejected.
+	Rejection *PlacementRejectionResponseBody `form:"rejection,omitempty" json:"rejection,omitempty" xml:"rejection,omitempty"`
+}
+
+// PixelPlaceResponseBody is the type of the "api" service "PixelPlace"
+// endpoint HTTP response body.
+type PixelPlaceResponseBody struct {
+	X     int32 `form:"x" json:"x" xml:"x"`
+	Y     int32 `form:"y" json:"y" xml:"y"`
+	Color int32 `form:"color" json:"color" xml:"color"`
+}
+
+// PixelInfoGetResponseBody is the type of the "api" service "PixelInfoGet"
+// endpoint HTTP response body.
+type PixelInfoGetResponseBody struct {
+	X int32 `form:"x" json:"x" xml:"x"`
+	Y int32 `form:"y" json:"y" xml:"y"`
+	// Latest placements at the coordinate, most recent first.
+	Placements []*PixelHistoryEntryResponseBody `form:"placements" json:"placements" xml:"placements"`
+}
+
+// CanvasCreateUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasCreate" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasCreateUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasCreateAccessDeniedResponseBody is the type of the "api" service
+// "CanvasCreate" endpoint HTTP response body for the "access_denied" error.
+type CanvasCreateAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasCreateNotFoundResponseBody is the type of the "api" service
+// "CanvasCreate" endpoint HTTP response body for the "not_found" error.
+type CanvasCreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasListUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasList" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasListUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasListAccessDeniedResponseBody is the type of the "api" service
+// "CanvasList" endpoint HTTP response body for the "access_denied" error.
+type CanvasListAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasListNotFoundResponseBody is the type of the "api" service "CanvasList"
+// endpoint HTTP response body for the "not_found" error.
+type CanvasListNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
 // CanvasGetUnauthenticatedResponseBody is the type of the "api" service
@@ -56,41 +388,2732 @@ type CanvasGetAccessDeniedResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCanvasGetResponseBody builds the HTTP response body from the result of
-// the "CanvasGet" endpoint of the "api" service.
-func NewCanvasGetResponseBody(res *apiviews.CanvasView) *CanvasGetResponseBody {
-	body := &CanvasGetResponseBody{
-		ID:     *res.ID,
-		Width:  *res.Width,
-		Height: *res.Height,
-	}
-	return body
+// CanvasGetNotFoundResponseBody is the type of the "api" service "CanvasGet"
+// endpoint HTTP response body for the "not_found" error.
+type CanvasGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCanvasGetUnauthenticatedResponseBody builds the HTTP response body from
-// the result of the "CanvasGet" endpoint of the "api" service.
-func NewCanvasGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasGetUnauthenticatedResponseBody {
-	body := &CanvasGetUnauthenticatedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// CanvasArchiveUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasArchive" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasArchiveUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCanvasGetAccessDeniedResponseBody builds the HTTP response body from the
-// result of the "CanvasGet" endpoint of the "api" service.
-func NewCanvasGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasGetAccessDeniedResponseBody {
-	body := &CanvasGetAccessDeniedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// CanvasArchiveAccessDeniedResponseBody is the type of the "api" service
+// "CanvasArchive" endpoint HTTP response body for the "access_denied" error.
+type CanvasArchiveAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasArchiveNotFoundResponseBody is the type of the "api" service
+// "CanvasArchive" endpoint HTTP response body for the "not_found" error.
+type CanvasArchiveNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasResizeCanvasArchivedResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "canvas_archived" error.
+type CanvasResizeCanvasArchivedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasResizeUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasResizeUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasResizeAccessDeniedResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "access_denied" error.
+type CanvasResizeAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasResizeNotFoundResponseBody is the type of the "api" service
+// "CanvasResize" endpoint HTTP response body for the "not_found" error.
+type CanvasResizeNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ProtectedRegionCreateUnauthenticatedResponseBody is the type of the "api"
+// service "ProtectedRegionCreate" endpoint HTTP response body for the
+// "unauthenticated" error.
+type ProtectedRegionCreateUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ProtectedRegionCreateAccessDeniedResponseBody is the type of the "api"
+// service "ProtectedRegionCreate" endpoint HTTP response body for the
+// "access_denied" error.
+type ProtectedRegionCreateAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ProtectedRegionCreateNotFoundResponseBody is the type of the "api" service
+// "ProtectedRegionCreate" endpoint HTTP response body for the "not_found"
+// error.
+type ProtectedRegionCreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ProtectedRegionDeleteUnauthenticatedResponseBody is the type of the "api"
+// service "ProtectedRegionDelete" endpoint HTTP response body for the
+// "unauthenticated" error.
+type ProtectedRegionDeleteUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ProtectedRegionDeleteAccessDeniedResponseBody is the type of the "api"
+// service "ProtectedRegionDelete" endpoint HTTP response body for the
+// "access_denied" error.
+type ProtectedRegionDeleteAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ProtectedRegionDeleteNotFoundResponseBody is the type of the "api" service
+// "ProtectedRegionDelete" endpoint HTTP response body for the "not_found"
+// error.
+type ProtectedRegionDeleteNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRollbackCanvasArchivedResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "canvas_archived" error.
+type CanvasRollbackCanvasArchivedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRollbackUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasRollbackUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRollbackAccessDeniedResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "access_denied" error.
+type CanvasRollbackAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRollbackNotFoundResponseBody is the type of the "api" service
+// "CanvasRollback" endpoint HTTP response body for the "not_found" error.
+type CanvasRollbackNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AuditLogGetUnauthenticatedResponseBody is the type of the "api" service
+// "AuditLogGet" endpoint HTTP response body for the "unauthenticated" error.
+type AuditLogGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AuditLogGetAccessDeniedResponseBody is the type of the "api" service
+// "AuditLogGet" endpoint HTTP response body for the "access_denied" error.
+type AuditLogGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AuditLogGetNotFoundResponseBody is the type of the "api" service
+// "AuditLogGet" endpoint HTTP response body for the "not_found" error.
+type AuditLogGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasPixelsGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasPixelsGet" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasPixelsGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasPixelsGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasPixelsGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasPixelsGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasPixelsGetNotFoundResponseBody is the type of the "api" service
+// "CanvasPixelsGet" endpoint HTTP response body for the "not_found" error.
+type CanvasPixelsGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasChunksGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasChunksGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasChunksGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasChunksGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasChunksGetNotFoundResponseBody is the type of the "api" service
+// "CanvasChunksGet" endpoint HTTP response body for the "not_found" error.
+type CanvasChunksGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRegionGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasRegionGet" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasRegionGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRegionGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasRegionGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasRegionGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRegionGetNotFoundResponseBody is the type of the "api" service
+// "CanvasRegionGet" endpoint HTTP response body for the "not_found" error.
+type CanvasRegionGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasImageGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasImageGet" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasImageGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasImageGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasImageGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasImageGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasImageGetNotFoundResponseBody is the type of the "api" service
+// "CanvasImageGet" endpoint HTTP response body for the "not_found" error.
+type CanvasImageGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRegionImageGetUnauthenticatedResponseBody is the type of the "api"
+// service "CanvasRegionImageGet" endpoint HTTP response body for the
+// "unauthenticated" error.
+type CanvasRegionImageGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRegionImageGetAccessDeniedResponseBody is the type of the "api"
+// service "CanvasRegionImageGet" endpoint HTTP response body for the
+// "access_denied" error.
+type CanvasRegionImageGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasRegionImageGetNotFoundResponseBody is the type of the "api" service
+// "CanvasRegionImageGet" endpoint HTTP response body for the "not_found" error.
+type CanvasRegionImageGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasTileGetUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasTileGet" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasTileGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasTileGetAccessDeniedResponseBody is the type of the "api" service
+// "CanvasTileGet" endpoint HTTP response body for the "access_denied" error.
+type CanvasTileGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasTileGetNotFoundResponseBody is the type of the "api" service
+// "CanvasTileGet" endpoint HTTP response body for the "not_found" error.
+type CanvasTileGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasSubscribeUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body for the "unauthenticated"
+// error.
+type CanvasSubscribeUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasSubscribeAccessDeniedResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body for the "access_denied" error.
+type CanvasSubscribeAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasSubscribeNotFoundResponseBody is the type of the "api" service
+// "CanvasSubscribe" endpoint HTTP response body for the "not_found" error.
+type CanvasSubscribeNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasSessionUnauthenticatedResponseBody is the type of the "api" service
+// "CanvasSession" endpoint HTTP response body for the "unauthenticated" error.
+type CanvasSessionUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasSessionAccessDeniedResponseBody is the type of the "api" service
+// "CanvasSession" endpoint HTTP response body for the "access_denied" error.
+type CanvasSessionAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CanvasSessionNotFoundResponseBody is the type of the "api" service
+// "CanvasSession" endpoint HTTP response body for the "not_found" error.
+type CanvasSessionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PixelPlaceCooldownActiveResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "cooldown_active" error.
+type PixelPlaceCooldownActiveResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PixelPlaceCanvasArchivedResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "canvas_archived" error.
+type PixelPlaceCanvasArchivedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PixelPlaceUnauthenticatedResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "unauthenticated" error.
+type PixelPlaceUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PixelPlaceAccessDeniedResponseBody is the type of the "api" service
+// "PixelPlace" endpoint HTTP response body for the "access_denied" error.
+type PixelPlaceAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PixelPlaceNotFoundResponseBody is the type of the "api" service "PixelPlace"
+// endpoint HTTP response body for the "not_found" error.
+type PixelPlaceNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PixelInfoGetUnauthenticatedResponseBody is the type of the "api" service
+// "PixelInfoGet" endpoint HTTP response body for the "unauthenticated" error.
+type PixelInfoGetUnauthenticatedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PixelInfoGetAccessDeniedResponseBody is the type of the "api" service
+// "PixelInfoGet" endpoint HTTP response body for the "access_denied" error.
+type PixelInfoGetAccessDeniedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PixelInfoGetNotFoundResponseBody is the type of the "api" service
+// "PixelInfoGet" endpoint HTTP response body for the "not_found" error.
+type PixelInfoGetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ProtectedRegionResponseBody is used to define fields on response body types.
+type ProtectedRegionResponseBody struct {
+	ID        string `form:"id" json:"id" xml:"id"`
+	X         int32  `form:"x" json:"x" xml:"x"`
+	Y         int32  `form:"y" json:"y" xml:"y"`
+	Width     int32  `form:"width" json:"width" xml:"width"`
+	Height    int32  `form:"height" json:"height" xml:"height"`
+	Reason    string `form:"reason" json:"reason" xml:"reason"`
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Set if the region is only protected until then.
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// CanvasResponseBody is used to define fields on response body types.
+type CanvasResponseBody struct {
+	ID     string `form:"id" json:"id" xml:"id"`
+	Width  int32  `form:"width" json:"width" xml:"width"`
+	Height int32  `form:"height" json:"height" xml:"height"`
+	// Ordered list of colors, indexed by the color of each pixel.
+	Palette   []string `form:"palette" json:"palette" xml:"palette"`
+	CreatedAt string   `form:"created_at" json:"created_at" xml:"created_at"`
+	// Set once the canvas is archived, after which no more pixels can be placed on
+	// it.
+	ArchivedAt *string `form:"archived_at,omitempty" json:"archived_at,omitempty" xml:"archived_at,omitempty"`
+	// Incremented each time the canvas is resized.
+	Version int32 `form:"version" json:"version" xml:"version"`
+	// Regions of the canvas in which no pixels can be placed, in the order they
+	// were protected.
+	ProtectedRegions []*ProtectedRegionResponseBody `form:"protected_regions" json:"protected_regions" xml:"protected_regions"`
+}
+
+// AuditEntryResponseBody is used to define fields on response body types.
+type AuditEntryResponseBody struct {
+	Action string `form:"action" json:"action" xml:"action"`
+	// ID of the moderator who took the action.
+	UserID    string `form:"user_id" json:"user_id" xml:"user_id"`
+	Details   string `form:"details" json:"details" xml:"details"`
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// CanvasChunkResponseBody is used to define fields on response body types.
+type CanvasChunkResponseBody struct {
+	// X coordinate of the chunk, in chunks.
+	X int32 `form:"x" json:"x" xml:"x"`
+	// Y coordinate of the chunk, in chunks.
+	Y      int32 `form:"y" json:"y" xml:"y"`
+	Width  int32 `form:"width" json:"width" xml:"width"`
+	Height int32 `form:"height" json:"height" xml:"height"`
+	// Sequence number of the latest placement in the chunk.
+	Version int64 `form:"version" json:"version" xml:"version"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte `form:"pixels" json:"pixels" xml:"pixels"`
+}
+
+// PixelEventResponseBody is used to define fields on response body types.
+type PixelEventResponseBody struct {
+	X     int32 `json:"x"`
+	Y     int32 `json:"y"`
+	Color int32 `json:"color"`
+	// ID of the user who placed the pixel.
+	UserID   string `json:"user_id"`
+	PlacedAt string `json:"placed_at"`
+	// Sequence number of the placement on the canvas.
+	Seq int64 `json:"seq"`
+}
+
+// CanvasSnapshotResponseBody is used to define fields on response body types.
+type CanvasSnapshotResponseBody struct {
+	Seq    int64 `json:"seq"`
+	Width  int32 `json:"width"`
+	Height int32 `json:"height"`
+	// Row-major palette indices, one byte per pixel.
+	Pixels []byte `json:"pixels"`
+}
+
+// PlacementRejectionResponseBody is used to define fields on response body
+// types.
+type PlacementRejectionResponseBody struct {
+	// X coordinate of the placement, if it could be decoded.
+	X *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	// Y coordinate of the placement, if it could be decoded.
+	Y *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	// Name of the error, e.g. cooldown_active.
+	Name    string `form:"name" json:"name" xml:"name"`
+	Message string `form:"message" json:"message" xml:"message"`
+	// Number of seconds to wait before placing another pixel, if on cooldown.
+	RetryAfter *int32 `form:"retry_after,omitempty" json:"retry_after,omitempty" xml:"retry_after,omitempty"`
+}
+
+// PixelHistoryEntryResponseBody is used to define fields on response body
+// types.
+type PixelHistoryEntryResponseBody struct {
+	// ID of the user who placed the pixel.
+	UserID   string `form:"user_id" json:"user_id" xml:"user_id"`
+	Color    int32  `form:"color" json:"color" xml:"color"`
+	PlacedAt string `form:"placed_at" json:"placed_at" xml:"placed_at"`
+	// Sequence number of the placement on the canvas.
+	Seq int64 `form:"seq" json:"seq" xml:"seq"`
+}
+
+// PixelPlacementStreamingBody is used to define fields on request body types.
+type PixelPlacementStreamingBody struct {
+	X     *int32 `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	Y     *int32 `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+	Color *int32 `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
+}
+
+// NewCanvasCreateResponseBody builds the HTTP response body from the result of
+// the "CanvasCreate" endpoint of the "api" service.
+func NewCanvasCreateResponseBody(res *apiviews.CanvasView) *CanvasCreateResponseBody {
+	body := &CanvasCreateResponseBody{
+		ID:         *res.ID,
+		Width:      *res.Width,
+		Height:     *res.Height,
+		CreatedAt:  *res.CreatedAt,
+		ArchivedAt: res.ArchivedAt,
+		Version:    *res.Version,
+	}
+	if res.Palette != nil {
+		body.Palette = make([]string, len(res.Palette))
+		for i, val := range res.Palette {
+			body.Palette[i] = val
+		}
+	} else {
+		body.Palette = []string{}
+	}
+	if res.ProtectedRegions != nil {
+		body.ProtectedRegions = make([]*ProtectedRegionResponseBody, len(res.ProtectedRegions))
+		for i, val := range res.ProtectedRegions {
+			body.ProtectedRegions[i] = marshalApiviewsProtectedRegionViewToProtectedRegionResponseBody(val)
+		}
+	} else {
+		body.ProtectedRegions = []*ProtectedRegionResponseBody{}
+	}
+	return body
+}
+
+// NewCanvasListResponseBody builds the HTTP response body from the result of
+// the "CanvasList" endpoint of the "api" service.
+func NewCanvasListResponseBody(res *apiviews.CanvasesView) *CanvasListResponseBody {
+	body := &CanvasListResponseBody{}
+	if res.Canvases != nil {
+		body.Canvases = make([]*CanvasResponseBody, len(res.Canvases))
+		for i, val := range res.Canvases {
+			body.Canvases[i] = marshalApiviewsCanvasViewToCanvasResponseBody(val)
+		}
+	} else {
+		body.Canvases = []*CanvasResponseBody{}
+	}
+	return body
+}
+
+// NewCanvasGetResponseBody builds the HTTP response body from the result of
+// the "CanvasGet" endpoint of the "api" service.
+func NewCanvasGetResponseBody(res *apiviews.CanvasView) *CanvasGetResponseBody {
+	body := &CanvasGetResponseBody{
+		ID:         *res.ID,
+		Width:      *res.Width,
+		Height:     *res.Height,
+		CreatedAt:  *res.CreatedAt,
+		ArchivedAt: res.ArchivedAt,
+		Version:    *res.Version,
+	}
+	if res.Palette != nil {
+		body.Palette = make([]string, len(res.Palette))
+		for i, val := range res.Palette {
+			body.Palette[i] = val
+		}
+	} else {
+		body.Palette = []string{}
+	}
+	if res.ProtectedRegions != nil {
+		body.ProtectedRegions = make([]*ProtectedRegionResponseBody, len(res.ProtectedRegions))
+		for i, val := range res.ProtectedRegions {
+			body.ProtectedRegions[i] = marshalApiviewsProtectedRegionViewToProtectedRegionResponseBody(val)
+		}
+	} else {
+		body.ProtectedRegions = []*ProtectedRegionResponseBody{}
+	}
+	return body
+}
+
+// NewCanvasArchiveResponseBody builds the HTTP response body from the result
+// of the "CanvasArchive" endpoint of the "api" service.
+func NewCanvasArchiveResponseBody(res *apiviews.CanvasView) *CanvasArchiveResponseBody {
+	body := &CanvasArchiveResponseBody{
+		ID:         *res.ID,
+		Width:      *res.Width,
+		Height:     *res.Height,
+		CreatedAt:  *res.CreatedAt,
+		ArchivedAt: res.ArchivedAt,
+		Version:    *res.Version,
+	}
+	if res.Palette != nil {
+		body.Palette = make([]string, len(res.Palette))
+		for i, val := range res.Palette {
+			body.Palette[i] = val
+		}
+	} else {
+		body.Palette = []string{}
+	}
+	if res.ProtectedRegions != nil {
+		body.ProtectedRegions = make([]*ProtectedRegionResponseBody, len(res.ProtectedRegions))
+		for i, val := range res.ProtectedRegions {
+			body.ProtectedRegions[i] = marshalApiviewsProtectedRegionViewToProtectedRegionResponseBody(val)
+		}
+	} else {
+		body.ProtectedRegions = []*ProtectedRegionResponseBody{}
+	}
+	return body
+}
+
+// NewCanvasResizeResponseBody builds the HTTP response body from the result of
+// the "CanvasResize" endpoint of the "api" service.
+func NewCanvasResizeResponseBody(res *apiviews.CanvasView) *CanvasResizeResponseBody {
+	body := &CanvasResizeResponseBody{
+		ID:         *res.ID,
+		Width:      *res.Width,
+		Height:     *res.Height,
+		CreatedAt:  *res.CreatedAt,
+		ArchivedAt: res.ArchivedAt,
+		Version:    *res.Version,
+	}
+	if res.Palette != nil {
+		body.Palette = make([]string, len(res.Palette))
+		for i, val := range res.Palette {
+			body.Palette[i] = val
+		}
+	} else {
+		body.Palette = []string{}
+	}
+	if res.ProtectedRegions != nil {
+		body.ProtectedRegions = make([]*ProtectedRegionResponseBody, len(res.ProtectedRegions))
+		for i, val := range res.ProtectedRegions {
+			body.ProtectedRegions[i] = marshalApiviewsProtectedRegionViewToProtectedRegionResponseBody(val)
+		}
+	} else {
+		body.ProtectedRegions = []*ProtectedRegionResponseBody{}
+	}
+	return body
+}
+
+// NewProtectedRegionCreateResponseBody builds the HTTP response body from the
+// result of the "ProtectedRegionCreate" endpoint of the "api" service.
+func NewProtectedRegionCreateResponseBody(res *apiviews.ProtectedRegionView) *ProtectedRegionCreateResponseBody {
+	body := &ProtectedRegionCreateResponseBody{
+		ID:        *res.ID,
+		X:         *res.X,
+		Y:         *res.Y,
+		Width:     *res.Width,
+		Height:    *res.Height,
+		Reason:    *res.Reason,
+		CreatedAt: *res.CreatedAt,
+		ExpiresAt: res.ExpiresAt,
+	}
+	return body
+}
+
+// NewCanvasRollbackResponseBody builds the HTTP response body from the result
+// of the "CanvasRollback" endpoint of the "api" service.
+func NewCanvasRollbackResponseBody(res *apiviews.RollbackView) *CanvasRollbackResponseBody {
+	body := &CanvasRollbackResponseBody{
+		Reverted: *res.Reverted,
+	}
+	return body
+}
+
+// NewAuditLogGetResponseBody builds the HTTP response body from the result of
+// the "AuditLogGet" endpoint of the "api" service.
+func NewAuditLogGetResponseBody(res *apiviews.AuditLogView) *AuditLogGetResponseBody {
+	body := &AuditLogGetResponseBody{}
+	if res.Entries != nil {
+		body.Entries = make([]*AuditEntryResponseBody, len(res.Entries))
+		for i, val := range res.Entries {
+			body.Entries[i] = marshalApiviewsAuditEntryViewToAuditEntryResponseBody(val)
+		}
+	} else {
+		body.Entries = []*AuditEntryResponseBody{}
+	}
+	return body
+}
+
+// NewCanvasChunksGetResponseBody builds the HTTP response body from the result
+// of the "CanvasChunksGet" endpoint of the "api" service.
+func NewCanvasChunksGetResponseBody(res *apiviews.CanvasChunksView) *CanvasChunksGetResponseBody {
+	body := &CanvasChunksGetResponseBody{
+		Version:   *res.Version,
+		ChunkSize: *res.ChunkSize,
+	}
+	if res.Chunks != nil {
+		body.Chunks = make([]*CanvasChunkResponseBody, len(res.Chunks))
+		for i, val := range res.Chunks {
+			body.Chunks[i] = marshalApiviewsCanvasChunkViewToCanvasChunkResponseBody(val)
+		}
+	} else {
+		body.Chunks = []*CanvasChunkResponseBody{}
+	}
+	return body
+}
+
+// NewCanvasSubscribeResponseBody builds the HTTP response body from the result
+// of the "CanvasSubscribe" endpoint of the "api" service.
+func NewCanvasSubscribeResponseBody(res *api.CanvasEvent) *CanvasSubscribeResponseBody {
+	body := &CanvasSubscribeResponseBody{
+		ID:   res.ID,
+		Type: res.Type,
+	}
+	if res.Pixel != nil {
+		body.Pixel = marshalAPIPixelEventToPixelEventResponseBody(res.Pixel)
+	}
+	if res.Snapshot != nil {
+		body.Snapshot = marshalAPICanvasSnapshotToCanvasSnapshotResponseBody(res.Snapshot)
+	}
+	return body
+}
+
+// NewCanvasSessionResponseBody builds the HTTP response body from the result
+// of the "CanvasSession" endpoint of the "api" service.
+func NewCanvasSessionResponseBody(res *api.SessionEvent) *CanvasSessionResponseBody {
+	body := &CanvasSessionResponseBody{}
+	if res.Canvas != nil {
+		body.Canvas = marshalAPICanvasToCanvasResponseBody(res.Canvas)
+	}
+	if res.Pixel != nil {
+		body.Pixel = marshalAPIPixelEventToPixelEventResponseBody(res.Pixel)
+	}
+	if res.Rejection != nil {
+		body.Rejection = marshalAPIPlacementRejectionToPlacementRejectionResponseBody(res.Rejection)
+	}
+	return body
+}
+
+// NewPixelPlaceResponseBody builds the HTTP response body from the result of
+// the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceResponseBody(res *apiviews.PixelView) *PixelPlaceResponseBody {
+	body := &PixelPlaceResponseBody{
+		X:     *res.X,
+		Y:     *res.Y,
+		Color: *res.Color,
+	}
+	return body
+}
+
+// NewPixelInfoGetResponseBody builds the HTTP response body from the result of
+// the "PixelInfoGet" endpoint of the "api" service.
+func NewPixelInfoGetResponseBody(res *apiviews.PixelInfoView) *PixelInfoGetResponseBody {
+	body := &PixelInfoGetResponseBody{
+		X: *res.X,
+		Y: *res.Y,
+	}
+	if res.Placements != nil {
+		body.Placements = make([]*PixelHistoryEntryResponseBody, len(res.Placements))
+		for i, val := range res.Placements {
+			body.Placements[i] = marshalApiviewsPixelHistoryEntryViewToPixelHistoryEntryResponseBody(val)
+		}
+	} else {
+		body.Placements = []*PixelHistoryEntryResponseBody{}
+	}
+	return body
+}
+
+// NewCanvasCreateUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasCreate" endpoint of the "api" service.
+func NewCanvasCreateUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasCreateUnauthenticatedResponseBody {
+	body := &CanvasCreateUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasCreateAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "CanvasCreate" endpoint of the "api" service.
+func NewCanvasCreateAccessDeniedResponseBody(res *goa.ServiceError) *CanvasCreateAccessDeniedResponseBody {
+	body := &CanvasCreateAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasCreateNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasCreate" endpoint of the "api" service.
+func NewCanvasCreateNotFoundResponseBody(res *goa.ServiceError) *CanvasCreateNotFoundResponseBody {
+	body := &CanvasCreateNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasListUnauthenticatedResponseBody builds the HTTP response body from
+// the result of the "CanvasList" endpoint of the "api" service.
+func NewCanvasListUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasListUnauthenticatedResponseBody {
+	body := &CanvasListUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasListAccessDeniedResponseBody builds the HTTP response body from the
+// result of the "CanvasList" endpoint of the "api" service.
+func NewCanvasListAccessDeniedResponseBody(res *goa.ServiceError) *CanvasListAccessDeniedResponseBody {
+	body := &CanvasListAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasListNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasList" endpoint of the "api" service.
+func NewCanvasListNotFoundResponseBody(res *goa.ServiceError) *CanvasListNotFoundResponseBody {
+	body := &CanvasListNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasGetUnauthenticatedResponseBody builds the HTTP response body from
+// the result of the "CanvasGet" endpoint of the "api" service.
+func NewCanvasGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasGetUnauthenticatedResponseBody {
+	body := &CanvasGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasGetAccessDeniedResponseBody builds the HTTP response body from the
+// result of the "CanvasGet" endpoint of the "api" service.
+func NewCanvasGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasGetAccessDeniedResponseBody {
+	body := &CanvasGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasGetNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasGet" endpoint of the "api" service.
+func NewCanvasGetNotFoundResponseBody(res *goa.ServiceError) *CanvasGetNotFoundResponseBody {
+	body := &CanvasGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasArchiveUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasArchive" endpoint of the "api" service.
+func NewCanvasArchiveUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasArchiveUnauthenticatedResponseBody {
+	body := &CanvasArchiveUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasArchiveAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "CanvasArchive" endpoint of the "api" service.
+func NewCanvasArchiveAccessDeniedResponseBody(res *goa.ServiceError) *CanvasArchiveAccessDeniedResponseBody {
+	body := &CanvasArchiveAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasArchiveNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasArchive" endpoint of the "api" service.
+func NewCanvasArchiveNotFoundResponseBody(res *goa.ServiceError) *CanvasArchiveNotFoundResponseBody {
+	body := &CanvasArchiveNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasResizeCanvasArchivedResponseBody builds the HTTP response body from
+// the result of the "CanvasResize" endpoint of the "api" service.
+func NewCanvasResizeCanvasArchivedResponseBody(res *goa.ServiceError) *CanvasResizeCanvasArchivedResponseBody {
+	body := &CanvasResizeCanvasArchivedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasResizeUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasResize" endpoint of the "api" service.
+func NewCanvasResizeUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasResizeUnauthenticatedResponseBody {
+	body := &CanvasResizeUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasResizeAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "CanvasResize" endpoint of the "api" service.
+func NewCanvasResizeAccessDeniedResponseBody(res *goa.ServiceError) *CanvasResizeAccessDeniedResponseBody {
+	body := &CanvasResizeAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasResizeNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasResize" endpoint of the "api" service.
+func NewCanvasResizeNotFoundResponseBody(res *goa.ServiceError) *CanvasResizeNotFoundResponseBody {
+	body := &CanvasResizeNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewProtectedRegionCreateUnauthenticatedResponseBody builds the HTTP response
+// body from the result of the "ProtectedRegionCreate" endpoint of the "api"
+// service.
+func NewProtectedRegionCreateUnauthenticatedResponseBody(res *goa.ServiceError) *ProtectedRegionCreateUnauthenticatedResponseBody {
+	body := &ProtectedRegionCreateUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewProtectedRegionCreateAccessDeniedResponseBody builds the HTTP response
+// body from the result of the "ProtectedRegionCreate" endpoint of the "api"
+// service.
+func NewProtectedRegionCreateAccessDeniedResponseBody(res *goa.ServiceError) *ProtectedRegionCreateAccessDeniedResponseBody {
+	body := &ProtectedRegionCreateAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewProtectedRegionCreateNotFoundResponseBody builds the HTTP response body
+// from the result of the "ProtectedRegionCreate" endpoint of the "api" service.
+func NewProtectedRegionCreateNotFoundResponseBody(res *goa.ServiceError) *ProtectedRegionCreateNotFoundResponseBody {
+	body := &ProtectedRegionCreateNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewProtectedRegionDeleteUnauthenticatedResponseBody builds the HTTP response
+// body from the result of the "ProtectedRegionDelete" endpoint of the "api"
+// service.
+func NewProtectedRegionDeleteUnauthenticatedResponseBody(res *goa.ServiceError) *ProtectedRegionDeleteUnauthenticatedResponseBody {
+	body := &ProtectedRegionDeleteUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewProtectedRegionDeleteAccessDeniedResponseBody builds the HTTP response
+// body from the result of the "ProtectedRegionDelete" endpoint of the "api"
+// service.
+func NewProtectedRegionDeleteAccessDeniedResponseBody(res *goa.ServiceError) *ProtectedRegionDeleteAccessDeniedResponseBody {
+	body := &ProtectedRegionDeleteAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewProtectedRegionDeleteNotFoundResponseBody builds the HTTP response body
+// from the result of the "ProtectedRegionDelete" endpoint of the "api" service.
+func NewProtectedRegionDeleteNotFoundResponseBody(res *goa.ServiceError) *ProtectedRegionDeleteNotFoundResponseBody {
+	body := &ProtectedRegionDeleteNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRollbackCanvasArchivedResponseBody builds the HTTP response body
+// from the result of the "CanvasRollback" endpoint of the "api" service.
+func NewCanvasRollbackCanvasArchivedResponseBody(res *goa.ServiceError) *CanvasRollbackCanvasArchivedResponseBody {
+	body := &CanvasRollbackCanvasArchivedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRollbackUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasRollback" endpoint of the "api" service.
+func NewCanvasRollbackUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasRollbackUnauthenticatedResponseBody {
+	body := &CanvasRollbackUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRollbackAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "CanvasRollback" endpoint of the "api" service.
+func NewCanvasRollbackAccessDeniedResponseBody(res *goa.ServiceError) *CanvasRollbackAccessDeniedResponseBody {
+	body := &CanvasRollbackAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRollbackNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasRollback" endpoint of the "api" service.
+func NewCanvasRollbackNotFoundResponseBody(res *goa.ServiceError) *CanvasRollbackNotFoundResponseBody {
+	body := &CanvasRollbackNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAuditLogGetUnauthenticatedResponseBody builds the HTTP response body from
+// the result of the "AuditLogGet" endpoint of the "api" service.
+func NewAuditLogGetUnauthenticatedResponseBody(res *goa.ServiceError) *AuditLogGetUnauthenticatedResponseBody {
+	body := &AuditLogGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAuditLogGetAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "AuditLogGet" endpoint of the "api" service.
+func NewAuditLogGetAccessDeniedResponseBody(res *goa.ServiceError) *AuditLogGetAccessDeniedResponseBody {
+	body := &AuditLogGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAuditLogGetNotFoundResponseBody builds the HTTP response body from the
+// result of the "AuditLogGet" endpoint of the "api" service.
+func NewAuditLogGetNotFoundResponseBody(res *goa.ServiceError) *AuditLogGetNotFoundResponseBody {
+	body := &AuditLogGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasPixelsGetUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasPixelsGet" endpoint of the "api" service.
+func NewCanvasPixelsGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasPixelsGetUnauthenticatedResponseBody {
+	body := &CanvasPixelsGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasPixelsGetAccessDeniedResponseBody builds the HTTP response body
+// from the result of the "CanvasPixelsGet" endpoint of the "api" service.
+func NewCanvasPixelsGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasPixelsGetAccessDeniedResponseBody {
+	body := &CanvasPixelsGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasPixelsGetNotFoundResponseBody builds the HTTP response body from
+// the result of the "CanvasPixelsGet" endpoint of the "api" service.
+func NewCanvasPixelsGetNotFoundResponseBody(res *goa.ServiceError) *CanvasPixelsGetNotFoundResponseBody {
+	body := &CanvasPixelsGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasChunksGetUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasChunksGet" endpoint of the "api" service.
+func NewCanvasChunksGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasChunksGetUnauthenticatedResponseBody {
+	body := &CanvasChunksGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasChunksGetAccessDeniedResponseBody builds the HTTP response body
+// from the result of the "CanvasChunksGet" endpoint of the "api" service.
+func NewCanvasChunksGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasChunksGetAccessDeniedResponseBody {
+	body := &CanvasChunksGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasChunksGetNotFoundResponseBody builds the HTTP response body from
+// the result of the "CanvasChunksGet" endpoint of the "api" service.
+func NewCanvasChunksGetNotFoundResponseBody(res *goa.ServiceError) *CanvasChunksGetNotFoundResponseBody {
+	body := &CanvasChunksGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRegionGetUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasRegionGet" endpoint of the "api" service.
+func NewCanvasRegionGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasRegionGetUnauthenticatedResponseBody {
+	body := &CanvasRegionGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRegionGetAccessDeniedResponseBody builds the HTTP response body
+// from the result of the "CanvasRegionGet" endpoint of the "api" service.
+func NewCanvasRegionGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasRegionGetAccessDeniedResponseBody {
+	body := &CanvasRegionGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRegionGetNotFoundResponseBody builds the HTTP response body from
+// the result of the "CanvasRegionGet" endpoint of the "api" service.
+func NewCanvasRegionGetNotFoundResponseBody(res *goa.ServiceError) *CanvasRegionGetNotFoundResponseBody {
+	body := &CanvasRegionGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasImageGetUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasImageGet" endpoint of the "api" service.
+func NewCanvasImageGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasImageGetUnauthenticatedResponseBody {
+	body := &CanvasImageGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasImageGetAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "CanvasImageGet" endpoint of the "api" service.
+func NewCanvasImageGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasImageGetAccessDeniedResponseBody {
+	body := &CanvasImageGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasImageGetNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasImageGet" endpoint of the "api" service.
+func NewCanvasImageGetNotFoundResponseBody(res *goa.ServiceError) *CanvasImageGetNotFoundResponseBody {
+	body := &CanvasImageGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRegionImageGetUnauthenticatedResponseBody builds the HTTP response
+// body from the result of the "CanvasRegionImageGet" endpoint of the "api"
+// service.
+func NewCanvasRegionImageGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasRegionImageGetUnauthenticatedResponseBody {
+	body := &CanvasRegionImageGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRegionImageGetAccessDeniedResponseBody builds the HTTP response
+// body from the result of the "CanvasRegionImageGet" endpoint of the "api"
+// service.
+func NewCanvasRegionImageGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasRegionImageGetAccessDeniedResponseBody {
+	body := &CanvasRegionImageGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasRegionImageGetNotFoundResponseBody builds the HTTP response body
+// from the result of the "CanvasRegionImageGet" endpoint of the "api" service.
+func NewCanvasRegionImageGetNotFoundResponseBody(res *goa.ServiceError) *CanvasRegionImageGetNotFoundResponseBody {
+	body := &CanvasRegionImageGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasTileGetUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasTileGet" endpoint of the "api" service.
+func NewCanvasTileGetUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasTileGetUnauthenticatedResponseBody {
+	body := &CanvasTileGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasTileGetAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "CanvasTileGet" endpoint of the "api" service.
+func NewCanvasTileGetAccessDeniedResponseBody(res *goa.ServiceError) *CanvasTileGetAccessDeniedResponseBody {
+	body := &CanvasTileGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasTileGetNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasTileGet" endpoint of the "api" service.
+func NewCanvasTileGetNotFoundResponseBody(res *goa.ServiceError) *CanvasTileGetNotFoundResponseBody {
+	body := &CanvasTileGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasSubscribeUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasSubscribe" endpoint of the "api" service.
+func NewCanvasSubscribeUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasSubscribeUnauthenticatedResponseBody {
+	body := &CanvasSubscribeUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasSubscribeAccessDeniedResponseBody builds the HTTP response body
+// from the result of the "CanvasSubscribe" endpoint of the "api" service.
+func NewCanvasSubscribeAccessDeniedResponseBody(res *goa.ServiceError) *CanvasSubscribeAccessDeniedResponseBody {
+	body := &CanvasSubscribeAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasSubscribeNotFoundResponseBody builds the HTTP response body from
+// the result of the "CanvasSubscribe" endpoint of the "api" service.
+func NewCanvasSubscribeNotFoundResponseBody(res *goa.ServiceError) *CanvasSubscribeNotFoundResponseBody {
+	body := &CanvasSubscribeNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasSessionUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "CanvasSession" endpoint of the "api" service.
+func NewCanvasSessionUnauthenticatedResponseBody(res *goa.ServiceError) *CanvasSessionUnauthenticatedResponseBody {
+	body := &CanvasSessionUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasSessionAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "CanvasSession" endpoint of the "api" service.
+func NewCanvasSessionAccessDeniedResponseBody(res *goa.ServiceError) *CanvasSessionAccessDeniedResponseBody {
+	body := &CanvasSessionAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasSessionNotFoundResponseBody builds the HTTP response body from the
+// result of the "CanvasSession" endpoint of the "api" service.
+func NewCanvasSessionNotFoundResponseBody(res *goa.ServiceError) *CanvasSessionNotFoundResponseBody {
+	body := &CanvasSessionNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPixelPlaceCooldownActiveResponseBody builds the HTTP response body from
+// the result of the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceCooldownActiveResponseBody(res *api.CooldownError) *PixelPlaceCooldownActiveResponseBody {
+	body := &PixelPlaceCooldownActiveResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPixelPlaceCanvasArchivedResponseBody builds the HTTP response body from
+// the result of the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceCanvasArchivedResponseBody(res *goa.ServiceError) *PixelPlaceCanvasArchivedResponseBody {
+	body := &PixelPlaceCanvasArchivedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPixelPlaceUnauthenticatedResponseBody builds the HTTP response body from
+// the result of the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceUnauthenticatedResponseBody(res *goa.ServiceError) *PixelPlaceUnauthenticatedResponseBody {
+	body := &PixelPlaceUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPixelPlaceAccessDeniedResponseBody builds the HTTP response body from the
+// result of the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceAccessDeniedResponseBody(res *goa.ServiceError) *PixelPlaceAccessDeniedResponseBody {
+	body := &PixelPlaceAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPixelPlaceNotFoundResponseBody builds the HTTP response body from the
+// result of the "PixelPlace" endpoint of the "api" service.
+func NewPixelPlaceNotFoundResponseBody(res *goa.ServiceError) *PixelPlaceNotFoundResponseBody {
+	body := &PixelPlaceNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPixelInfoGetUnauthenticatedResponseBody builds the HTTP response body
+// from the result of the "PixelInfoGet" endpoint of the "api" service.
+func NewPixelInfoGetUnauthenticatedResponseBody(res *goa.ServiceError) *PixelInfoGetUnauthenticatedResponseBody {
+	body := &PixelInfoGetUnauthenticatedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPixelInfoGetAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "PixelInfoGet" endpoint of the "api" service.
+func NewPixelInfoGetAccessDeniedResponseBody(res *goa.ServiceError) *PixelInfoGetAccessDeniedResponseBody {
+	body := &PixelInfoGetAccessDeniedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPixelInfoGetNotFoundResponseBody builds the HTTP response body from the
+// result of the "PixelInfoGet" endpoint of the "api" service.
+func NewPixelInfoGetNotFoundResponseBody(res *goa.ServiceError) *PixelInfoGetNotFoundResponseBody {
+	body := &PixelInfoGetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCanvasCreatePayload builds a api service CanvasCreate endpoint payload.
+func NewCanvasCreatePayload(body *CanvasCreateRequestBody, token string) *api.CanvasCreatePayload {
+	v := &api.CanvasCreatePayload{
+		Width:  *body.Width,
+		Height: *body.Height,
+	}
+	if body.Palette != nil {
+		v.Palette = make([]string, len(body.Palette))
+		for i, val := range body.Palette {
+			v.Palette[i] = val
+		}
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewCanvasListPayload builds a api service CanvasList endpoint payload.
+func NewCanvasListPayload(includeArchived bool) *api.CanvasListPayload {
+	v := &api.CanvasListPayload{}
+	v.IncludeArchived = includeArchived
+
+	return v
+}
+
+// NewCanvasGetPayload builds a api service CanvasGet endpoint payload.
+func NewCanvasGetPayload(id string) *api.CanvasGetPayload {
+	v := &api.CanvasGetPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewCanvasArchivePayload builds a api service CanvasArchive endpoint payload.
+func NewCanvasArchivePayload(id string, token string) *api.CanvasArchivePayload {
+	v := &api.CanvasArchivePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewCanvasResizePayload builds a api service CanvasResize endpoint payload.
+func NewCanvasResizePayload(body *CanvasResizeRequestBody, id string, token string) *api.CanvasResizePayload {
+	v := &api.CanvasResizePayload{}
+	if body.Left != nil {
+		v.Left = *body.Left
+	}
+	if body.Top != nil {
+		v.Top = *body.Top
+	}
+	if body.Right != nil {
+		v.Right = *body.Right
+	}
+	if body.Bottom != nil {
+		v.Bottom = *body.Bottom
+	}
+	if body.Fill != nil {
+		v.Fill = *body.Fill
+	}
+	if body.Left == nil {
+		v.Left = 0
+	}
+	if body.Top == nil {
+		v.Top = 0
+	}
+	if body.Right == nil {
+		v.Right = 0
+	}
+	if body.Bottom == nil {
+		v.Bottom = 0
+	}
+	if body.Fill == nil {
+		v.Fill = 0
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewProtectedRegionCreatePayload builds a api service ProtectedRegionCreate
+// endpoint payload.
+func NewProtectedRegionCreatePayload(body *ProtectedRegionCreateRequestBody, id string, token string) *api.ProtectedRegionCreatePayload {
+	v := &api.ProtectedRegionCreatePayload{
+		X:        *body.X,
+		Y:        *body.Y,
+		Width:    *body.Width,
+		Height:   *body.Height,
+		Reason:   body.Reason,
+		Duration: body.Duration,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewProtectedRegionDeletePayload builds a api service ProtectedRegionDelete
+// endpoint payload.
+func NewProtectedRegionDeletePayload(id string, regionID string, token string) *api.ProtectedRegionDeletePayload {
+	v := &api.ProtectedRegionDeletePayload{}
+	v.ID = id
+	v.RegionID = regionID
+	v.Token = token
+
+	return v
+}
+
+// NewCanvasRollbackPayload builds a api service CanvasRollback endpoint
+// payload.
+func NewCanvasRollbackPayload(body *CanvasRollbackRequestBody, id string, token string) *api.CanvasRollbackPayload {
+	v := &api.CanvasRollbackPayload{
+		UserID: body.UserID,
+		X:      body.X,
+		Y:      body.Y,
+		Width:  body.Width,
+		Height: body.Height,
+		From:   body.From,
+		To:     body.To,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewAuditLogGetPayload builds a api service AuditLogGet endpoint payload.
+func NewAuditLogGetPayload(id string, limit int32, token string) *api.AuditLogGetPayload {
+	v := &api.AuditLogGetPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
+// NewCanvasPixelsGetPayload builds a api service CanvasPixelsGet endpoint
+// payload.
+func NewCanvasPixelsGetPayload(id string) *api.CanvasPixelsGetPayload {
+	v := &api.CanvasPixelsGetPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewCanvasChunksGetPayload builds a api service CanvasChunksGet endpoint
+// payload.
+func NewCanvasChunksGetPayload(id string, since int64) *api.CanvasChunksGetPayload {
+	v := &api.CanvasChunksGetPayload{}
+	v.ID = id
+	v.Since = since
+
+	return v
+}
+
+// NewCanvasRegionGetPayload builds a api service CanvasRegionGet endpoint
+// payload.
+func NewCanvasRegionGetPayload(id string, x int32, y int32, width int32, height int32) *api.CanvasRegionGetPayload {
+	v := &api.CanvasRegionGetPayload{}
+	v.ID = id
+	v.X = x
+	v.Y = y
+	v.Width = width
+	v.Height = height
+
+	return v
+}
+
+// NewCanvasImageGetPayload builds a api service CanvasImageGet endpoint
+// payload.
+func NewCanvasImageGetPayload(id string, scale int32) *api.CanvasImageGetPayload {
+	v := &api.CanvasImageGetPayload{}
+	v.ID = id
+	v.Scale = scale
+
+	return v
+}
+
+// NewCanvasRegionImageGetPayload builds a api service CanvasRegionImageGet
+// endpoint payload.
+func NewCanvasRegionImageGetPayload(id string, x int32, y int32, width int32, height int32, scale int32) *api.CanvasRegionImageGetPayload {
+	v := &api.CanvasRegionImageGetPayload{}
+	v.ID = id
+	v.X = x
+	v.Y = y
+	v.Width = width
+	v.Height = height
+	v.Scale = scale
+
+	return v
+}
+
+// NewCanvasTileGetPayload builds a api service CanvasTileGet endpoint payload.
+func NewCanvasTileGetPayload(id string, z int32, x int32, y int32, ifNoneMatch *string) *api.CanvasTileGetPayload {
+	v := &api.CanvasTileGetPayload{}
+	v.ID = id
+	v.Z = z
+	v.X = x
+	v.Y = y
+	v.IfNoneMatch = ifNoneMatch
+
+	return v
+}
+
+// NewCanvasSubscribePayload builds a api service CanvasSubscribe endpoint
+// payload.
+func NewCanvasSubscribePayload(id string, since *int64, lastEventID *string) *api.CanvasSubscribePayload {
+	v := &api.CanvasSubscribePayload{}
+	v.ID = id
+	v.Since = since
+	v.LastEventID = lastEventID
+
+	return v
+}
+
+// NewCanvasSessionPayload builds a api service CanvasSession endpoint payload.
+func NewCanvasSessionPayload(id string, token string) *api.CanvasSessionPayload {
+	v := &api.CanvasSessionPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewCanvasSessionStreamingBody builds a api service CanvasSession endpoint
+// payload.
+func NewCanvasSessionStreamingBody(body *CanvasSessionStreamingBody) *api.PixelPlacement {
+	v := &api.PixelPlacement{
+		X:     *body.X,
+		Y:     *body.Y,
+		Color: *body.Color,
+	}
+
+	return v
+}
+
+// NewPixelPlacePayload builds a api service PixelPlace endpoint payload.
+func NewPixelPlacePayload(body *PixelPlaceRequestBody, id string, token string) *api.PixelPlacePayload {
+	v := &api.PixelPlacePayload{
+		X:     *body.X,
+		Y:     *body.Y,
+		Color: *body.Color,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewPixelInfoGetPayload builds a api service PixelInfoGet endpoint payload.
+func NewPixelInfoGetPayload(id string, x int32, y int32, limit int32) *api.PixelInfoGetPayload {
+	v := &api.PixelInfoGetPayload{}
+	v.ID = id
+	v.X = x
+	v.Y = y
+	v.Limit = limit
+
+	return v
+}
+
+// ValidateCanvasCreateRequestBody runs the validations defined on
+// CanvasCreateRequestBody
+func ValidateCanvasCreateRequestBody(body *CanvasCreateRequestBody) (err error) {
+	if body.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "body"))
+	}
+	if body.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "body"))
+	}
+	if body.Width != nil {
+		if *body.Width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 1, true))
+		}
+	}
+	if body.Width != nil {
+		if *body.Width > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 2048, false))
+		}
+	}
+	if body.Height != nil {
+		if *body.Height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 1, true))
+		}
+	}
+	if body.Height != nil {
+		if *body.Height > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 2048, false))
+		}
+	}
+	if len(body.Palette) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.palette", body.Palette, len(body.Palette), 256, false))
+	}
+	for _, e := range body.Palette {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.palette[*]", e, "^#[0-9A-Fa-f]{6}$"))
+	}
+	return
+}
+
+// ValidateCanvasResizeRequestBody runs the validations defined on
+// CanvasResizeRequestBody
+func ValidateCanvasResizeRequestBody(body *CanvasResizeRequestBody) (err error) {
+	if body.Left != nil {
+		if *body.Left < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.left", *body.Left, 0, true))
+		}
+	}
+	if body.Left != nil {
+		if *body.Left > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.left", *body.Left, 2048, false))
+		}
+	}
+	if body.Top != nil {
+		if *body.Top < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top", *body.Top, 0, true))
+		}
+	}
+	if body.Top != nil {
+		if *body.Top > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.top", *body.Top, 2048, false))
+		}
+	}
+	if body.Right != nil {
+		if *body.Right < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.right", *body.Right, 0, true))
+		}
+	}
+	if body.Right != nil {
+		if *body.Right > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.right", *body.Right, 2048, false))
+		}
+	}
+	if body.Bottom != nil {
+		if *body.Bottom < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.bottom", *body.Bottom, 0, true))
+		}
+	}
+	if body.Bottom != nil {
+		if *body.Bottom > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.bottom", *body.Bottom, 2048, false))
+		}
+	}
+	if body.Fill != nil {
+		if *body.Fill < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.fill", *body.Fill, 0, true))
+		}
+	}
+	if body.Fill != nil {
+		if *body.Fill > 255 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.fill", *body.Fill, 255, false))
+		}
+	}
+	return
+}
+
+// ValidateProtectedRegionCreateRequestBody runs the validations defined on
+// ProtectedRegionCreateRequestBody
+func ValidateProtectedRegionCreateRequestBody(body *ProtectedRegionCreateRequestBody) (err error) {
+	if body.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "body"))
+	}
+	if body.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "body"))
+	}
+	if body.Width == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("width", "body"))
+	}
+	if body.Height == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("height", "body"))
+	}
+	if body.X != nil {
+		if *body.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", *body.X, 0, true))
+		}
+	}
+	if body.Y != nil {
+		if *body.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", *body.Y, 0, true))
+		}
+	}
+	if body.Width != nil {
+		if *body.Width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 1, true))
+		}
+	}
+	if body.Width != nil {
+		if *body.Width > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 2048, false))
+		}
+	}
+	if body.Height != nil {
+		if *body.Height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 1, true))
+		}
+	}
+	if body.Height != nil {
+		if *body.Height > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 2048, false))
+		}
+	}
+	if body.Reason != nil {
+		if utf8.RuneCountInString(*body.Reason) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 200, false))
+		}
+	}
+	if body.Duration != nil {
+		if *body.Duration < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 1, true))
+		}
+	}
+	return
+}
+
+// ValidateCanvasRollbackRequestBody runs the validations defined on
+// CanvasRollbackRequestBody
+func ValidateCanvasRollbackRequestBody(body *CanvasRollbackRequestBody) (err error) {
+	if body.X != nil {
+		if *body.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", *body.X, 0, true))
+		}
+	}
+	if body.Y != nil {
+		if *body.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", *body.Y, 0, true))
+		}
+	}
+	if body.Width != nil {
+		if *body.Width < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 1, true))
+		}
+	}
+	if body.Width != nil {
+		if *body.Width > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.width", *body.Width, 2048, false))
+		}
+	}
+	if body.Height != nil {
+		if *body.Height < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 1, true))
+		}
+	}
+	if body.Height != nil {
+		if *body.Height > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.height", *body.Height, 2048, false))
+		}
+	}
+	if body.From != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.from", *body.From, goa.FormatDateTime))
+	}
+	if body.To != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.to", *body.To, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCanvasSessionStreamingBody runs the validations defined on
+// CanvasSessionStreamingBody
+func ValidateCanvasSessionStreamingBody(body *CanvasSessionStreamingBody) (err error) {
+	if body.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "body"))
+	}
+	if body.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "body"))
+	}
+	if body.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "body"))
+	}
+	if body.X != nil {
+		if *body.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", *body.X, 0, true))
+		}
+	}
+	if body.Y != nil {
+		if *body.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", *body.Y, 0, true))
+		}
+	}
+	if body.Color != nil {
+		if *body.Color < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", *body.Color, 0, true))
+		}
+	}
+	if body.Color != nil {
+		if *body.Color > 255 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", *body.Color, 255, false))
+		}
+	}
+	return
+}
+
+// ValidatePixelPlaceRequestBody runs the validations defined on
+// PixelPlaceRequestBody
+func ValidatePixelPlaceRequestBody(body *PixelPlaceRequestBody) (err error) {
+	if body.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "body"))
+	}
+	if body.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "body"))
+	}
+	if body.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "body"))
+	}
+	if body.X != nil {
+		if *body.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", *body.X, 0, true))
+		}
+	}
+	if body.Y != nil {
+		if *body.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", *body.Y, 0, true))
+		}
+	}
+	if body.Color != nil {
+		if *body.Color < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", *body.Color, 0, true))
+		}
+	}
+	if body.Color != nil {
+		if *body.Color > 255 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", *body.Color, 255, false))
+		}
+	}
+	return
+}
+
+// ValidatePixelPlacementStreamingBody runs the validations defined on
+// PixelPlacementStreamingBody
+func ValidatePixelPlacementStreamingBody(body *PixelPlacementStreamingBody) (err error) {
+	if body.X == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("x", "body"))
+	}
+	if body.Y == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("y", "body"))
+	}
+	if body.Color == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("color", "body"))
+	}
+	if body.X != nil {
+		if *body.X < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.x", *body.X, 0, true))
+		}
+	}
+	if body.Y != nil {
+		if *body.Y < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.y", *body.Y, 0, true))
+		}
+	}
+	if body.Color != nil {
+		if *body.Color < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", *body.Color, 0, true))
+		}
+	}
+	if body.Color != nil {
+		if *body.Color > 255 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.color", *body.Color, 255, false))
+		}
+	}
+	return
 }
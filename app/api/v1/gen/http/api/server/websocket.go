@@ -0,0 +1,146 @@
+// Code generated by goa v3.22.6, DO NOT EDIT.
+//
+// api WebSocket server streaming
+//
+// Command:
+// $ goa gen github.com/jace-ys/pikcel/api/v1 -o api/v1
+
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	api "github.com/jace-ys/pikcel/api/v1/gen/api"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// ConnConfigurer holds the websocket connection configurer functions for the
+// streaming endpoints in "api" service.
+type ConnConfigurer struct {
+	CanvasSessionFn goahttp.ConnConfigureFunc
+}
+
+// CanvasSessionServerStream implements the api.CanvasSessionServerStream
+// interface.
+type CanvasSessionServerStream struct {
+	once sync.Once
+	// upgrader is the websocket connection upgrader.
+	upgrader goahttp.Upgrader
+	// configurer is the websocket connection configurer.
+	configurer goahttp.ConnConfigureFunc
+	// cancel is the context cancellation function which cancels the request
+	// context when invoked.
+	cancel context.CancelFunc
+	// w is the HTTP response writer used in upgrading the connection.
+	w http.ResponseWriter
+	// r is the HTTP request.
+	r *http.Request
+	// conn is the underlying websocket connection.
+	conn *websocket.Conn
+}
+
+// NewConnConfigurer initializes the websocket connection configurer function
+// with fn for all the streaming endpoints in "api" service.
+func NewConnConfigurer(fn goahttp.ConnConfigureFunc) *ConnConfigurer {
+	return &ConnConfigurer{
+		CanvasSessionFn: fn,
+	}
+}
+
+// Send streams instances of "api.SessionEvent" to the "CanvasSession" endpoint
+// websocket connection.
+func (s *CanvasSessionServerStream) Send(v *api.SessionEvent) error {
+	var err error
+	// Upgrade the HTTP connection to a websocket connection only once. Connection
+	// upgrade is done here so that authorization logic in the endpoint is executed
+	// before calling the actual service method which may call Send().
+	s.once.Do(func() {
+		var conn *websocket.Conn
+		conn, err = s.upgrader.Upgrade(s.w, s.r, nil)
+		if err != nil {
+			return
+		}
+		if s.configurer != nil {
+			conn = s.configurer(conn, s.cancel)
+		}
+		s.conn = conn
+	})
+	if err != nil {
+		return err
+	}
+	res := v
+	body := NewCanvasSessionResponseBody(res)
+	return s.conn.WriteJSON(body)
+}
+
+// SendWithContext streams instances of "api.SessionEvent" to the
+// "CanvasSession" endpoint websocket connection with context.
+func (s *CanvasSessionServerStream) SendWithContext(ctx context.Context, v *api.SessionEvent) error {
+	return s.Send(v)
+}
+
+// Recv reads instances of "api.PixelPlacement" from the "CanvasSession"
+// endpoint websocket connection.
+func (s *CanvasSessionServerStream) Recv() (*api.PixelPlacement, error) {
+	var (
+		rv  *api.PixelPlacement
+		msg *CanvasSessionStreamingBody
+		err error
+	)
+	// Upgrade the HTTP connection to a websocket connection only once. Connection
+	// upgrade is done here so that authorization logic in the endpoint is executed
+	// before calling the actual service method which may call Recv().
+	s.once.Do(func() {
+		var conn *websocket.Conn
+		conn, err = s.upgrader.Upgrade(s.w, s.r, nil)
+		if err != nil {
+			return
+		}
+		if s.configurer != nil {
+			conn = s.configurer(conn, s.cancel)
+		}
+		s.conn = conn
+	})
+	if err != nil {
+		return rv, err
+	}
+	if err = s.conn.ReadJSON(&msg); err != nil {
+		return rv, err
+	}
+	if msg == nil {
+		return rv, io.EOF
+	}
+	body := *msg
+	err = ValidateCanvasSessionStreamingBody(&body)
+	if err != nil {
+		return rv, err
+	}
+	return NewCanvasSessionStreamingBody(msg), nil
+}
+
+// RecvWithContext reads instances of "api.PixelPlacement" from the
+// "CanvasSession" endpoint websocket connection with context.
+func (s *CanvasSessionServerStream) RecvWithContext(ctx context.Context) (*api.PixelPlacement, error) {
+	return s.Recv()
+}
+
+// Close closes the "CanvasSession" endpoint websocket connection.
+func (s *CanvasSessionServerStream) Close() error {
+	var err error
+	if s.conn == nil {
+		return nil
+	}
+	if err = s.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, "server closing connection"),
+		time.Now().Add(time.Second),
+	); err != nil {
+		return err
+	}
+	return s.conn.Close()
+}
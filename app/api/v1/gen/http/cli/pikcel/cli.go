@@ -1,4 +1,4 @@
-// Code generated by goa v3.22.1, DO NOT EDIT.
+// Code generated by goa v3.22.6, DO NOT EDIT.
 //
 // pikcel HTTP client CLI support package
 //
@@ -23,13 +23,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"api canvas-get",
+		"api (canvas-create|canvas-list|canvas-get|canvas-archive|canvas-resize|protected-region-create|protected-region-delete|canvas-rollback|audit-log-get|canvas-pixels-get|canvas-chunks-get|canvas-region-get|canvas-image-get|canvas-region-image-get|canvas-tile-get|canvas-subscribe|canvas-session|pixel-place|pixel-info-get)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` api canvas-get` + "\n" +
+	return os.Args[0] + " " + "api canvas-create --body '{\n      \"height\": 1824,\n      \"palette\": [\n         \"#Bd04a9\",\n         \"#1dB02f\",\n         \"#F25271\"\n      ],\n      \"width\": 926\n   }' --token \"Eos velit.\"" + "\n" +
 		""
 }
 
@@ -41,14 +41,124 @@ func ParseEndpoint(
 	enc func(*http.Request) goahttp.Encoder,
 	dec func(*http.Response) goahttp.Decoder,
 	restore bool,
+	dialer goahttp.Dialer,
+	apiConfigurer *apic.ConnConfigurer,
 ) (goa.Endpoint, any, error) {
 	var (
 		apiFlags = flag.NewFlagSet("api", flag.ContinueOnError)
 
-		apiCanvasGetFlags = flag.NewFlagSet("canvas-get", flag.ExitOnError)
+		apiCanvasCreateFlags     = flag.NewFlagSet("canvas-create", flag.ExitOnError)
+		apiCanvasCreateBodyFlag  = apiCanvasCreateFlags.String("body", "REQUIRED", "")
+		apiCanvasCreateTokenFlag = apiCanvasCreateFlags.String("token", "REQUIRED", "")
+
+		apiCanvasListFlags               = flag.NewFlagSet("canvas-list", flag.ExitOnError)
+		apiCanvasListIncludeArchivedFlag = apiCanvasListFlags.String("include-archived", "", "")
+
+		apiCanvasGetFlags  = flag.NewFlagSet("canvas-get", flag.ExitOnError)
+		apiCanvasGetIDFlag = apiCanvasGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+
+		apiCanvasArchiveFlags     = flag.NewFlagSet("canvas-archive", flag.ExitOnError)
+		apiCanvasArchiveIDFlag    = apiCanvasArchiveFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasArchiveTokenFlag = apiCanvasArchiveFlags.String("token", "REQUIRED", "")
+
+		apiCanvasResizeFlags     = flag.NewFlagSet("canvas-resize", flag.ExitOnError)
+		apiCanvasResizeBodyFlag  = apiCanvasResizeFlags.String("body", "REQUIRED", "")
+		apiCanvasResizeIDFlag    = apiCanvasResizeFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasResizeTokenFlag = apiCanvasResizeFlags.String("token", "REQUIRED", "")
+
+		apiProtectedRegionCreateFlags     = flag.NewFlagSet("protected-region-create", flag.ExitOnError)
+		apiProtectedRegionCreateBodyFlag  = apiProtectedRegionCreateFlags.String("body", "REQUIRED", "")
+		apiProtectedRegionCreateIDFlag    = apiProtectedRegionCreateFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiProtectedRegionCreateTokenFlag = apiProtectedRegionCreateFlags.String("token", "REQUIRED", "")
+
+		apiProtectedRegionDeleteFlags        = flag.NewFlagSet("protected-region-delete", flag.ExitOnError)
+		apiProtectedRegionDeleteIDFlag       = apiProtectedRegionDeleteFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiProtectedRegionDeleteRegionIDFlag = apiProtectedRegionDeleteFlags.String("region-id", "REQUIRED", "ID of the protected region, e.g. rgn_01h455vb4pex5vsknk084sn02q.")
+		apiProtectedRegionDeleteTokenFlag    = apiProtectedRegionDeleteFlags.String("token", "REQUIRED", "")
+
+		apiCanvasRollbackFlags     = flag.NewFlagSet("canvas-rollback", flag.ExitOnError)
+		apiCanvasRollbackBodyFlag  = apiCanvasRollbackFlags.String("body", "REQUIRED", "")
+		apiCanvasRollbackIDFlag    = apiCanvasRollbackFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasRollbackTokenFlag = apiCanvasRollbackFlags.String("token", "REQUIRED", "")
+
+		apiAuditLogGetFlags     = flag.NewFlagSet("audit-log-get", flag.ExitOnError)
+		apiAuditLogGetIDFlag    = apiAuditLogGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiAuditLogGetLimitFlag = apiAuditLogGetFlags.String("limit", "50", "")
+		apiAuditLogGetTokenFlag = apiAuditLogGetFlags.String("token", "REQUIRED", "")
+
+		apiCanvasPixelsGetFlags  = flag.NewFlagSet("canvas-pixels-get", flag.ExitOnError)
+		apiCanvasPixelsGetIDFlag = apiCanvasPixelsGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+
+		apiCanvasChunksGetFlags     = flag.NewFlagSet("canvas-chunks-get", flag.ExitOnError)
+		apiCanvasChunksGetIDFlag    = apiCanvasChunksGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasChunksGetSinceFlag = apiCanvasChunksGetFlags.String("since", "", "")
+
+		apiCanvasRegionGetFlags      = flag.NewFlagSet("canvas-region-get", flag.ExitOnError)
+		apiCanvasRegionGetIDFlag     = apiCanvasRegionGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasRegionGetXFlag      = apiCanvasRegionGetFlags.String("x", "REQUIRED", "")
+		apiCanvasRegionGetYFlag      = apiCanvasRegionGetFlags.String("y", "REQUIRED", "")
+		apiCanvasRegionGetWidthFlag  = apiCanvasRegionGetFlags.String("width", "REQUIRED", "")
+		apiCanvasRegionGetHeightFlag = apiCanvasRegionGetFlags.String("height", "REQUIRED", "")
+
+		apiCanvasImageGetFlags     = flag.NewFlagSet("canvas-image-get", flag.ExitOnError)
+		apiCanvasImageGetIDFlag    = apiCanvasImageGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasImageGetScaleFlag = apiCanvasImageGetFlags.String("scale", "1", "")
+
+		apiCanvasRegionImageGetFlags      = flag.NewFlagSet("canvas-region-image-get", flag.ExitOnError)
+		apiCanvasRegionImageGetIDFlag     = apiCanvasRegionImageGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasRegionImageGetXFlag      = apiCanvasRegionImageGetFlags.String("x", "REQUIRED", "")
+		apiCanvasRegionImageGetYFlag      = apiCanvasRegionImageGetFlags.String("y", "REQUIRED", "")
+		apiCanvasRegionImageGetWidthFlag  = apiCanvasRegionImageGetFlags.String("width", "REQUIRED", "")
+		apiCanvasRegionImageGetHeightFlag = apiCanvasRegionImageGetFlags.String("height", "REQUIRED", "")
+		apiCanvasRegionImageGetScaleFlag  = apiCanvasRegionImageGetFlags.String("scale", "1", "")
+
+		apiCanvasTileGetFlags           = flag.NewFlagSet("canvas-tile-get", flag.ExitOnError)
+		apiCanvasTileGetIDFlag          = apiCanvasTileGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasTileGetZFlag           = apiCanvasTileGetFlags.String("z", "REQUIRED", "Zoom level, from 0 where a single tile covers the whole canvas up to the level where each tile pixel is one canvas pixel.")
+		apiCanvasTileGetXFlag           = apiCanvasTileGetFlags.String("x", "REQUIRED", "X coordinate of the tile, in tiles.")
+		apiCanvasTileGetYFlag           = apiCanvasTileGetFlags.String("y", "REQUIRED", "Y coordinate of the tile, in tiles.")
+		apiCanvasTileGetIfNoneMatchFlag = apiCanvasTileGetFlags.String("if-none-match", "", "")
+
+		apiCanvasSubscribeFlags           = flag.NewFlagSet("canvas-subscribe", flag.ExitOnError)
+		apiCanvasSubscribeIDFlag          = apiCanvasSubscribeFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasSubscribeSinceFlag       = apiCanvasSubscribeFlags.String("since", "", "")
+		apiCanvasSubscribeLastEventIDFlag = apiCanvasSubscribeFlags.String("last-event-id", "", "")
+
+		apiCanvasSessionFlags     = flag.NewFlagSet("canvas-session", flag.ExitOnError)
+		apiCanvasSessionIDFlag    = apiCanvasSessionFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiCanvasSessionTokenFlag = apiCanvasSessionFlags.String("token", "REQUIRED", "")
+
+		apiPixelPlaceFlags     = flag.NewFlagSet("pixel-place", flag.ExitOnError)
+		apiPixelPlaceBodyFlag  = apiPixelPlaceFlags.String("body", "REQUIRED", "")
+		apiPixelPlaceIDFlag    = apiPixelPlaceFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiPixelPlaceTokenFlag = apiPixelPlaceFlags.String("token", "REQUIRED", "")
+
+		apiPixelInfoGetFlags     = flag.NewFlagSet("pixel-info-get", flag.ExitOnError)
+		apiPixelInfoGetIDFlag    = apiPixelInfoGetFlags.String("id", "REQUIRED", "ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.")
+		apiPixelInfoGetXFlag     = apiPixelInfoGetFlags.String("x", "REQUIRED", "")
+		apiPixelInfoGetYFlag     = apiPixelInfoGetFlags.String("y", "REQUIRED", "")
+		apiPixelInfoGetLimitFlag = apiPixelInfoGetFlags.String("limit", "10", "")
 	)
 	apiFlags.Usage = apiUsage
+	apiCanvasCreateFlags.Usage = apiCanvasCreateUsage
+	apiCanvasListFlags.Usage = apiCanvasListUsage
 	apiCanvasGetFlags.Usage = apiCanvasGetUsage
+	apiCanvasArchiveFlags.Usage = apiCanvasArchiveUsage
+	apiCanvasResizeFlags.Usage = apiCanvasResizeUsage
+	apiProtectedRegionCreateFlags.Usage = apiProtectedRegionCreateUsage
+	apiProtectedRegionDeleteFlags.Usage = apiProtectedRegionDeleteUsage
+	apiCanvasRollbackFlags.Usage = apiCanvasRollbackUsage
+	apiAuditLogGetFlags.Usage = apiAuditLogGetUsage
+	apiCanvasPixelsGetFlags.Usage = apiCanvasPixelsGetUsage
+	apiCanvasChunksGetFlags.Usage = apiCanvasChunksGetUsage
+	apiCanvasRegionGetFlags.Usage = apiCanvasRegionGetUsage
+	apiCanvasImageGetFlags.Usage = apiCanvasImageGetUsage
+	apiCanvasRegionImageGetFlags.Usage = apiCanvasRegionImageGetUsage
+	apiCanvasTileGetFlags.Usage = apiCanvasTileGetUsage
+	apiCanvasSubscribeFlags.Usage = apiCanvasSubscribeUsage
+	apiCanvasSessionFlags.Usage = apiCanvasSessionUsage
+	apiPixelPlaceFlags.Usage = apiPixelPlaceUsage
+	apiPixelInfoGetFlags.Usage = apiPixelInfoGetUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -84,9 +194,63 @@ func ParseEndpoint(
 		switch svcn {
 		case "api":
 			switch epn {
+			case "canvas-create":
+				epf = apiCanvasCreateFlags
+
+			case "canvas-list":
+				epf = apiCanvasListFlags
+
 			case "canvas-get":
 				epf = apiCanvasGetFlags
 
+			case "canvas-archive":
+				epf = apiCanvasArchiveFlags
+
+			case "canvas-resize":
+				epf = apiCanvasResizeFlags
+
+			case "protected-region-create":
+				epf = apiProtectedRegionCreateFlags
+
+			case "protected-region-delete":
+				epf = apiProtectedRegionDeleteFlags
+
+			case "canvas-rollback":
+				epf = apiCanvasRollbackFlags
+
+			case "audit-log-get":
+				epf = apiAuditLogGetFlags
+
+			case "canvas-pixels-get":
+				epf = apiCanvasPixelsGetFlags
+
+			case "canvas-chunks-get":
+				epf = apiCanvasChunksGetFlags
+
+			case "canvas-region-get":
+				epf = apiCanvasRegionGetFlags
+
+			case "canvas-image-get":
+				epf = apiCanvasImageGetFlags
+
+			case "canvas-region-image-get":
+				epf = apiCanvasRegionImageGetFlags
+
+			case "canvas-tile-get":
+				epf = apiCanvasTileGetFlags
+
+			case "canvas-subscribe":
+				epf = apiCanvasSubscribeFlags
+
+			case "canvas-session":
+				epf = apiCanvasSessionFlags
+
+			case "pixel-place":
+				epf = apiPixelPlaceFlags
+
+			case "pixel-info-get":
+				epf = apiPixelInfoGetFlags
+
 			}
 
 		}
@@ -110,10 +274,65 @@ func ParseEndpoint(
 	{
 		switch svcn {
 		case "api":
-			c := apic.NewClient(scheme, host, doer, enc, dec, restore)
+			c := apic.NewClient(scheme, host, doer, enc, dec, restore, dialer, apiConfigurer)
 			switch epn {
+			case "canvas-create":
+				endpoint = c.CanvasCreate()
+				data, err = apic.BuildCanvasCreatePayload(*apiCanvasCreateBodyFlag, *apiCanvasCreateTokenFlag)
+			case "canvas-list":
+				endpoint = c.CanvasList()
+				data, err = apic.BuildCanvasListPayload(*apiCanvasListIncludeArchivedFlag)
 			case "canvas-get":
 				endpoint = c.CanvasGet()
+				data, err = apic.BuildCanvasGetPayload(*apiCanvasGetIDFlag)
+			case "canvas-archive":
+				endpoint = c.CanvasArchive()
+				data, err = apic.BuildCanvasArchivePayload(*apiCanvasArchiveIDFlag, *apiCanvasArchiveTokenFlag)
+			case "canvas-resize":
+				endpoint = c.CanvasResize()
+				data, err = apic.BuildCanvasResizePayload(*apiCanvasResizeBodyFlag, *apiCanvasResizeIDFlag, *apiCanvasResizeTokenFlag)
+			case "protected-region-create":
+				endpoint = c.ProtectedRegionCreate()
+				data, err = apic.BuildProtectedRegionCreatePayload(*apiProtectedRegionCreateBodyFlag, *apiProtectedRegionCreateIDFlag, *apiProtectedRegionCreateTokenFlag)
+			case "protected-region-delete":
+				endpoint = c.ProtectedRegionDelete()
+				data, err = apic.BuildProtectedRegionDeletePayload(*apiProtectedRegionDeleteIDFlag, *apiProtectedRegionDeleteRegionIDFlag, *apiProtectedRegionDeleteTokenFlag)
+			case "canvas-rollback":
+				endpoint = c.CanvasRollback()
+				data, err = apic.BuildCanvasRollbackPayload(*apiCanvasRollbackBodyFlag, *apiCanvasRollbackIDFlag, *apiCanvasRollbackTokenFlag)
+			case "audit-log-get":
+				endpoint = c.AuditLogGet()
+				data, err = apic.BuildAuditLogGetPayload(*apiAuditLogGetIDFlag, *apiAuditLogGetLimitFlag, *apiAuditLogGetTokenFlag)
+			case "canvas-pixels-get":
+				endpoint = c.CanvasPixelsGet()
+				data, err = apic.BuildCanvasPixelsGetPayload(*apiCanvasPixelsGetIDFlag)
+			case "canvas-chunks-get":
+				endpoint = c.CanvasChunksGet()
+				data, err = apic.BuildCanvasChunksGetPayload(*apiCanvasChunksGetIDFlag, *apiCanvasChunksGetSinceFlag)
+			case "canvas-region-get":
+				endpoint = c.CanvasRegionGet()
+				data, err = apic.BuildCanvasRegionGetPayload(*apiCanvasRegionGetIDFlag, *apiCanvasRegionGetXFlag, *apiCanvasRegionGetYFlag, *apiCanvasRegionGetWidthFlag, *apiCanvasRegionGetHeightFlag)
+			case "canvas-image-get":
+				endpoint = c.CanvasImageGet()
+				data, err = apic.BuildCanvasImageGetPayload(*apiCanvasImageGetIDFlag, *apiCanvasImageGetScaleFlag)
+			case "canvas-region-image-get":
+				endpoint = c.CanvasRegionImageGet()
+				data, err = apic.BuildCanvasRegionImageGetPayload(*apiCanvasRegionImageGetIDFlag, *apiCanvasRegionImageGetXFlag, *apiCanvasRegionImageGetYFlag, *apiCanvasRegionImageGetWidthFlag, *apiCanvasRegionImageGetHeightFlag, *apiCanvasRegionImageGetScaleFlag)
+			case "canvas-tile-get":
+				endpoint = c.CanvasTileGet()
+				data, err = apic.BuildCanvasTileGetPayload(*apiCanvasTileGetIDFlag, *apiCanvasTileGetZFlag, *apiCanvasTileGetXFlag, *apiCanvasTileGetYFlag, *apiCanvasTileGetIfNoneMatchFlag)
+			case "canvas-subscribe":
+				endpoint = c.CanvasSubscribe()
+				data, err = apic.BuildCanvasSubscribePayload(*apiCanvasSubscribeIDFlag, *apiCanvasSubscribeSinceFlag, *apiCanvasSubscribeLastEventIDFlag)
+			case "canvas-session":
+				endpoint = c.CanvasSession()
+				data, err = apic.BuildCanvasSessionPayload(*apiCanvasSessionIDFlag, *apiCanvasSessionTokenFlag)
+			case "pixel-place":
+				endpoint = c.PixelPlace()
+				data, err = apic.BuildPixelPlacePayload(*apiPixelPlaceBodyFlag, *apiPixelPlaceIDFlag, *apiPixelPlaceTokenFlag)
+			case "pixel-info-get":
+				endpoint = c.PixelInfoGet()
+				data, err = apic.BuildPixelInfoGetPayload(*apiPixelInfoGetIDFlag, *apiPixelInfoGetXFlag, *apiPixelInfoGetYFlag, *apiPixelInfoGetLimitFlag)
 			}
 		}
 	}
@@ -126,23 +345,440 @@ func ParseEndpoint(
 
 // apiUsage displays the usage of the api command and its subcommands.
 func apiUsage() {
-	fmt.Fprintf(os.Stderr, `Service is the api service interface.
-Usage:
-    %[1]s [globalflags] api COMMAND [flags]
+	fmt.Fprintln(os.Stderr, `Service is the api service interface.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] api COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    canvas-create: CanvasCreate implements CanvasCreate.`)
+	fmt.Fprintln(os.Stderr, `    canvas-list: CanvasList implements CanvasList.`)
+	fmt.Fprintln(os.Stderr, `    canvas-get: CanvasGet implements CanvasGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-archive: Archive a canvas, after which it can still be viewed but no more pixels can be placed on it.`)
+	fmt.Fprintln(os.Stderr, `    canvas-resize: Grow a canvas by a number of pixels on each side. Existing pixels keep their colors and history, but move right and down by the number of pixels added on the left and top.`)
+	fmt.Fprintln(os.Stderr, `    protected-region-create: Protect a region of a canvas, after which no pixels can be placed in it until it expires or is deleted.`)
+	fmt.Fprintln(os.Stderr, `    protected-region-delete: Delete a protected region of a canvas, after which pixels can be placed in it again.`)
+	fmt.Fprintln(os.Stderr, `    canvas-rollback: Revert the pixels last placed by a user, or within a region and time window, to their previous colors. Every filter that is set must match, and at least a user or a region must be set. Only placements since the canvas was last resized are reverted.`)
+	fmt.Fprintln(os.Stderr, `    audit-log-get: List the latest actions taken by moderators on a canvas.`)
+	fmt.Fprintln(os.Stderr, `    canvas-pixels-get: CanvasPixelsGet implements CanvasPixelsGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-chunks-get: Get the chunks of a canvas modified since a version, so that clients can refresh only what changed.`)
+	fmt.Fprintln(os.Stderr, `    canvas-region-get: CanvasRegionGet implements CanvasRegionGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-image-get: CanvasImageGet implements CanvasImageGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-region-image-get: CanvasRegionImageGet implements CanvasRegionImageGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-tile-get: CanvasTileGet implements CanvasTileGet.`)
+	fmt.Fprintln(os.Stderr, `    canvas-subscribe: CanvasSubscribe implements CanvasSubscribe.`)
+	fmt.Fprintln(os.Stderr, `    canvas-session: CanvasSession implements CanvasSession.`)
+	fmt.Fprintln(os.Stderr, `    pixel-place: PixelPlace implements PixelPlace.`)
+	fmt.Fprintln(os.Stderr, `    pixel-info-get: PixelInfoGet implements PixelInfoGet.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s api COMMAND --help\n", os.Args[0])
+}
+func apiCanvasCreateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-create", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasCreate implements CanvasCreate.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-create --body '{\n      \"height\": 1824,\n      \"palette\": [\n         \"#Bd04a9\",\n         \"#1dB02f\",\n         \"#F25271\"\n      ],\n      \"width\": 926\n   }' --token \"Eos velit.\"")
+}
+
+func apiCanvasListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -include-archived BOOL")
+	fmt.Fprintln(os.Stderr)
 
-COMMAND:
-    canvas-get: CanvasGet implements CanvasGet.
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasList implements CanvasList.`)
 
-Additional help:
-    %[1]s api COMMAND --help
-`, os.Args[0])
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -include-archived BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-list --include-archived true")
 }
+
 func apiCanvasGetUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] api canvas-get
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasGet implements CanvasGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-get --id \"Illo itaque fugiat et nisi.\"")
+}
+
+func apiCanvasArchiveUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-archive", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Archive a canvas, after which it can still be viewed but no more pixels can be placed on it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-archive --id \"Quo quia quia quidem est doloribus.\" --token \"Natus dignissimos provident fuga.\"")
+}
+
+func apiCanvasResizeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-resize", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Grow a canvas by a number of pixels on each side. Existing pixels keep their colors and history, but move right and down by the number of pixels added on the left and top.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-resize --body '{\n      \"bottom\": 587,\n      \"fill\": 215,\n      \"left\": 331,\n      \"right\": 566,\n      \"top\": 1623\n   }' --id \"Nihil aut totam.\" --token \"Temporibus ratione fugiat dolores.\"")
+}
+
+func apiProtectedRegionCreateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api protected-region-create", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Protect a region of a canvas, after which no pixels can be placed in it until it expires or is deleted.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api protected-region-create --body '{\n      \"duration\": 1840362032,\n      \"height\": 1102,\n      \"reason\": \"db4\",\n      \"width\": 1458,\n      \"x\": 1288742254,\n      \"y\": 1085671537\n   }' --id \"Et incidunt accusamus harum incidunt harum animi.\" --token \"Corporis voluptatum reprehenderit.\"")
+}
+
+func apiProtectedRegionDeleteUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api protected-region-delete", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -region-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a protected region of a canvas, after which pixels can be placed in it again.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -region-id STRING: ID of the protected region, e.g. rgn_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api protected-region-delete --id \"Voluptatibus quibusdam culpa tempora autem qui.\" --region-id \"Earum sapiente ut illo et.\" --token \"Voluptas dolorem suscipit nulla.\"")
+}
+
+func apiCanvasRollbackUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-rollback", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revert the pixels last placed by a user, or within a region and time window, to their previous colors. Every filter that is set must match, and at least a user or a region must be set. Only placements since the canvas was last resized are reverted.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-rollback --body '{\n      \"from\": \"2004-06-09T21:55:02Z\",\n      \"height\": 589,\n      \"to\": \"1976-02-24T11:19:54Z\",\n      \"user_id\": \"Ut tenetur voluptatum sint.\",\n      \"width\": 21,\n      \"x\": 74661222,\n      \"y\": 1961545439\n   }' --id \"Illum eius voluptatem earum voluptatem.\" --token \"Consequatur et corporis voluptates vel quae.\"")
+}
+
+func apiAuditLogGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api audit-log-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -limit INT32")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the latest actions taken by moderators on a canvas.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -limit INT32: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api audit-log-get --id \"Quod aperiam id.\" --limit 292 --token \"Vel quis eum possimus dolorum.\"")
+}
+
+func apiCanvasPixelsGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-pixels-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasPixelsGet implements CanvasPixelsGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-pixels-get --id \"Rerum fugiat.\"")
+}
+
+func apiCanvasChunksGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-chunks-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -since INT64")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the chunks of a canvas modified since a version, so that clients can refresh only what changed.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -since INT64: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-chunks-get --id \"Quia illo.\" --since 4271589491314384893")
+}
+
+func apiCanvasRegionGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-region-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -x INT32")
+	fmt.Fprint(os.Stderr, " -y INT32")
+	fmt.Fprint(os.Stderr, " -width INT32")
+	fmt.Fprint(os.Stderr, " -height INT32")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasRegionGet implements CanvasRegionGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -x INT32: `)
+	fmt.Fprintln(os.Stderr, `    -y INT32: `)
+	fmt.Fprintln(os.Stderr, `    -width INT32: `)
+	fmt.Fprintln(os.Stderr, `    -height INT32: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-region-get --id \"Odio ipsum nobis.\" --x 617055659 --y 1987794873 --width 121 --height 52")
+}
+
+func apiCanvasImageGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-image-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -scale INT32")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasImageGet implements CanvasImageGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -scale INT32: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-image-get --id \"Eius maxime in quis aperiam facilis.\" --scale 14")
+}
+
+func apiCanvasRegionImageGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-region-image-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -x INT32")
+	fmt.Fprint(os.Stderr, " -y INT32")
+	fmt.Fprint(os.Stderr, " -width INT32")
+	fmt.Fprint(os.Stderr, " -height INT32")
+	fmt.Fprint(os.Stderr, " -scale INT32")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasRegionImageGet implements CanvasRegionImageGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -x INT32: `)
+	fmt.Fprintln(os.Stderr, `    -y INT32: `)
+	fmt.Fprintln(os.Stderr, `    -width INT32: `)
+	fmt.Fprintln(os.Stderr, `    -height INT32: `)
+	fmt.Fprintln(os.Stderr, `    -scale INT32: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-region-image-get --id \"Eum ut.\" --x 515175329 --y 963546964 --width 19 --height 70 --scale 8")
+}
+
+func apiCanvasTileGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-tile-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -z INT32")
+	fmt.Fprint(os.Stderr, " -x INT32")
+	fmt.Fprint(os.Stderr, " -y INT32")
+	fmt.Fprint(os.Stderr, " -if-none-match STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasTileGet implements CanvasTileGet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -z INT32: Zoom level, from 0 where a single tile covers the whole canvas up to the level where each tile pixel is one canvas pixel.`)
+	fmt.Fprintln(os.Stderr, `    -x INT32: X coordinate of the tile, in tiles.`)
+	fmt.Fprintln(os.Stderr, `    -y INT32: Y coordinate of the tile, in tiles.`)
+	fmt.Fprintln(os.Stderr, `    -if-none-match STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-tile-get --id \"Voluptas ullam.\" --z 148405090 --x 1105586018 --y 1561290752 --if-none-match \"Eos in molestiae nihil velit rerum sit.\"")
+}
+
+func apiCanvasSubscribeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-subscribe", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -since INT64")
+	fmt.Fprint(os.Stderr, " -last-event-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasSubscribe implements CanvasSubscribe.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -since INT64: `)
+	fmt.Fprintln(os.Stderr, `    -last-event-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-subscribe --id \"Ratione ipsam qui dolore.\" --since 6760525065695390823 --last-event-id \"Cumque assumenda placeat quia illo velit.\"")
+}
+
+func apiCanvasSessionUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api canvas-session", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `CanvasSession implements CanvasSession.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api canvas-session --id \"Recusandae blanditiis.\" --token \"Incidunt autem quaerat porro sit.\"")
+}
+
+func apiPixelPlaceUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api pixel-place", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `PixelPlace implements PixelPlace.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api pixel-place --body '{\n      \"color\": 37,\n      \"x\": 920829531,\n      \"y\": 88867099\n   }' --id \"Voluptas ullam quisquam excepturi.\" --token \"Quia ut vero.\"")
+}
+
+func apiPixelInfoGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] api pixel-info-get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -x INT32")
+	fmt.Fprint(os.Stderr, " -y INT32")
+	fmt.Fprint(os.Stderr, " -limit INT32")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `PixelInfoGet implements PixelInfoGet.`)
 
-CanvasGet implements CanvasGet.
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ID of the canvas, e.g. cnv_01h455vb4pex5vsknk084sn02q.`)
+	fmt.Fprintln(os.Stderr, `    -x INT32: `)
+	fmt.Fprintln(os.Stderr, `    -y INT32: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT32: `)
 
-Example:
-    %[1]s api canvas-get
-`, os.Args[0])
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "api pixel-info-get --id \"Quas officiis.\" --x 440641360 --y 67277495 --limit 61")
 }
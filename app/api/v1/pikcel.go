@@ -20,27 +20,652 @@ var _ = API("pikcel", func() {
 })
 
 var _ = Service("api", func() {
+	Security(JWTAuth)
+
 	Error(ErrCodeUnauthenticated)
 	Error(ErrCodeAccessDenied)
+	Error(ErrCodeNotFound)
 
 	HTTP(func() {
 		Path("/api/v1")
 		Response(ErrCodeUnauthenticated, StatusUnauthorized)
 		Response(ErrCodeAccessDenied, StatusForbidden)
+		Response(ErrCodeNotFound, StatusNotFound)
 	})
 
 	GRPC(func() {
 		Response(ErrCodeUnauthenticated, CodeUnauthenticated)
 		Response(ErrCodeAccessDenied, CodePermissionDenied)
+		Response(ErrCodeNotFound, CodeNotFound)
+	})
+
+	Method("CanvasCreate", func() {
+		Security(JWTAuth, func() {
+			Scope("canvas:manage")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			Field(1, "width", Int32, func() {
+				Minimum(1)
+				Maximum(MaxCanvasSize)
+			})
+			Field(2, "height", Int32, func() {
+				Minimum(1)
+				Maximum(MaxCanvasSize)
+			})
+			Field(3, "palette", ArrayOf(String, func() {
+				Pattern("^#[0-9A-Fa-f]{6}$")
+			}), "Ordered list of colors available on the canvas, defaults to the standard palette if empty.", func() {
+				MaxLength(MaxPaletteSize)
+			})
+			Required("token", "width", "height")
+		})
+
+		Result(Canvas)
+
+		HTTP(func() {
+			POST("/canvases")
+			Response(StatusCreated)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasList", func() {
+		NoSecurity()
+
+		Payload(func() {
+			Field(1, "include_archived", Boolean, "Whether to include archived canvases.", func() {
+				Default(false)
+			})
+		})
+
+		Result(Canvases)
+
+		HTTP(func() {
+			GET("/canvases")
+			Param("include_archived")
+			Response(StatusOK)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
 	})
 
 	Method("CanvasGet", func() {
 		NoSecurity()
 
+		Payload(func() {
+			CanvasID(1)
+			Required("id")
+		})
+
 		Result(Canvas)
 
 		HTTP(func() {
-			GET("/canvas")
+			GET("/canvases/{id}")
+			Response(StatusOK)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasArchive", func() {
+		Description("Archive a canvas, after which it can still be viewed but no more pixels can be placed on it.")
+
+		Security(JWTAuth, func() {
+			Scope("canvas:manage")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			CanvasID(1)
+			Required("token", "id")
+		})
+
+		Result(Canvas)
+
+		HTTP(func() {
+			POST("/canvases/{id}/archive")
+			Response(StatusOK)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasResize", func() {
+		Description("Grow a canvas by a number of pixels on each side. Existing pixels keep their colors and history, but move right and down by the number of pixels added on the left and top.")
+
+		Security(JWTAuth, func() {
+			Scope("canvas:manage")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			CanvasID(1)
+			Field(2, "left", Int32, func() {
+				Default(0)
+				Minimum(0)
+				Maximum(MaxCanvasSize)
+			})
+			Field(3, "top", Int32, func() {
+				Default(0)
+				Minimum(0)
+				Maximum(MaxCanvasSize)
+			})
+			Field(4, "right", Int32, func() {
+				Default(0)
+				Minimum(0)
+				Maximum(MaxCanvasSize)
+			})
+			Field(5, "bottom", Int32, func() {
+				Default(0)
+				Minimum(0)
+				Maximum(MaxCanvasSize)
+			})
+			Field(6, "fill", Int32, "Color of the pixels added to the canvas.", func() {
+				Default(0)
+				Minimum(0)
+				Maximum(255)
+			})
+			Required("token", "id")
+		})
+
+		Result(Canvas)
+
+		Error(ErrCodeCanvasArchived)
+
+		HTTP(func() {
+			POST("/canvases/{id}/resize")
+			Response(StatusOK)
+			Response(ErrCodeCanvasArchived, StatusConflict)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response(ErrCodeCanvasArchived, CodeFailedPrecondition)
+		})
+	})
+
+	Method("ProtectedRegionCreate", func() {
+		Description("Protect a region of a canvas, after which no pixels can be placed in it until it expires or is deleted.")
+
+		Security(JWTAuth, func() {
+			Scope("canvas:manage")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			Field(1, "x", Int32, func() {
+				Minimum(0)
+			})
+			Field(2, "y", Int32, func() {
+				Minimum(0)
+			})
+			Field(3, "width", Int32, func() {
+				Minimum(1)
+				Maximum(MaxCanvasSize)
+			})
+			Field(4, "height", Int32, func() {
+				Minimum(1)
+				Maximum(MaxCanvasSize)
+			})
+			Field(5, "reason", String, "Why the region is protected, e.g. a sponsor's logo.", func() {
+				MaxLength(200)
+			})
+			Field(6, "duration", Int32, "Number of seconds to protect the region for. The region is protected until deleted if unset.", func() {
+				Minimum(1)
+			})
+			CanvasID(7)
+			Required("token", "id", "x", "y", "width", "height")
+		})
+
+		Result(ProtectedRegion)
+
+		HTTP(func() {
+			POST("/canvases/{id}/protected-regions")
+			Response(StatusCreated)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("ProtectedRegionDelete", func() {
+		Description("Delete a protected region of a canvas, after which pixels can be placed in it again.")
+
+		Security(JWTAuth, func() {
+			Scope("canvas:manage")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			CanvasID(1)
+			Field(2, "region_id", String, "ID of the protected region, e.g. rgn_01h455vb4pex5vsknk084sn02q.")
+			Required("token", "id", "region_id")
+		})
+
+		HTTP(func() {
+			DELETE("/canvases/{id}/protected-regions/{region_id}")
+			Response(StatusNoContent)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasRollback", func() {
+		Description("Revert the pixels last placed by a user, or within a region and time window, to their previous colors. Every filter that is set must match, and at least a user or a region must be set. Only placements since the canvas was last resized are reverted.")
+
+		Security(JWTAuth, func() {
+			Scope("canvas:manage")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			CanvasID(1)
+			Field(2, "user_id", String, "Revert pixels placed by this user.")
+			Field(3, "x", Int32, func() {
+				Minimum(0)
+			})
+			Field(4, "y", Int32, func() {
+				Minimum(0)
+			})
+			Field(5, "width", Int32, "Revert pixels within this region, along with x, y and height.", func() {
+				Minimum(1)
+				Maximum(MaxCanvasSize)
+			})
+			Field(6, "height", Int32, func() {
+				Minimum(1)
+				Maximum(MaxCanvasSize)
+			})
+			Field(7, "from", String, "Revert pixels placed at or after this time.", func() {
+				Format(FormatDateTime)
+			})
+			Field(8, "to", String, "Revert pixels placed before this time.", func() {
+				Format(FormatDateTime)
+			})
+			Required("token", "id")
+		})
+
+		Result(Rollback)
+
+		Error(ErrCodeCanvasArchived)
+
+		HTTP(func() {
+			POST("/canvases/{id}/rollback")
+			Response(StatusOK)
+			Response(ErrCodeCanvasArchived, StatusConflict)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response(ErrCodeCanvasArchived, CodeFailedPrecondition)
+		})
+	})
+
+	Method("AuditLogGet", func() {
+		Description("List the latest actions taken by moderators on a canvas.")
+
+		Security(JWTAuth, func() {
+			Scope("canvas:manage")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			CanvasID(1)
+			Field(2, "limit", Int32, "Maximum number of entries to return.", func() {
+				Default(50)
+				Minimum(1)
+				Maximum(MaxAuditEntries)
+			})
+			Required("token", "id")
+		})
+
+		Result(AuditLog)
+
+		HTTP(func() {
+			GET("/canvases/{id}/audit-log")
+			Param("limit")
+			Response(StatusOK)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasPixelsGet", func() {
+		NoSecurity()
+
+		Payload(func() {
+			CanvasID(1)
+			Required("id")
+		})
+
+		Result(CanvasPixels)
+
+		HTTP(func() {
+			GET("/canvases/{id}/pixels")
+			Response(StatusOK, func() {
+				Header("width:X-Canvas-Width")
+				Header("height:X-Canvas-Height")
+				Body("pixels")
+				ContentType("application/octet-stream")
+			})
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasChunksGet", func() {
+		Description("Get the chunks of a canvas modified since a version, so that clients can refresh only what changed.")
+
+		NoSecurity()
+
+		Payload(func() {
+			CanvasID(1)
+			Field(2, "since", Int64, "Version of the canvas the client already has, or 0 for every chunk with pixels placed.", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Required("id")
+		})
+
+		Result(CanvasChunks)
+
+		HTTP(func() {
+			GET("/canvases/{id}/chunks")
+			Param("since")
+			Response(StatusOK)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasRegionGet", func() {
+		NoSecurity()
+
+		Payload(func() {
+			Field(1, "x", Int32, func() {
+				Minimum(0)
+			})
+			Field(2, "y", Int32, func() {
+				Minimum(0)
+			})
+			Field(3, "width", Int32, func() {
+				Minimum(1)
+				Maximum(MaxRegionSize)
+			})
+			Field(4, "height", Int32, func() {
+				Minimum(1)
+				Maximum(MaxRegionSize)
+			})
+			CanvasID(5)
+			Required("id", "x", "y", "width", "height")
+		})
+
+		Result(CanvasRegion)
+
+		HTTP(func() {
+			GET("/canvases/{id}/region")
+			Param("x")
+			Param("y")
+			Param("width")
+			Param("height")
+			Response(StatusOK, func() {
+				Header("x:X-Region-X")
+				Header("y:X-Region-Y")
+				Header("width:X-Region-Width")
+				Header("height:X-Region-Height")
+				Body("pixels")
+				ContentType("application/octet-stream")
+			})
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasImageGet", func() {
+		NoSecurity()
+
+		Payload(func() {
+			Field(1, "scale", Int32, "Number of image pixels per canvas pixel.", func() {
+				Default(1)
+				Minimum(1)
+				Maximum(MaxImageScale)
+			})
+			CanvasID(2)
+			Required("id")
+		})
+
+		Result(Bytes)
+
+		HTTP(func() {
+			GET("/canvases/{id}/image.png")
+			Param("scale")
+			Response(StatusOK, func() {
+				ContentType("image/png")
+			})
+		})
+	})
+
+	Method("CanvasRegionImageGet", func() {
+		NoSecurity()
+
+		Payload(func() {
+			Field(1, "x", Int32, func() {
+				Minimum(0)
+			})
+			Field(2, "y", Int32, func() {
+				Minimum(0)
+			})
+			Field(3, "width", Int32, func() {
+				Minimum(1)
+				Maximum(MaxRegionSize)
+			})
+			Field(4, "height", Int32, func() {
+				Minimum(1)
+				Maximum(MaxRegionSize)
+			})
+			Field(5, "scale", Int32, "Number of image pixels per canvas pixel.", func() {
+				Default(1)
+				Minimum(1)
+				Maximum(MaxImageScale)
+			})
+			CanvasID(6)
+			Required("id", "x", "y", "width", "height")
+		})
+
+		Result(Bytes)
+
+		HTTP(func() {
+			GET("/canvases/{id}/region.png")
+			Param("x")
+			Param("y")
+			Param("width")
+			Param("height")
+			Param("scale")
+			Response(StatusOK, func() {
+				ContentType("image/png")
+			})
+		})
+	})
+
+	Method("CanvasTileGet", func() {
+		NoSecurity()
+
+		Payload(func() {
+			Field(1, "z", Int32, "Zoom level, from 0 where a single tile covers the whole canvas up to the level where each tile pixel is one canvas pixel.", func() {
+				Minimum(0)
+			})
+			Field(2, "x", Int32, "X coordinate of the tile, in tiles.", func() {
+				Minimum(0)
+			})
+			Field(3, "y", Int32, "Y coordinate of the tile, in tiles.", func() {
+				Minimum(0)
+			})
+			Field(4, "if_none_match", String)
+			CanvasID(5)
+			Required("id", "z", "x", "y")
+		})
+
+		Result(CanvasTile)
+
+		HTTP(func() {
+			GET("/canvases/{id}/tiles/{z}/{x}/{y}.png")
+			Header("if_none_match:If-None-Match")
+			Response(StatusNotModified, func() {
+				Tag("status", "not_modified")
+				Header("etag:ETag")
+				Header("cache_control:Cache-Control")
+				Body(Empty)
+			})
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+				Header("cache_control:Cache-Control")
+				Body("image")
+				ContentType("image/png")
+			})
+		})
+	})
+
+	Method("CanvasSubscribe", func() {
+		NoSecurity()
+
+		Payload(func() {
+			Field(1, "since", Int64, "Sequence number of the last event received. Placements made after it are replayed before live events.", func() {
+				Minimum(0)
+			})
+			Field(2, "last_event_id", String, "Set from the Last-Event-ID header by reconnecting SSE clients, in place of since.")
+			CanvasID(3)
+			Required("id")
+		})
+
+		StreamingResult(CanvasEvent)
+
+		HTTP(func() {
+			GET("/canvases/{id}/events")
+			Param("since")
+			Header("last_event_id:Last-Event-ID")
+			ServerSentEvents(func() {
+				SSEEventID("id")
+				SSEEventType("type")
+			})
+		})
+
+		GRPC(func() {
+			Message(func() {
+				Attribute("id")
+				Attribute("since")
+			})
+			Response(CodeOK)
+		})
+	})
+
+	Method("CanvasSession", func() {
+		Security(JWTAuth, func() {
+			Scope("canvas:place")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			CanvasID(1)
+			Required("token", "id")
+		})
+
+		StreamingPayload(PixelPlacement)
+
+		StreamingResult(SessionEvent)
+
+		HTTP(func() {
+			GET("/canvases/{id}/session")
+			Param("token:access_token")
+		})
+	})
+
+	Method("PixelPlace", func() {
+		Security(JWTAuth, func() {
+			Scope("canvas:place")
+		})
+
+		Payload(func() {
+			Token("token", String)
+			Field(1, "x", Int32, func() {
+				Minimum(0)
+			})
+			Field(2, "y", Int32, func() {
+				Minimum(0)
+			})
+			Field(3, "color", Int32, func() {
+				Minimum(0)
+				Maximum(255)
+			})
+			CanvasID(4)
+			Required("token", "id", "x", "y", "color")
+		})
+
+		Result(Pixel)
+
+		Error(ErrCodeCooldownActive, CooldownError)
+		Error(ErrCodeCanvasArchived)
+
+		HTTP(func() {
+			POST("/canvases/{id}/pixels")
+			Response(StatusCreated)
+			Response(ErrCodeCooldownActive, StatusTooManyRequests, func() {
+				Header("retry_after:Retry-After")
+			})
+			Response(ErrCodeCanvasArchived, StatusConflict)
+		})
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response(ErrCodeCooldownActive, CodeResourceExhausted)
+			Response(ErrCodeCanvasArchived, CodeFailedPrecondition)
+		})
+	})
+
+	Method("PixelInfoGet", func() {
+		NoSecurity()
+
+		Payload(func() {
+			Field(1, "x", Int32, func() {
+				Minimum(0)
+			})
+			Field(2, "y", Int32, func() {
+				Minimum(0)
+			})
+			Field(3, "limit", Int32, "Maximum number of placements to return.", func() {
+				Default(10)
+				Minimum(1)
+				Maximum(MaxPixelHistory)
+			})
+			CanvasID(4)
+			Required("id", "x", "y")
+		})
+
+		Result(PixelInfo)
+
+		HTTP(func() {
+			GET("/canvases/{id}/pixels/{x}/{y}")
+			Param("limit")
 			Response(StatusOK)
 		})
 
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"fmt"
+	"io"
 	"net/http"
 	"time"
 
+	"github.com/gorilla/websocket"
 	cli "github.com/jace-ys/pikcel/api/v1/gen/http/cli/pikcel"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -25,11 +28,28 @@ func doHTTP(scheme, host string, timeout int, debug bool) (goa.Endpoint, any, er
 		host,
 		doer,
 		goahttp.RequestEncoder,
-		goahttp.ResponseDecoder,
+		responseDecoder,
 		debug,
+		websocket.DefaultDialer,
+		nil,
 	)
 }
 
+func responseDecoder(resp *http.Response) goahttp.Decoder {
+	if resp.Header.Get("Content-Type") == "application/octet-stream" {
+		return goahttp.EncodingFunc(func(v any) error {
+			b, ok := v.(*[]byte)
+			if !ok {
+				return fmt.Errorf("cannot decode application/octet-stream into %T", v)
+			}
+			var err error
+			*b, err = io.ReadAll(resp.Body)
+			return err
+		})
+	}
+	return goahttp.ResponseDecoder(resp)
+}
+
 func httpUsageCommands() []string {
 	return cli.UsageCommands()
 }
@@ -3,8 +3,10 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"slices"
@@ -12,6 +14,8 @@ import (
 	"strings"
 
 	goa "goa.design/goa/v3/pkg"
+
+	"github.com/jace-ys/pikcel/api/v1/gen/api"
 )
 
 func main() {
@@ -87,12 +91,70 @@ func main() {
 		os.Exit(1)
 	}
 
-	data, err := endpoint(context.Background(), payload)
+	ctx := context.Background()
+	data, err := endpoint(ctx, payload)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	switch stream := data.(type) {
+	case httpStream[*api.CanvasEvent]:
+		err = printStream(func() (*api.CanvasEvent, error) { return stream.Recv(ctx) })
+	case api.CanvasSubscribeClientStream:
+		err = printStream(func() (*api.CanvasEvent, error) { return stream.RecvWithContext(ctx) })
+	case api.CanvasSessionClientStream:
+		go sendPlacements(stream)
+		err = printStream(func() (*api.SessionEvent, error) { return stream.RecvWithContext(ctx) })
+	default:
+		printData(data)
+	}
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
+}
+
+type httpStream[T any] interface {
+	Recv(ctx context.Context) (T, error)
+}
+
+// sendPlacements reads placements as JSON values from stdin and sends them over
+// the session until stdin is exhausted.
+func sendPlacements(stream api.CanvasSessionClientStream) {
+	dec := json.NewDecoder(os.Stdin)
+	for {
+		var p api.PixelPlacement
+		if err := dec.Decode(&p); err != nil {
+			if !errors.Is(err, io.EOF) {
+				fmt.Fprintln(os.Stderr, err.Error())
+			}
+			return
+		}
+		if err := stream.Send(&p); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return
+		}
+	}
+}
+
+func printStream[T comparable](recv func() (T, error)) error {
+	var zero T
+	for {
+		data, err := recv()
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return err
+		case data == zero:
+			return nil
+		}
+		printData(data)
+	}
+}
 
+func printData(data any) {
 	if data != nil {
 		m, _ := json.MarshalIndent(data, "", "    ")
 		fmt.Println(string(m))
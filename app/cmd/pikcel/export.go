@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/storage/postgres"
+	"github.com/jace-ys/pikcel/internal/timelapse"
+)
+
+type ExportCmd struct {
+	Timelapse ExportTimelapseCmd `cmd:"" help:"Render placements on a canvas as a timelapse."`
+}
+
+type ExportTimelapseCmd struct {
+	Database DatabaseFlags `embed:"" prefix:"database-"`
+
+	CanvasID string `required:"" help:"ID of the canvas to export."`
+
+	FromSeq int64     `help:"Sequence number of the first placement to include."`
+	ToSeq   int64     `help:"Sequence number of the last placement to include."`
+	From    time.Time `help:"Time of the first placement to include, in RFC 3339 format."`
+	To      time.Time `help:"Time of the last placement to include, in RFC 3339 format."`
+
+	Every  string        `default:"100" help:"Interval between frames, either a number of placements or a duration."`
+	Format string        `default:"gif" enum:"gif,png" help:"Output format (${enum}), png writes one file per frame."`
+	Scale  int           `default:"1" help:"Size of each pixel in the output, in pixels."`
+	Delay  time.Duration `default:"100ms" help:"Time each frame is shown for in a GIF."`
+
+	Output string `short:"o" required:"" type:"path" help:"File to write a GIF to, or directory to write PNG frames to."`
+}
+
+func (c *ExportTimelapseCmd) Validate() error {
+	if c.FromSeq < 0 || c.ToSeq < 0 {
+		return errors.New("--from-seq and --to-seq must not be negative")
+	}
+	if c.Delay <= 0 {
+		return errors.New("--delay must be positive")
+	}
+	return nil
+}
+
+func (c *ExportTimelapseCmd) Run(ctx context.Context, _ *Globals) error {
+	interval, err := timelapse.ParseInterval(c.Every)
+	if err != nil {
+		return fmt.Errorf("parse --every: %w", err)
+	}
+
+	canvasID, err := idgen.FromString[idgen.Canvas](c.CanvasID)
+	if err != nil {
+		return fmt.Errorf("parse --canvas-id: %w", err)
+	}
+
+	opts := timelapse.Options{
+		Range: timelapse.Range{
+			FromSeq: c.FromSeq,
+			ToSeq:   c.ToSeq,
+			From:    c.From,
+			To:      c.To,
+		},
+		Interval: interval,
+		Scale:    c.Scale,
+	}
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid options: %w", err)
+	}
+
+	store, err := postgres.NewStore(ctx, c.Database.DSN)
+	if err != nil {
+		return fmt.Errorf("init postgres store: %w", err)
+	}
+	defer store.Close()
+
+	var enc timelapse.Encoder
+	switch c.Format {
+	case "gif":
+		f, err := os.Create(c.Output)
+		if err != nil {
+			return fmt.Errorf("create output file: %w", err)
+		}
+		defer f.Close()
+
+		enc = timelapse.NewGIFEncoder(f, c.Delay)
+	case "png":
+		enc, err = timelapse.NewDirEncoder(c.Output)
+		if err != nil {
+			return fmt.Errorf("init png encoder: %w", err)
+		}
+	}
+
+	frames, err := timelapse.Render(ctx, store, canvasID, opts, enc)
+	if err != nil {
+		return fmt.Errorf("render timelapse: %w", err)
+	}
+
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("write timelapse: %w", err)
+	}
+
+	ctxlog.Print(ctx, "exported timelapse",
+		ctxlog.KV("canvas.id", canvasID.String()),
+		ctxlog.KV("timelapse.frames", frames),
+		ctxlog.KV("timelapse.output", c.Output),
+	)
+
+	return nil
+}
@@ -15,6 +15,8 @@ type RootCmd struct {
 	Globals
 
 	Server  ServerCmd  `cmd:"" help:"Run the pikcel server."`
+	Migrate MigrateCmd `cmd:"" help:"Manage database schema migrations."`
+	Export  ExportCmd  `cmd:"" help:"Export canvas history."`
 	Version VersionCmd `cmd:"" help:"Show version information."`
 }
 
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"text/tabwriter"
+
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/storage/postgres"
+)
+
+type MigrateCmd struct {
+	Up     MigrateUpCmd     `cmd:"" help:"Apply all pending migrations."`
+	Down   MigrateDownCmd   `cmd:"" help:"Roll back the most recently applied migration."`
+	Status MigrateStatusCmd `cmd:"" help:"Show the status of all migrations."`
+}
+
+type DatabaseFlags struct {
+	DSN string `env:"DATABASE_DSN" required:"" help:"Connection string of the Postgres database."`
+}
+
+type MigrateUpCmd struct {
+	Database DatabaseFlags `embed:"" prefix:"database-"`
+}
+
+func (c *MigrateUpCmd) Run(ctx context.Context, _ *Globals) error {
+	return withMigrator(ctx, c.Database, func(m *postgres.Migrator) error {
+		results, err := m.Up(ctx)
+		if err != nil {
+			return fmt.Errorf("migrate up: %w", err)
+		}
+
+		for _, res := range results {
+			ctxlog.Print(ctx, "applied migration",
+				ctxlog.KV("migration.version", res.Source.Version),
+				ctxlog.KV("migration.source", path.Base(res.Source.Path)),
+				ctxlog.KV("migration.duration", res.Duration.String()),
+			)
+		}
+		ctxlog.Print(ctx, "database schema is up to date")
+
+		return nil
+	})
+}
+
+type MigrateDownCmd struct {
+	Database DatabaseFlags `embed:"" prefix:"database-"`
+}
+
+func (c *MigrateDownCmd) Run(ctx context.Context, _ *Globals) error {
+	return withMigrator(ctx, c.Database, func(m *postgres.Migrator) error {
+		res, err := m.Down(ctx)
+		if err != nil {
+			return fmt.Errorf("migrate down: %w", err)
+		}
+
+		ctxlog.Print(ctx, "rolled back migration",
+			ctxlog.KV("migration.version", res.Source.Version),
+			ctxlog.KV("migration.source", path.Base(res.Source.Path)),
+			ctxlog.KV("migration.duration", res.Duration.String()),
+		)
+
+		return nil
+	})
+}
+
+type MigrateStatusCmd struct {
+	Database DatabaseFlags `embed:"" prefix:"database-"`
+}
+
+func (c *MigrateStatusCmd) Run(ctx context.Context, g *Globals) error {
+	return withMigrator(ctx, c.Database, func(m *postgres.Migrator) error {
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("migrate status: %w", err)
+		}
+
+		tw := tabwriter.NewWriter(g.Writer, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tSOURCE\tSTATE\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "-"
+			if !status.AppliedAt.IsZero() {
+				appliedAt = status.AppliedAt.UTC().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", status.Source.Version, path.Base(status.Source.Path), status.State, appliedAt)
+		}
+
+		return tw.Flush() //nolint:wrapcheck
+	})
+}
+
+func withMigrator(ctx context.Context, db DatabaseFlags, fn func(m *postgres.Migrator) error) error {
+	store, err := postgres.NewStore(ctx, db.DSN)
+	if err != nil {
+		return fmt.Errorf("init postgres store: %w", err)
+	}
+	defer store.Close()
+
+	migrator, err := store.Migrator()
+	if err != nil {
+		return fmt.Errorf("init migrator: %w", err)
+	}
+	defer migrator.Close()
+
+	return fn(migrator)
+}
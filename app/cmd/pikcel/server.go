@@ -2,24 +2,87 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"image"
+	"time"
 
 	apiv1 "github.com/jace-ys/pikcel/api/v1"
 	genapi "github.com/jace-ys/pikcel/api/v1/gen/api"
 	apipb "github.com/jace-ys/pikcel/api/v1/gen/grpc/api/pb"
 	grpcapi "github.com/jace-ys/pikcel/api/v1/gen/grpc/api/server"
 	httpapi "github.com/jace-ys/pikcel/api/v1/gen/http/api/server"
+	"github.com/jace-ys/pikcel/internal/authn"
+	"github.com/jace-ys/pikcel/internal/canvas"
 	"github.com/jace-ys/pikcel/internal/ctxlog"
 	"github.com/jace-ys/pikcel/internal/endpoint"
+	"github.com/jace-ys/pikcel/internal/eventbus"
+	"github.com/jace-ys/pikcel/internal/handler/admin"
 	"github.com/jace-ys/pikcel/internal/handler/api"
+	"github.com/jace-ys/pikcel/internal/hub"
+	"github.com/jace-ys/pikcel/internal/idgen"
 	"github.com/jace-ys/pikcel/internal/instrument"
 	"github.com/jace-ys/pikcel/internal/service"
+	"github.com/jace-ys/pikcel/internal/snapshot"
+	"github.com/jace-ys/pikcel/internal/storage/memory"
+	"github.com/jace-ys/pikcel/internal/storage/postgres"
 	goatransport "github.com/jace-ys/pikcel/internal/transport/goa"
 )
 
 type ServerCmd struct {
 	Port      int `default:"8080" env:"PORT" help:"Port to listen on for the HTTP server."`
 	AdminPort int `default:"9090" env:"ADMIN_PORT" help:"Port to listen on for the admin server."`
+
+	HTTP struct {
+		AllowedOrigins []string `env:"HTTP_ALLOWED_ORIGINS" help:"Origins, such as https://example.com, allowed to open websocket connections from a browser besides the server's own. Use * to allow any origin."`
+	} `embed:"" prefix:"http-"`
+
+	Auth struct {
+		JWTSecret string `env:"AUTH_JWT_SECRET" required:"" help:"Secret used to verify HS256-signed access tokens."`
+	} `embed:"" prefix:"auth-"`
+
+	Store string `default:"memory" enum:"memory,postgres" env:"STORE" help:"Storage backend for canvases and placements (${enum}). The postgres backend requires --database-dsn."`
+
+	Database struct {
+		DSN string `env:"DATABASE_DSN" help:"Connection string of the Postgres database."`
+
+		RequireMigrated bool `env:"DATABASE_REQUIRE_MIGRATED" help:"Refuse to start if the database has pending migrations."`
+	} `embed:"" prefix:"database-"`
+
+	EventBus string `default:"inprocess" enum:"inprocess,postgres" env:"EVENT_BUS" help:"Event bus used to fan out placements across instances (${enum})."`
+
+	Hub struct {
+		BufferSize         int    `default:"256" env:"HUB_BUFFER_SIZE" help:"Number of updates buffered for each live update subscriber."`
+		SlowConsumerPolicy string `default:"coalesce" enum:"drop,coalesce,disconnect" env:"HUB_SLOW_CONSUMER_POLICY" help:"What to do when a subscriber's buffer is full (${enum})."`
+		ReplayLimit        int    `default:"1000" env:"HUB_REPLAY_LIMIT" help:"Maximum number of missed placements replayed to a resuming subscriber, beyond which a snapshot is sent instead."`
+	} `embed:"" prefix:"hub-"`
+
+	Snapshot struct {
+		Interval time.Duration `default:"1m" env:"SNAPSHOT_INTERVAL" help:"How often to compact placements into a canvas snapshot."`
+		Retain   int           `default:"3" env:"SNAPSHOT_RETAIN" help:"Number of snapshots to keep for each canvas."`
+	} `embed:"" prefix:"snapshot-"`
+
+	Tiles struct {
+		MaxAge time.Duration `default:"10s" env:"TILES_MAX_AGE" help:"How long clients and CDNs may cache canvas map tiles before revalidating them."`
+	} `embed:"" prefix:"tiles-"`
+
+	Cooldown time.Duration `default:"5m" env:"PLACEMENT_COOLDOWN" help:"Minimum time a user must wait between pixel placements."`
+
+	Canvas struct {
+		Width   int      `default:"100" env:"CANVAS_WIDTH" help:"Width of the canvas created when there are none yet, in pixels."`
+		Height  int      `default:"100" env:"CANVAS_HEIGHT" help:"Height of the canvas created when there are none yet, in pixels."`
+		Palette []string `env:"CANVAS_PALETTE" help:"Ordered list of #RRGGBB colors available on the canvas created when there are none yet."`
+	} `embed:"" prefix:"canvas-"`
+}
+
+func (c *ServerCmd) Validate() error {
+	if c.Store == "postgres" && c.Database.DSN == "" {
+		return errors.New("--database-dsn must be set when using the postgres store")
+	}
+	if c.EventBus == "postgres" && c.Store != "postgres" {
+		return errors.New("--store must be postgres when using the postgres event bus")
+	}
+	return nil
 }
 
 func (c *ServerCmd) Run(ctx context.Context, g *Globals) error {
@@ -38,16 +101,83 @@ func (c *ServerCmd) Run(ctx context.Context, g *Globals) error {
 	adminSrv := service.NewAdminServer(ctx, c.AdminPort, g.Debug)
 	adminSrv.Administer(httpSrv, grpcSrv)
 
-	handler, err := api.NewHandler()
+	var repo canvas.Repository
+	switch c.Store {
+	case "memory":
+		repo = memory.NewStore()
+	case "postgres":
+		store, err := postgres.NewStore(ctx, c.Database.DSN)
+		if err != nil {
+			return fmt.Errorf("init postgres store: %w", err)
+		}
+		defer store.Close()
+		adminSrv.Administer(store)
+
+		if c.Database.RequireMigrated {
+			if err := checkMigrated(ctx, store); err != nil {
+				return err
+			}
+		}
+
+		repo = store
+	}
+
+	servers := []service.Server{httpSrv, grpcSrv, adminSrv}
+
+	var (
+		bus   eventbus.Bus
+		pgBus *eventbus.Postgres
+	)
+	switch c.EventBus {
+	case "inprocess":
+		bus = eventbus.NewInProcess()
+	case "postgres":
+		var err error
+		pgBus, err = eventbus.NewPostgres(ctx, c.Database.DSN)
+		if err != nil {
+			return fmt.Errorf("init postgres event bus: %w", err)
+		}
+		defer pgBus.Close()
+		adminSrv.Administer(pgBus)
+
+		servers = append(servers, pgBus)
+		bus = pgBus
+	}
+
+	if err := c.ensureCanvas(ctx, repo); err != nil {
+		return fmt.Errorf("ensure canvas: %w", err)
+	}
+
+	servers = append(servers, snapshot.NewSnapshotter(repo, c.Snapshot.Interval, c.Snapshot.Retain))
+
+	auth := authn.NewJWTAuthenticator(c.Auth.JWTSecret)
+
+	policy, err := hub.ParsePolicy(c.Hub.SlowConsumerPolicy)
+	if err != nil {
+		return fmt.Errorf("parse slow consumer policy: %w", err)
+	}
+
+	newUpdates := func() *hub.Hub[canvas.Placement, image.Point] {
+		return hub.New("placements", c.Hub.BufferSize, policy, canvas.Placement.Point)
+	}
+
+	handler, err := api.NewHandler(auth, repo, bus, c.Cooldown, newUpdates, c.Hub.ReplayLimit, c.Tiles.MaxAge)
 	if err != nil {
 		return fmt.Errorf("init api handler: %w", err)
 	}
+	defer handler.Close()
 	adminSrv.Administer(handler)
 
+	if pgBus != nil {
+		pgBus.OnListen(handler.Resync)
+	}
+
+	admin.NewHandler(repo).Register(adminSrv)
+
 	ep := endpoint.Goa(genapi.NewEndpoints).Adapt(handler)
 
 	{
-		transport := goatransport.HTTP(httpapi.New, httpapi.Mount)
+		transport := goatransport.HTTP(httpapi.New, httpapi.Mount, c.HTTP.AllowedOrigins)
 		httpSrv.RegisterHandler(transport.Adapt(ep, apiv1.OpenAPIFS))
 	}
 	{
@@ -55,10 +185,59 @@ func (c *ServerCmd) Run(ctx context.Context, g *Globals) error {
 		grpcSrv.RegisterHandler(&apipb.API_ServiceDesc, transport.Adapt(ep))
 	}
 
-	if err := service.New(httpSrv, grpcSrv, adminSrv).Run(ctx); err != nil {
+	if err := service.New(servers...).Run(ctx); err != nil {
 		ctxlog.Error(ctx, "encountered error while running service", err)
 		return fmt.Errorf("service run: %w", err)
 	}
 
 	return nil
 }
+
+// ensureCanvas creates a canvas from the canvas flags if there are none yet, so
+// that a new deployment has a canvas to place pixels on. Replicas starting at
+// the same time create only one canvas between them.
+func (c *ServerCmd) ensureCanvas(ctx context.Context, repo canvas.Repository) error {
+	palette := canvas.DefaultPalette
+	if len(c.Canvas.Palette) > 0 {
+		var err error
+		palette, err = canvas.ParsePalette(c.Canvas.Palette)
+		if err != nil {
+			return fmt.Errorf("parse palette: %w", err)
+		}
+	}
+
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), c.Canvas.Width, c.Canvas.Height, palette)
+	if err != nil {
+		return fmt.Errorf("init canvas: %w", err)
+	}
+
+	created, err := repo.CreateInitialCanvas(ctx, cnv)
+	if err != nil {
+		return fmt.Errorf("create canvas: %w", err)
+	}
+	if !created {
+		return nil
+	}
+
+	ctxlog.Print(ctx, "created new canvas", ctxlog.KV("canvas.id", cnv.ID().String()))
+	return nil
+}
+
+func checkMigrated(ctx context.Context, store *postgres.Store) error {
+	migrator, err := store.Migrator()
+	if err != nil {
+		return fmt.Errorf("init migrator: %w", err)
+	}
+	defer migrator.Close()
+
+	pending, err := migrator.HasPending(ctx)
+	if err != nil {
+		return fmt.Errorf("check pending migrations: %w", err)
+	}
+
+	if pending {
+		return errors.New("database has pending migrations, run `pikcel migrate up` first")
+	}
+
+	return nil
+}
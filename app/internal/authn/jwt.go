@@ -0,0 +1,80 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+var (
+	ErrInvalidToken  = errors.New("invalid token")
+	ErrMissingScopes = errors.New("missing required scopes")
+)
+
+type JWTAuthenticator struct {
+	secret []byte
+}
+
+func NewJWTAuthenticator(secret string) *JWTAuthenticator {
+	return &JWTAuthenticator{
+		secret: []byte(secret),
+	}
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Scope string `json:"scope,omitempty"`
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string, requiredScopes []string) (context.Context, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(_ *jwt.Token) (any, error) {
+		return a.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return ctx, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	userID, err := idgen.FromString[idgen.User](c.Subject)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: subject: %w", ErrInvalidToken, err)
+	}
+
+	scopes := strings.Fields(c.Scope)
+	for _, scope := range requiredScopes {
+		if !slices.Contains(scopes, scope) {
+			return ctx, fmt.Errorf("%w: %q", ErrMissingScopes, scope)
+		}
+	}
+
+	return newUserID(ctx, userID), nil
+}
+
+type ctxKeyUserID struct{}
+
+func newUserID(ctx context.Context, userID idgen.ID[idgen.User]) context.Context {
+	ctx = context.WithValue(ctx, ctxKeyUserID{}, userID)
+	ctx = ctxlog.With(ctx, ctxlog.KV("user.id", userID.String()))
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("user.id", userID.String()))
+
+	return ctx
+}
+
+func UserIDFromContext(ctx context.Context) idgen.ID[idgen.User] {
+	userID, ok := ctx.Value(ctxKeyUserID{}).(idgen.ID[idgen.User])
+	if !ok {
+		return idgen.ID[idgen.User]{}
+	}
+	return userID
+}
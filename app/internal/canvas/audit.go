@@ -0,0 +1,19 @@
+package canvas
+
+import (
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+const AuditActionRollback = "rollback"
+
+// AuditEntry records an action taken by a moderator on a canvas.
+type AuditEntry struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Action   string
+	// UserID is the ID of the moderator who took the action.
+	UserID    idgen.ID[idgen.User]
+	Details   string
+	CreatedAt time.Time
+}
@@ -0,0 +1,148 @@
+package canvas
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"sync/atomic"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+var (
+	ErrNotFound      = errors.New("canvas not found")
+	ErrAlreadyExists = errors.New("canvas already exists")
+	ErrArchived      = errors.New("canvas is archived")
+	ErrProtected     = errors.New("pixel is in a protected region")
+	ErrOutOfBounds   = errors.New("coordinates out of canvas bounds")
+	ErrInvalidColor  = errors.New("color not in canvas palette")
+
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	ErrRegionNotFound   = errors.New("protected region not found")
+)
+
+// Canvas holds its pixels in chunks that are locked and versioned
+// independently, so that placements in different areas do not contend.
+type Canvas struct {
+	id      idgen.ID[idgen.Canvas]
+	width   int
+	height  int
+	palette Palette
+
+	// version is incremented each time the canvas is resized, at the sequence
+	// number resizedSeq.
+	version    int
+	resizedSeq int64
+
+	chunkCols int
+	chunks    []*chunk
+	seq       atomic.Int64
+}
+
+func New(id idgen.ID[idgen.Canvas], width, height int, palette Palette) (*Canvas, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid canvas dimensions %dx%d", width, height)
+	}
+
+	if len(palette) == 0 || len(palette) > MaxPaletteSize {
+		return nil, fmt.Errorf("palette must have between 1 and %d colors", MaxPaletteSize)
+	}
+
+	return &Canvas{
+		id:        id,
+		width:     width,
+		height:    height,
+		palette:   palette,
+		version:   1,
+		chunkCols: chunkCount(width),
+		chunks:    newChunks(width, height),
+	}, nil
+}
+
+func (c *Canvas) ID() idgen.ID[idgen.Canvas] {
+	return c.id
+}
+
+func (c *Canvas) Width() int {
+	return c.width
+}
+
+func (c *Canvas) Height() int {
+	return c.height
+}
+
+func (c *Canvas) Palette() Palette {
+	return c.palette
+}
+
+func (c *Canvas) Pixel(x, y int) (uint8, error) {
+	if !c.inBounds(x, y) {
+		return 0, ErrOutOfBounds
+	}
+
+	ch := c.chunkAt(x, y)
+	ch.mu.RLock()
+	defer ch.mu.RUnlock()
+
+	return ch.pixels[ch.offset(x, y)], nil
+}
+
+func (c *Canvas) Pixels() []byte {
+	pixels, _ := c.Capture()
+	return pixels
+}
+
+// Capture returns a copy of every pixel on the canvas, along with the sequence
+// number of the latest placement applied to them.
+func (c *Canvas) Capture() ([]byte, int64) {
+	c.rlockAll()
+	defer c.runlockAll()
+
+	bounds := image.Rect(0, 0, c.width, c.height)
+	pixels := make([]byte, c.width*c.height)
+	for _, ch := range c.chunks {
+		ch.copyTo(pixels, bounds, ch.bounds)
+	}
+	return pixels, c.seq.Load()
+}
+
+// Seq returns the sequence number of the latest placement applied to the
+// canvas.
+func (c *Canvas) Seq() int64 {
+	return c.seq.Load()
+}
+
+func (c *Canvas) Region(x, y, width, height int) ([]byte, error) {
+	if width <= 0 || height <= 0 || !c.inBounds(x, y) || !c.inBounds(x+width-1, y+height-1) {
+		return nil, ErrOutOfBounds
+	}
+
+	region := image.Rect(x, y, x+width, y+height)
+	pixels := make([]byte, width*height)
+	for _, ch := range c.chunks {
+		r := ch.bounds.Intersect(region)
+		if r.Empty() {
+			continue
+		}
+
+		ch.mu.RLock()
+		ch.copyTo(pixels, region, r)
+		ch.mu.RUnlock()
+	}
+	return pixels, nil
+}
+
+// advance moves the sequence number of the canvas forward to seq, if it is not
+// already past it.
+func (c *Canvas) advance(seq int64) {
+	for {
+		current := c.seq.Load()
+		if seq <= current || c.seq.CompareAndSwap(current, seq) {
+			return
+		}
+	}
+}
+
+func (c *Canvas) inBounds(x, y int) bool {
+	return x >= 0 && x < c.width && y >= 0 && y < c.height
+}
@@ -0,0 +1,185 @@
+package canvas
+
+import (
+	"image"
+	"math"
+	"sync"
+)
+
+// ChunkSize is the width and height of the chunks a canvas is split into.
+// Chunks on the right and bottom edges of a canvas may be smaller.
+const ChunkSize = 64
+
+// Chunk is a copy of the pixels in one chunk of a canvas. X and Y are chunk
+// coordinates, so the chunk starts at pixel (X*ChunkSize, Y*ChunkSize).
+type Chunk struct {
+	X       int
+	Y       int
+	Version int64
+	Pixels  []byte
+}
+
+// chunk holds the pixels of one chunk of a canvas, which are locked
+// independently of the rest of the canvas. Its version is the sequence number
+// of the latest placement applied to it.
+//
+// The sequence number each pixel was last set at is base, at which the whole
+// chunk was last restored or resized, plus its entry in seqs. Storing offsets
+// from base keeps seqs to four bytes a pixel, and it is only allocated once a
+// placement is applied to the chunk.
+type chunk struct {
+	bounds image.Rectangle
+
+	mu      sync.RWMutex
+	pixels  []byte
+	version int64
+	base    int64
+	seqs    []uint32
+}
+
+func newChunks(width, height int) []*chunk {
+	cols, rows := chunkCount(width), chunkCount(height)
+
+	chunks := make([]*chunk, 0, cols*rows)
+	for cy := range rows {
+		for cx := range cols {
+			bounds := image.Rect(cx*ChunkSize, cy*ChunkSize, (cx+1)*ChunkSize, (cy+1)*ChunkSize).
+				Intersect(image.Rect(0, 0, width, height))
+			chunks = append(chunks, &chunk{
+				bounds: bounds,
+				pixels: make([]byte, bounds.Dx()*bounds.Dy()),
+			})
+		}
+	}
+	return chunks
+}
+
+func chunkCount(length int) int {
+	return (length + ChunkSize - 1) / ChunkSize
+}
+
+func (ch *chunk) offset(x, y int) int {
+	return (y-ch.bounds.Min.Y)*ch.bounds.Dx() + (x - ch.bounds.Min.X)
+}
+
+// reset marks every pixel of the chunk as set at seq. It must be called with
+// the chunk locked.
+func (ch *chunk) reset(seq int64) {
+	ch.version = seq
+	ch.base = seq
+	ch.seqs = nil
+}
+
+// pixelSeq returns the sequence number the pixel at offset i was last set at.
+// It must be called with the chunk locked.
+func (ch *chunk) pixelSeq(i int) int64 {
+	if ch.seqs == nil {
+		return ch.base
+	}
+	return ch.base + int64(ch.seqs[i])
+}
+
+// setPixel sets the pixel at offset i to color as of seq, which must not be
+// before base. It must be called with the chunk locked.
+func (ch *chunk) setPixel(i int, color uint8, seq int64) {
+	if seq-ch.base > math.MaxUint32 {
+		// Rather than widen the offsets of a chunk billions of placements
+		// after it was restored, every pixel of the chunk is taken to have
+		// been set as late as the offsets can still reach from seq.
+		ch.base = max(ch.version, seq-math.MaxUint32)
+		ch.seqs = nil
+	}
+	if ch.seqs == nil {
+		ch.seqs = make([]uint32, len(ch.pixels))
+	}
+
+	ch.pixels[i] = color
+	ch.seqs[i] = uint32(seq - ch.base) //nolint:gosec
+	ch.version = max(ch.version, seq)
+}
+
+// copyTo copies the pixels of the chunk within r into dst, a row-major buffer
+// of the pixels in dstRect. It must be called with the chunk locked.
+func (ch *chunk) copyTo(dst []byte, dstRect, r image.Rectangle) {
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		src := ch.pixels[ch.offset(r.Min.X, y):][:r.Dx()]
+		copy(dst[(y-dstRect.Min.Y)*dstRect.Dx()+(r.Min.X-dstRect.Min.X):], src)
+	}
+}
+
+func (ch *chunk) snapshot() Chunk {
+	pixels := make([]byte, len(ch.pixels))
+	copy(pixels, ch.pixels)
+
+	return Chunk{
+		X:       ch.bounds.Min.X / ChunkSize,
+		Y:       ch.bounds.Min.Y / ChunkSize,
+		Version: ch.version,
+		Pixels:  pixels,
+	}
+}
+
+// ChunkBounds returns the pixels covered by the chunk at the given chunk
+// coordinates, which is empty if the chunk is outside the canvas.
+func (c *Canvas) ChunkBounds(cx, cy int) image.Rectangle {
+	if cx < 0 || cx >= c.chunkCols || cy < 0 || cy*ChunkSize >= c.height {
+		return image.Rectangle{}
+	}
+	return c.chunks[cy*c.chunkCols+cx].bounds
+}
+
+// ChunkCount returns the number of chunks the canvas is split into.
+func (c *Canvas) ChunkCount() int {
+	return len(c.chunks)
+}
+
+// ChunkIndex returns the index of the chunk containing a pixel, counting chunks
+// in row-major order.
+func (c *Canvas) ChunkIndex(x, y int) int {
+	return (y/ChunkSize)*c.chunkCols + x/ChunkSize
+}
+
+// ChunksSince returns copies of the chunks modified by placements after the
+// given sequence number, along with the sequence number the canvas is at.
+func (c *Canvas) ChunksSince(seq int64) ([]Chunk, int64) {
+	c.rlockAll()
+	defer c.runlockAll()
+
+	var chunks []Chunk
+	for _, ch := range c.chunks {
+		if ch.version > seq {
+			chunks = append(chunks, ch.snapshot())
+		}
+	}
+	return chunks, c.seq.Load()
+}
+
+func (c *Canvas) chunkAt(x, y int) *chunk {
+	return c.chunks[c.ChunkIndex(x, y)]
+}
+
+// lockAll and rlockAll lock every chunk in order, for operations that need a
+// consistent view of the whole canvas.
+func (c *Canvas) lockAll() {
+	for _, ch := range c.chunks {
+		ch.mu.Lock()
+	}
+}
+
+func (c *Canvas) unlockAll() {
+	for _, ch := range c.chunks {
+		ch.mu.Unlock()
+	}
+}
+
+func (c *Canvas) rlockAll() {
+	for _, ch := range c.chunks {
+		ch.mu.RLock()
+	}
+}
+
+func (c *Canvas) runlockAll() {
+	for _, ch := range c.chunks {
+		ch.mu.RUnlock()
+	}
+}
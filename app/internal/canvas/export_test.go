@@ -0,0 +1,20 @@
+package canvas
+
+// SetPixel sets a pixel without recording a placement, leaving the versions of
+// its chunk untouched, so that tests can set up the state of a canvas directly.
+func (c *Canvas) SetPixel(x, y int, color uint8) error {
+	if !c.inBounds(x, y) {
+		return ErrOutOfBounds
+	}
+
+	if !c.palette.Contains(color) {
+		return ErrInvalidColor
+	}
+
+	ch := c.chunkAt(x, y)
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.pixels[ch.offset(x, y)] = color
+	return nil
+}
@@ -0,0 +1,43 @@
+package canvas
+
+import (
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// Info describes a canvas as stored, without its pixels.
+type Info struct {
+	ID         idgen.ID[idgen.Canvas]
+	Width      int
+	Height     int
+	Palette    Palette
+	Seq        int64
+	Version    int
+	ResizedSeq int64
+	CreatedAt  time.Time
+	ArchivedAt time.Time
+
+	// ProtectedRegions are the regions of the canvas that are protected, in
+	// the order they were created.
+	ProtectedRegions []ProtectedRegion
+}
+
+// Archived reports whether the canvas has been archived, after which no more
+// pixels can be placed on it.
+func (i Info) Archived() bool {
+	return !i.ArchivedAt.IsZero()
+}
+
+// FromInfo returns a blank canvas with the dimensions of a stored canvas, to
+// restore its pixels onto.
+func FromInfo(info Info) (*Canvas, error) {
+	cnv, err := New(info.ID, info.Width, info.Height, info.Palette)
+	if err != nil {
+		return nil, err
+	}
+
+	cnv.version = max(info.Version, 1)
+	cnv.resizedSeq = info.ResizedSeq
+	return cnv, nil
+}
@@ -0,0 +1,67 @@
+package canvas
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+)
+
+const MaxPaletteSize = 256
+
+type Palette []color.RGBA
+
+var DefaultPalette = Palette{
+	{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	{R: 0xE4, G: 0xE4, B: 0xE4, A: 0xFF},
+	{R: 0x88, G: 0x88, B: 0x88, A: 0xFF},
+	{R: 0x22, G: 0x22, B: 0x22, A: 0xFF},
+	{R: 0xFF, G: 0xA7, B: 0xD1, A: 0xFF},
+	{R: 0xE5, G: 0x00, B: 0x00, A: 0xFF},
+	{R: 0xE5, G: 0x95, B: 0x00, A: 0xFF},
+	{R: 0xA0, G: 0x6A, B: 0x42, A: 0xFF},
+	{R: 0xE5, G: 0xD9, B: 0x00, A: 0xFF},
+	{R: 0x94, G: 0xE0, B: 0x44, A: 0xFF},
+	{R: 0x02, G: 0xBE, B: 0x01, A: 0xFF},
+	{R: 0x00, G: 0xD3, B: 0xDD, A: 0xFF},
+	{R: 0x00, G: 0x83, B: 0xC7, A: 0xFF},
+	{R: 0x00, G: 0x00, B: 0xEA, A: 0xFF},
+	{R: 0xCF, G: 0x6E, B: 0xE4, A: 0xFF},
+	{R: 0x82, G: 0x00, B: 0x80, A: 0xFF},
+}
+
+func ParsePalette(hexes []string) (Palette, error) {
+	palette := make(Palette, 0, len(hexes))
+	for _, hex := range hexes {
+		c, err := ParseColor(hex)
+		if err != nil {
+			return nil, err
+		}
+		palette = append(palette, c)
+	}
+	return palette, nil
+}
+
+func ParseColor(hex string) (color.RGBA, error) {
+	if len(hex) != 7 || hex[0] != '#' {
+		return color.RGBA{}, fmt.Errorf("invalid color %q: must be of the form #RRGGBB", hex)
+	}
+
+	rgb, err := strconv.ParseUint(hex[1:], 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("invalid color %q: %w", hex, err)
+	}
+
+	return color.RGBA{R: uint8(rgb >> 16), G: uint8(rgb >> 8), B: uint8(rgb), A: 0xFF}, nil //nolint:gosec
+}
+
+func (p Palette) Hex() []string {
+	hexes := make([]string, 0, len(p))
+	for _, c := range p {
+		hexes = append(hexes, fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B))
+	}
+	return hexes
+}
+
+func (p Palette) Contains(idx uint8) bool {
+	return int(idx) < len(p)
+}
@@ -0,0 +1,50 @@
+package canvas
+
+import (
+	"image"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+type Placement struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	X        int
+	Y        int
+	Color    uint8
+	UserID   idgen.ID[idgen.User]
+	PlacedAt time.Time
+	Seq      int64
+}
+
+func (p Placement) Point() image.Point {
+	return image.Pt(p.X, p.Y)
+}
+
+// Apply sets the pixel of a placement and advances the sequence number of the
+// canvas, and the version of the chunk containing the pixel, to that of the
+// placement. Placements received out of order are ignored if the pixel has
+// already been set by a later placement, and Apply reports whether the
+// placement was applied.
+func (c *Canvas) Apply(p Placement) (bool, error) {
+	if !c.inBounds(p.X, p.Y) {
+		return false, ErrOutOfBounds
+	}
+
+	if !c.palette.Contains(p.Color) {
+		return false, ErrInvalidColor
+	}
+
+	ch := c.chunkAt(p.X, p.Y)
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	i := ch.offset(p.X, p.Y)
+	if p.Seq < ch.pixelSeq(i) {
+		return false, nil
+	}
+
+	ch.setPixel(i, p.Color, p.Seq)
+	c.advance(p.Seq)
+	return true, nil
+}
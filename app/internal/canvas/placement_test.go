@@ -0,0 +1,94 @@
+package canvas_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name       string
+		placements []canvas.Placement
+		// wantApplied is whether each placement is applied.
+		wantApplied []bool
+		wantColor   uint8
+	}{
+		{
+			name: "InOrder",
+			placements: []canvas.Placement{
+				{X: 1, Y: 1, Color: 1, Seq: 1},
+				{X: 1, Y: 1, Color: 2, Seq: 2},
+			},
+			wantApplied: []bool{true, true},
+			wantColor:   2,
+		},
+		{
+			name: "Stale",
+			placements: []canvas.Placement{
+				{X: 1, Y: 1, Color: 2, Seq: 2},
+				{X: 1, Y: 1, Color: 1, Seq: 1},
+			},
+			wantApplied: []bool{true, false},
+			wantColor:   2,
+		},
+		{
+			name: "StaleOnOtherPixel",
+			placements: []canvas.Placement{
+				{X: 2, Y: 1, Color: 2, Seq: 2},
+				{X: 1, Y: 1, Color: 1, Seq: 1},
+			},
+			wantApplied: []bool{true, true},
+			wantColor:   1,
+		},
+		{
+			name: "Duplicate",
+			placements: []canvas.Placement{
+				{X: 1, Y: 1, Color: 1, Seq: 1},
+				{X: 1, Y: 1, Color: 1, Seq: 1},
+			},
+			wantApplied: []bool{true, true},
+			wantColor:   1,
+		},
+		{
+			name: "BeyondOffsets",
+			placements: []canvas.Placement{
+				{X: 1, Y: 1, Color: 1, Seq: 1},
+				{X: 2, Y: 1, Color: 2, Seq: math.MaxUint32 + 10},
+				{X: 1, Y: 1, Color: 3, Seq: 5},
+				{X: 1, Y: 1, Color: 4, Seq: math.MaxUint32 + 20},
+			},
+			wantApplied: []bool{true, true, false, true},
+			wantColor:   4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cnv, err := canvas.New(idgen.New[idgen.Canvas](), 4, 4, canvas.DefaultPalette)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+
+			for i, p := range tt.placements {
+				applied, err := cnv.Apply(p)
+				if err != nil {
+					t.Fatalf("Apply: %v", err)
+				}
+				if applied != tt.wantApplied[i] {
+					t.Errorf("Apply(%v): got applied %t, want %t", p, applied, tt.wantApplied[i])
+				}
+			}
+
+			color, err := cnv.Pixel(1, 1)
+			if err != nil {
+				t.Fatalf("Pixel: %v", err)
+			}
+			if color != tt.wantColor {
+				t.Errorf("Pixel: got color %d, want %d", color, tt.wantColor)
+			}
+		})
+	}
+}
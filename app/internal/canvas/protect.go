@@ -0,0 +1,30 @@
+package canvas
+
+import (
+	"image"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// ProtectedRegion is a rectangle of a canvas on which no pixels can be placed,
+// such as a sponsor's logo or an area frozen after being vandalized.
+type ProtectedRegion struct {
+	ID        idgen.ID[idgen.Region]
+	Bounds    image.Rectangle
+	Reason    string
+	CreatedAt time.Time
+	// ExpiresAt is set if the region is only protected until then.
+	ExpiresAt time.Time
+}
+
+// Active reports whether the region is protected at the given time.
+func (r ProtectedRegion) Active(at time.Time) bool {
+	return r.ExpiresAt.IsZero() || at.Before(r.ExpiresAt)
+}
+
+// Protects reports whether the region prevents a pixel from being placed at the
+// given time.
+func (r ProtectedRegion) Protects(x, y int, at time.Time) bool {
+	return image.Pt(x, y).In(r.Bounds) && r.Active(at)
+}
@@ -0,0 +1,47 @@
+package canvas
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+func RenderPNG(w io.Writer, pixels []byte, width, height int, palette Palette, scale int) error {
+	if len(pixels) != width*height {
+		return fmt.Errorf("pixel buffer length %d does not match %dx%d", len(pixels), width, height)
+	}
+
+	if err := png.Encode(w, Image(pixels, width, height, palette, scale)); err != nil {
+		return fmt.Errorf("encode png: %w", err)
+	}
+
+	return nil
+}
+
+func Image(pixels []byte, width, height int, palette Palette, scale int) *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, width*scale, height*scale), palette.ColorPalette())
+
+	for y := range height {
+		for s := range scale {
+			row := img.Pix[(y*scale+s)*img.Stride:]
+			for x := range width {
+				idx := pixels[y*width+x]
+				for t := range scale {
+					row[x*scale+t] = idx
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+func (p Palette) ColorPalette() color.Palette {
+	cp := make(color.Palette, 0, len(p))
+	for _, c := range p {
+		cp = append(cp, c)
+	}
+	return cp
+}
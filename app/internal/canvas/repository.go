@@ -0,0 +1,78 @@
+package canvas
+
+import (
+	"context"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+type Repository interface {
+	CreateCanvas(ctx context.Context, cnv *Canvas) error
+	// CreateInitialCanvas creates cnv if no canvas has been created yet,
+	// reporting whether it did. Concurrent calls create at most one canvas.
+	CreateInitialCanvas(ctx context.Context, cnv *Canvas) (bool, error)
+	GetCanvas(ctx context.Context, id idgen.ID[idgen.Canvas]) (*Canvas, error)
+	GetCanvasInfo(ctx context.Context, id idgen.ID[idgen.Canvas]) (Info, error)
+	// ListCanvases returns canvases in the order they were created, skipping
+	// archived canvases unless includeArchived is set.
+	ListCanvases(ctx context.Context, includeArchived bool) ([]Info, error)
+	// ArchiveCanvas marks a canvas as archived at the given time, keeping the
+	// original time if it is already archived.
+	ArchiveCanvas(ctx context.Context, id idgen.ID[idgen.Canvas], at time.Time) (Info, error)
+	// ResizeCanvas grows a canvas and shifts the coordinates of its placements
+	// along with its pixels, replacing its snapshots with one of the resized
+	// canvas. The resize takes up the next sequence number of the canvas. It
+	// fails with ErrArchived if the canvas is archived.
+	ResizeCanvas(ctx context.Context, id idgen.ID[idgen.Canvas], r Resize) (Info, error)
+
+	// ProtectRegion adds a protected region to a canvas, within which
+	// InsertPlacement fails with ErrProtected until the region expires or is
+	// removed. Resizing the canvas shifts the region along with its pixels.
+	ProtectRegion(ctx context.Context, canvasID idgen.ID[idgen.Canvas], r ProtectedRegion) error
+	// UnprotectRegion removes a protected region from a canvas, failing with
+	// ErrRegionNotFound if there is no such region.
+	UnprotectRegion(ctx context.Context, canvasID idgen.ID[idgen.Canvas], regionID idgen.ID[idgen.Region]) error
+
+	// InsertPlacement stores a placement and returns the sequence number it
+	// was assigned, which is one more than that of the previous placement on
+	// the same canvas. It fails with ErrArchived if the canvas is archived,
+	// with ErrProtected if the pixel is in a region protected at the time of
+	// the placement, and with a *cooldown.ActiveError if the user placed a
+	// pixel on the canvas less than cooldown before. The cooldown is checked
+	// against every placement stored other than those made by rollbacks, so
+	// that it holds across instances.
+	InsertPlacement(ctx context.Context, p Placement, cooldown time.Duration) (int64, error)
+	// ListPlacements returns up to limit placements on a canvas with sequence
+	// numbers after afterSeq, in sequence order.
+	ListPlacements(ctx context.Context, canvasID idgen.ID[idgen.Canvas], afterSeq int64, limit int) ([]Placement, error)
+	// ListPixelPlacements returns up to limit of the latest placements at a
+	// coordinate of a canvas, most recent first.
+	ListPixelPlacements(ctx context.Context, canvasID idgen.ID[idgen.Canvas], x, y, limit int) ([]Placement, error)
+	// RollbackPlacements stores the placements that revert the pixels selected
+	// by a rollback, as given by Rollback.Reverts, as made by a moderator at the
+	// given time. Only placements since the latest resize of the canvas are
+	// reverted, to its pixels as of the resize if there are no others. The
+	// placements are stored atomically along with an entry in the audit log of
+	// the canvas. They are not subject to protected regions, and do not count
+	// towards the cooldown of the moderator. It returns the placements stored,
+	// and fails with ErrArchived if the canvas is archived.
+	RollbackPlacements(ctx context.Context, canvasID idgen.ID[idgen.Canvas], r Rollback, by idgen.ID[idgen.User], at time.Time) ([]Placement, error)
+
+	// ListAuditEntries returns up to limit of the latest entries in the audit
+	// log of a canvas, most recent first.
+	ListAuditEntries(ctx context.Context, canvasID idgen.ID[idgen.Canvas], limit int) ([]AuditEntry, error)
+
+	// InsertSnapshot stores a snapshot, doing nothing if one already exists
+	// for the same canvas and sequence number.
+	InsertSnapshot(ctx context.Context, s Snapshot) error
+	GetLatestSnapshot(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) (Snapshot, error)
+	// GetSnapshot returns the snapshot of a canvas taken at a sequence number,
+	// including every chunk as of then, failing with ErrSnapshotNotFound if
+	// there is none.
+	GetSnapshot(ctx context.Context, canvasID idgen.ID[idgen.Canvas], seq int64) (Snapshot, error)
+	// PruneSnapshots deletes all but the latest keep snapshots of a canvas,
+	// besides the snapshot of its latest resize, which is kept so that the
+	// canvas can be replayed from it.
+	PruneSnapshots(ctx context.Context, canvasID idgen.ID[idgen.Canvas], keep int) error
+}
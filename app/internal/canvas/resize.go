@@ -0,0 +1,68 @@
+package canvas
+
+import (
+	"fmt"
+	"image"
+)
+
+// Resize describes how to grow a canvas, by a number of pixels on each side.
+type Resize struct {
+	Left   int
+	Top    int
+	Right  int
+	Bottom int
+	// Fill is the color of the pixels added to the canvas.
+	Fill uint8
+}
+
+// Version returns the version of the dimensions of the canvas, which starts at
+// 1 and is incremented each time the canvas is resized.
+func (c *Canvas) Version() int {
+	return c.version
+}
+
+// ResizedSeq returns the sequence number of the latest resize of the canvas, or
+// 0 if it has never been resized. Placements before it have had their
+// coordinates shifted along with the pixels they were placed on.
+func (c *Canvas) ResizedSeq() int64 {
+	return c.resizedSeq
+}
+
+// Resize returns a copy of the canvas grown as described by r, with its pixels
+// shifted right by r.Left and down by r.Top. The resize is assigned the
+// sequence number seq, at which every chunk of the resized canvas is versioned
+// since their pixels may all have moved.
+func (c *Canvas) Resize(r Resize, seq int64) (*Canvas, error) {
+	if r.Left < 0 || r.Top < 0 || r.Right < 0 || r.Bottom < 0 {
+		return nil, fmt.Errorf("invalid resize by %d, %d, %d, %d pixels", r.Left, r.Top, r.Right, r.Bottom)
+	}
+
+	if !c.palette.Contains(r.Fill) {
+		return nil, ErrInvalidColor
+	}
+
+	resized, err := New(c.id, c.width+r.Left+r.Right, c.height+r.Top+r.Bottom, c.palette)
+	if err != nil {
+		return nil, err
+	}
+	resized.version = c.version + 1
+	resized.resizedSeq = seq
+
+	pixels, _ := c.Capture()
+	shifted := image.Rect(r.Left, r.Top, r.Left+c.width, r.Top+c.height)
+	for _, ch := range resized.chunks {
+		for i := range ch.pixels {
+			ch.pixels[i] = r.Fill
+		}
+
+		moved := ch.bounds.Intersect(shifted)
+		for y := moved.Min.Y; y < moved.Max.Y; y++ {
+			src := pixels[(y-r.Top)*c.width+(moved.Min.X-r.Left):][:moved.Dx()]
+			copy(ch.pixels[ch.offset(moved.Min.X, y):], src)
+		}
+		ch.reset(seq)
+	}
+	resized.advance(seq)
+
+	return resized, nil
+}
@@ -0,0 +1,126 @@
+package canvas
+
+import (
+	"cmp"
+	"fmt"
+	"image"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// Rollback selects placements to revert, such as those of a user who defaced
+// the canvas. A placement is selected if it matches every filter that is set.
+type Rollback struct {
+	// UserID selects the placements made by a user, if set.
+	UserID idgen.ID[idgen.User]
+	// Bounds selects the placements within a rectangle, if not empty.
+	Bounds image.Rectangle
+	// From and To select the placements made at or after From and before To,
+	// if set.
+	From time.Time
+	To   time.Time
+}
+
+// Matches reports whether a placement is selected by the rollback.
+func (r Rollback) Matches(p Placement) bool {
+	switch {
+	case r.UserID != (idgen.ID[idgen.User]{}) && p.UserID != r.UserID:
+		return false
+	case !r.Bounds.Empty() && !p.Point().In(r.Bounds):
+		return false
+	case !r.From.IsZero() && p.PlacedAt.Before(r.From):
+		return false
+	case !r.To.IsZero() && !p.PlacedAt.Before(r.To):
+		return false
+	}
+	return true
+}
+
+// Reverts returns the placements that revert every pixel whose latest
+// placement is selected by the rollback to the color of the latest placement
+// before it that is not, or to its color on base if there is none. Pixels
+// whose latest placement was made over a selected one are left as they are.
+// Base is the canvas as it was before the history, which must be in sequence
+// order and include every placement since on the pixels it covers. The
+// placements returned only set the coordinates and color of each pixel, in
+// the order of the placements they revert.
+func (r Rollback) Reverts(base *Canvas, history []Placement) []Placement {
+	type pixel struct {
+		latest   Placement
+		selected bool
+		// previous is the color of the latest placement that is not selected,
+		// or of the pixel on base if there is none.
+		previous uint8
+	}
+
+	pixels := make(map[image.Point]*pixel)
+	for _, p := range history {
+		px, ok := pixels[p.Point()]
+		if !ok {
+			px = &pixel{}
+			px.previous, _ = base.Pixel(p.X, p.Y)
+			pixels[p.Point()] = px
+		}
+
+		px.latest = p
+		px.selected = r.Matches(p)
+		if !px.selected {
+			px.previous = p.Color
+		}
+	}
+
+	var selected []Placement
+	for _, px := range pixels {
+		if px.selected && px.latest.Color != px.previous {
+			selected = append(selected, px.latest)
+		}
+	}
+	slices.SortFunc(selected, func(a, b Placement) int {
+		return cmp.Compare(a.Seq, b.Seq)
+	})
+
+	reverts := make([]Placement, len(selected))
+	for i, p := range selected {
+		reverts[i] = Placement{
+			X:     p.X,
+			Y:     p.Y,
+			Color: pixels[p.Point()].previous,
+		}
+	}
+	return reverts
+}
+
+func (r Rollback) String() string {
+	var filters []string
+	if r.UserID != (idgen.ID[idgen.User]{}) {
+		filters = append(filters, "by "+r.UserID.String())
+	}
+	if !r.Bounds.Empty() {
+		filters = append(filters, "within "+r.Bounds.String())
+	}
+	if !r.From.IsZero() {
+		filters = append(filters, "from "+r.From.Format(time.RFC3339))
+	}
+	if !r.To.IsZero() {
+		filters = append(filters, "until "+r.To.Format(time.RFC3339))
+	}
+	if len(filters) == 0 {
+		return "all placements"
+	}
+	return "placements " + strings.Join(filters, ", ")
+}
+
+// AuditEntry returns the entry recording that a moderator reverted a number of
+// pixels with the rollback.
+func (r Rollback) AuditEntry(canvasID idgen.ID[idgen.Canvas], by idgen.ID[idgen.User], at time.Time, reverted int) AuditEntry {
+	return AuditEntry{
+		CanvasID:  canvasID,
+		Action:    AuditActionRollback,
+		UserID:    by,
+		Details:   fmt.Sprintf("reverted %d pixels of %s", reverted, r),
+		CreatedAt: at,
+	}
+}
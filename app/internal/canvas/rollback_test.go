@@ -0,0 +1,181 @@
+package canvas_test
+
+import (
+	"image"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+func TestRollbackReverts(t *testing.T) {
+	alice := idgen.New[idgen.User]()
+	mallory := idgen.New[idgen.User]()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// place is a placement by a user, made a minute after the one before it.
+	type place struct {
+		x, y   int
+		color  uint8
+		userID idgen.ID[idgen.User]
+	}
+
+	tests := []struct {
+		name     string
+		rollback canvas.Rollback
+		// base sets pixels on the base canvas before the history.
+		base    []place
+		history []place
+		want    []canvas.Placement
+	}{
+		{
+			name:     "Empty",
+			rollback: canvas.Rollback{UserID: mallory},
+			want:     []canvas.Placement{},
+		},
+		{
+			name:     "RevertToBase",
+			rollback: canvas.Rollback{UserID: mallory},
+			base:     []place{{x: 0, y: 0, color: 2}},
+			history:  []place{{x: 0, y: 0, color: 5, userID: mallory}},
+			want:     []canvas.Placement{{X: 0, Y: 0, Color: 2}},
+		},
+		{
+			name:     "RevertToPrevious",
+			rollback: canvas.Rollback{UserID: mallory},
+			history: []place{
+				{x: 1, y: 0, color: 3, userID: alice},
+				{x: 1, y: 0, color: 5, userID: mallory},
+			},
+			want: []canvas.Placement{{X: 1, Y: 0, Color: 3}},
+		},
+		{
+			name:     "Interleaved",
+			rollback: canvas.Rollback{UserID: mallory},
+			history: []place{
+				{x: 1, y: 0, color: 3, userID: alice},
+				{x: 1, y: 0, color: 5, userID: mallory},
+				{x: 1, y: 0, color: 4, userID: alice},
+				{x: 1, y: 0, color: 6, userID: mallory},
+				{x: 1, y: 0, color: 7, userID: mallory},
+			},
+			want: []canvas.Placement{{X: 1, Y: 0, Color: 4}},
+		},
+		{
+			name:     "LatestNotSelected",
+			rollback: canvas.Rollback{UserID: mallory},
+			history: []place{
+				{x: 1, y: 0, color: 5, userID: mallory},
+				{x: 1, y: 0, color: 3, userID: alice},
+			},
+			want: []canvas.Placement{},
+		},
+		{
+			name:     "SameColor",
+			rollback: canvas.Rollback{UserID: mallory},
+			history: []place{
+				{x: 1, y: 0, color: 5, userID: alice},
+				{x: 1, y: 0, color: 5, userID: mallory},
+			},
+			want: []canvas.Placement{},
+		},
+		{
+			name:     "SequenceOrder",
+			rollback: canvas.Rollback{UserID: mallory},
+			history: []place{
+				{x: 2, y: 2, color: 5, userID: mallory},
+				{x: 0, y: 0, color: 6, userID: mallory},
+				{x: 2, y: 2, color: 3, userID: alice},
+				{x: 3, y: 1, color: 4, userID: alice},
+				{x: 3, y: 1, color: 7, userID: mallory},
+			},
+			want: []canvas.Placement{{X: 0, Y: 0, Color: 0}, {X: 3, Y: 1, Color: 4}},
+		},
+		{
+			name:     "Bounds",
+			rollback: canvas.Rollback{Bounds: image.Rect(0, 0, 2, 2)},
+			history: []place{
+				{x: 0, y: 0, color: 5, userID: alice},
+				{x: 1, y: 1, color: 6, userID: mallory},
+				{x: 2, y: 2, color: 7, userID: mallory},
+			},
+			want: []canvas.Placement{{X: 0, Y: 0, Color: 0}, {X: 1, Y: 1, Color: 0}},
+		},
+		{
+			name:     "From",
+			rollback: canvas.Rollback{From: start.Add(2 * time.Minute)},
+			history: []place{
+				{x: 0, y: 0, color: 3, userID: alice},
+				{x: 0, y: 0, color: 5, userID: alice},
+			},
+			want: []canvas.Placement{{X: 0, Y: 0, Color: 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, err := canvas.New(idgen.New[idgen.Canvas](), 4, 4, canvas.DefaultPalette)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			for _, p := range tt.base {
+				if err := base.SetPixel(p.x, p.y, p.color); err != nil {
+					t.Fatalf("SetPixel: %v", err)
+				}
+			}
+
+			history := make([]canvas.Placement, len(tt.history))
+			for i, p := range tt.history {
+				history[i] = canvas.Placement{
+					CanvasID: base.ID(),
+					X:        p.x,
+					Y:        p.y,
+					Color:    p.color,
+					UserID:   p.userID,
+					PlacedAt: start.Add(time.Duration(i+1) * time.Minute),
+					Seq:      int64(i + 1),
+				}
+			}
+
+			got := tt.rollback.Reverts(base, history)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Reverts: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollbackRevertsResized(t *testing.T) {
+	mallory := idgen.New[idgen.User]()
+
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), 2, 2, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := cnv.SetPixel(0, 0, 3); err != nil {
+		t.Fatalf("SetPixel: %v", err)
+	}
+
+	base, err := cnv.Resize(canvas.Resize{Left: 1, Right: 1, Fill: 2}, 1)
+	if err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+
+	history := []canvas.Placement{
+		{X: 0, Y: 0, Color: 5, UserID: mallory, Seq: 2},
+		{X: 1, Y: 0, Color: 6, UserID: mallory, Seq: 3},
+		{X: 2, Y: 0, Color: 7, UserID: mallory, Seq: 4},
+	}
+
+	got := canvas.Rollback{UserID: mallory}.Reverts(base, history)
+	want := []canvas.Placement{
+		{X: 0, Y: 0, Color: 2},
+		{X: 1, Y: 0, Color: 3},
+		{X: 2, Y: 0, Color: 0},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Reverts: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,73 @@
+package canvas
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// Snapshot is a copy of the chunks of a canvas as of a sequence number, from
+// which the canvas can be restored without replaying the placements before it.
+// Chunks missing from a snapshot are unchanged since the previous snapshot, or
+// blank if there is none.
+type Snapshot struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Seq      int64
+	Chunks   []Chunk
+}
+
+// Snapshot returns a snapshot of every chunk of the canvas.
+func (c *Canvas) Snapshot() Snapshot {
+	return c.SnapshotSince(-1)
+}
+
+// SnapshotSince returns a snapshot of the chunks of the canvas modified by
+// placements after the given sequence number.
+func (c *Canvas) SnapshotSince(seq int64) Snapshot {
+	chunks, current := c.ChunksSince(seq)
+	return Snapshot{
+		CanvasID: c.id,
+		Seq:      current,
+		Chunks:   chunks,
+	}
+}
+
+// Restore replaces the chunks of the canvas with those of a snapshot, except
+// for chunks already at a later version. It reports whether any pixels were
+// changed.
+func (c *Canvas) Restore(s Snapshot) (bool, error) {
+	if s.CanvasID != c.id {
+		return false, fmt.Errorf("snapshot of canvas %s", s.CanvasID)
+	}
+
+	for _, sc := range s.Chunks {
+		bounds := c.ChunkBounds(sc.X, sc.Y)
+		if bounds.Empty() {
+			return false, fmt.Errorf("snapshot has chunk (%d, %d) outside the canvas", sc.X, sc.Y)
+		}
+		if len(sc.Pixels) != bounds.Dx()*bounds.Dy() {
+			return false, fmt.Errorf("snapshot chunk (%d, %d) has %d pixels, want %d", sc.X, sc.Y, len(sc.Pixels), bounds.Dx()*bounds.Dy())
+		}
+	}
+
+	c.lockAll()
+	defer c.unlockAll()
+
+	var restored bool
+	for _, sc := range s.Chunks {
+		ch := c.chunks[sc.Y*c.chunkCols+sc.X]
+		if sc.Version < ch.version {
+			continue
+		}
+
+		if !bytes.Equal(ch.pixels, sc.Pixels) {
+			copy(ch.pixels, sc.Pixels)
+			restored = true
+		}
+		ch.reset(sc.Version)
+	}
+	c.advance(s.Seq)
+
+	return restored, nil
+}
@@ -0,0 +1,144 @@
+package canvas
+
+import (
+	"image"
+	"image/color"
+)
+
+// TileSize is the width and height of map tiles, in image pixels.
+const TileSize = 256
+
+// MaxZoom returns the zoom level at which each tile pixel is one canvas pixel.
+// Each lower zoom level halves the resolution of tiles, down to zoom level 0
+// where a single tile covers the whole canvas.
+func (c *Canvas) MaxZoom() int {
+	z := 0
+	for TileSize<<z < max(c.width, c.height) {
+		z++
+	}
+	return z
+}
+
+// TileBounds returns the canvas pixels covered by a tile, which may extend past
+// the right and bottom edges of the canvas. It returns false if there is no
+// such tile.
+func (c *Canvas) TileBounds(z, x, y int) (image.Rectangle, bool) {
+	maxZoom := c.MaxZoom()
+	if z < 0 || z > maxZoom || x < 0 || y < 0 {
+		return image.Rectangle{}, false
+	}
+
+	span := TileSize << (maxZoom - z)
+	if x*span >= c.width || y*span >= c.height {
+		return image.Rectangle{}, false
+	}
+	return image.Rect(x*span, y*span, (x+1)*span, (y+1)*span), true
+}
+
+// TileVersion returns the version of a tile, which increases whenever a
+// placement is applied to any of the chunks it covers.
+func (c *Canvas) TileVersion(z, x, y int) (int64, error) {
+	bounds, ok := c.TileBounds(z, x, y)
+	if !ok {
+		return 0, ErrOutOfBounds
+	}
+
+	var version int64
+	for _, ch := range c.chunksWithin(bounds) {
+		ch.mu.RLock()
+		version += ch.version
+		ch.mu.RUnlock()
+	}
+	return version, nil
+}
+
+// Tile renders a tile along with its version. Below the maximum zoom level,
+// each tile pixel takes the most common color in the block of canvas pixels it
+// covers. Parts of the tile past the edges of the canvas are transparent,
+// unless the palette has no room for a transparent color, in which case they
+// take the first color.
+func (c *Canvas) Tile(z, x, y int) (*image.Paletted, int64, error) {
+	bounds, ok := c.TileBounds(z, x, y)
+	if !ok {
+		return nil, 0, ErrOutOfBounds
+	}
+
+	area := bounds.Intersect(image.Rect(0, 0, c.width, c.height))
+	pixels := make([]byte, area.Dx()*area.Dy())
+
+	var version int64
+	for _, ch := range c.chunksWithin(area) {
+		ch.mu.RLock()
+		ch.copyTo(pixels, area, ch.bounds.Intersect(area))
+		version += ch.version
+		ch.mu.RUnlock()
+	}
+
+	palette := c.palette.ColorPalette()
+	var blank uint8
+	if len(palette) < MaxPaletteSize {
+		blank = uint8(len(palette))
+		palette = append(palette, color.Transparent)
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, TileSize, TileSize), palette)
+	scale := bounds.Dx() / TileSize
+
+	var counts [MaxPaletteSize]int
+	for ty := range TileSize {
+		row := img.Pix[ty*img.Stride:]
+		for tx := range TileSize {
+			block := image.Rect(tx*scale, ty*scale, (tx+1)*scale, (ty+1)*scale).Add(bounds.Min).Intersect(area)
+			if block.Empty() {
+				row[tx] = blank
+				continue
+			}
+			row[tx] = mostCommon(pixels, area, block, &counts)
+		}
+	}
+
+	return img, version, nil
+}
+
+// mostCommon returns the most common color in block, breaking ties in favor of
+// the color that reached the count first. pixels is a row-major buffer of the
+// pixels in area, and counts must be zeroed, which it is again on return.
+func mostCommon(pixels []byte, area, block image.Rectangle, counts *[MaxPaletteSize]int) uint8 {
+	if block.Dx() == 1 && block.Dy() == 1 {
+		return pixels[(block.Min.Y-area.Min.Y)*area.Dx()+(block.Min.X-area.Min.X)]
+	}
+
+	var best uint8
+	for y := block.Min.Y; y < block.Max.Y; y++ {
+		for _, idx := range pixels[(y-area.Min.Y)*area.Dx()+(block.Min.X-area.Min.X):][:block.Dx()] {
+			counts[idx]++
+			if counts[idx] > counts[best] {
+				best = idx
+			}
+		}
+	}
+
+	for y := block.Min.Y; y < block.Max.Y; y++ {
+		for _, idx := range pixels[(y-area.Min.Y)*area.Dx()+(block.Min.X-area.Min.X):][:block.Dx()] {
+			counts[idx] = 0
+		}
+	}
+
+	return best
+}
+
+// chunksWithin returns the chunks overlapping r.
+func (c *Canvas) chunksWithin(r image.Rectangle) []*chunk {
+	r = r.Intersect(image.Rect(0, 0, c.width, c.height))
+	if r.Empty() {
+		return nil
+	}
+
+	var chunks []*chunk
+	for cy := r.Min.Y / ChunkSize; cy <= (r.Max.Y-1)/ChunkSize; cy++ {
+		for cx := r.Min.X / ChunkSize; cx <= (r.Max.X-1)/ChunkSize; cx++ {
+			chunks = append(chunks, c.chunks[cy*c.chunkCols+cx])
+		}
+	}
+	return chunks
+}
@@ -0,0 +1,102 @@
+package canvas_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+func TestTileVersion(t *testing.T) {
+	// A 300x100 canvas has two zoom levels: a single tile at zoom level 0, and
+	// two tiles side by side at zoom level 1, the second covering only the
+	// last column of chunks.
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), 300, 100, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	placements := []canvas.Placement{
+		{X: 10, Y: 10, Color: 1, Seq: 1},
+		{X: 70, Y: 80, Color: 2, Seq: 2},
+		{X: 270, Y: 10, Color: 3, Seq: 3},
+		{X: 11, Y: 10, Color: 4, Seq: 4},
+	}
+	for _, p := range placements {
+		if _, err := cnv.Apply(p); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		z, x, y int
+		want    int64
+		wantErr error
+	}{
+		{name: "WholeCanvas", z: 0, x: 0, y: 0, want: 4 + 2 + 3},
+		{name: "Left", z: 1, x: 0, y: 0, want: 4 + 2},
+		{name: "Right", z: 1, x: 1, y: 0, want: 3},
+		{name: "PastRight", z: 1, x: 2, y: 0, wantErr: canvas.ErrOutOfBounds},
+		{name: "PastBottom", z: 1, x: 0, y: 1, wantErr: canvas.ErrOutOfBounds},
+		{name: "PastMaxZoom", z: 2, x: 0, y: 0, wantErr: canvas.ErrOutOfBounds},
+		{name: "NegativeZoom", z: -1, x: 0, y: 0, wantErr: canvas.ErrOutOfBounds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cnv.TileVersion(tt.z, tt.x, tt.y)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TileVersion(%d, %d, %d): got error %v, want %v", tt.z, tt.x, tt.y, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TileVersion(%d, %d, %d): got %d, want %d", tt.z, tt.x, tt.y, got, tt.want)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			_, version, err := cnv.Tile(tt.z, tt.x, tt.y)
+			if err != nil {
+				t.Fatalf("Tile(%d, %d, %d): %v", tt.z, tt.x, tt.y, err)
+			}
+			if version != tt.want {
+				t.Errorf("Tile(%d, %d, %d): got version %d, want %d", tt.z, tt.x, tt.y, version, tt.want)
+			}
+		})
+	}
+}
+
+func TestTileVersionIgnoresStalePlacements(t *testing.T) {
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), 100, 100, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for _, p := range []canvas.Placement{
+		{X: 10, Y: 10, Color: 1, Seq: 2},
+		{X: 10, Y: 10, Color: 2, Seq: 1},
+	} {
+		if _, err := cnv.Apply(p); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+
+	version, err := cnv.TileVersion(0, 0, 0)
+	if err != nil {
+		t.Fatalf("TileVersion: %v", err)
+	}
+	if version != 2 {
+		t.Errorf("TileVersion: got %d, want %d", version, 2)
+	}
+
+	color, err := cnv.Pixel(10, 10)
+	if err != nil {
+		t.Fatalf("Pixel: %v", err)
+	}
+	if color != 1 {
+		t.Errorf("Pixel: got color %d, want %d", color, 1)
+	}
+}
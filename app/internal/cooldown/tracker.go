@@ -0,0 +1,71 @@
+package cooldown
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+type ActiveError struct {
+	Remaining time.Duration
+}
+
+func (e *ActiveError) Error() string {
+	return fmt.Sprintf("cooldown active, retry in %s", e.Remaining.Round(time.Second))
+}
+
+type Tracker struct {
+	duration time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex
+	last     map[idgen.ID[idgen.User]]time.Time
+	prunedAt time.Time
+}
+
+func NewTracker(duration time.Duration) *Tracker {
+	return &Tracker{
+		duration: duration,
+		now:      time.Now,
+		last:     make(map[idgen.ID[idgen.User]]time.Time),
+	}
+}
+
+func (t *Tracker) Acquire(userID idgen.ID[idgen.User]) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.prune(now)
+
+	if last, ok := t.last[userID]; ok {
+		if remaining := last.Add(t.duration).Sub(now); remaining > 0 {
+			return &ActiveError{Remaining: remaining}
+		}
+	}
+
+	t.last[userID] = now
+	return nil
+}
+
+func (t *Tracker) Release(userID idgen.ID[idgen.User]) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.last, userID)
+}
+
+func (t *Tracker) prune(now time.Time) {
+	if now.Sub(t.prunedAt) < t.duration {
+		return
+	}
+
+	for userID, last := range t.last {
+		if now.Sub(last) >= t.duration {
+			delete(t.last, userID)
+		}
+	}
+	t.prunedAt = now
+}
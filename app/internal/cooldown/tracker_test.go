@@ -0,0 +1,120 @@
+package cooldown
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+func TestTracker(t *testing.T) {
+	alice := idgen.New[idgen.User]()
+	bob := idgen.New[idgen.User]()
+
+	type step struct {
+		// after is how long after the previous step the user acquires.
+		after time.Duration
+		user  idgen.ID[idgen.User]
+		// release releases the cooldown of the user instead of acquiring one.
+		release bool
+		// wantRemaining is the remaining cooldown reported, if any is active.
+		wantRemaining time.Duration
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "FirstPlacement",
+			steps: []step{
+				{user: alice},
+			},
+		},
+		{
+			name: "Active",
+			steps: []step{
+				{user: alice},
+				{after: 2 * time.Minute, user: alice, wantRemaining: 3 * time.Minute},
+				{after: 2 * time.Minute, user: alice, wantRemaining: time.Minute},
+			},
+		},
+		{
+			name: "Expired",
+			steps: []step{
+				{user: alice},
+				{after: 5 * time.Minute, user: alice},
+				{after: time.Minute, user: alice, wantRemaining: 4 * time.Minute},
+			},
+		},
+		{
+			name: "RejectedAcquireKeepsCooldown",
+			steps: []step{
+				{user: alice},
+				{after: 4 * time.Minute, user: alice, wantRemaining: time.Minute},
+				{after: time.Minute, user: alice},
+			},
+		},
+		{
+			name: "PerUser",
+			steps: []step{
+				{user: alice},
+				{after: time.Minute, user: bob},
+				{after: time.Minute, user: alice, wantRemaining: 3 * time.Minute},
+				{after: time.Minute, user: bob, wantRemaining: 3 * time.Minute},
+			},
+		},
+		{
+			name: "ExpiredAfterPrune",
+			steps: []step{
+				{user: alice},
+				{after: 4 * time.Minute, user: bob},
+				{after: 2 * time.Minute, user: alice},
+				{after: time.Minute, user: bob, wantRemaining: 2 * time.Minute},
+			},
+		},
+		{
+			name: "Released",
+			steps: []step{
+				{user: alice},
+				{after: time.Minute, user: alice, release: true},
+				{user: alice},
+				{after: time.Minute, user: alice, wantRemaining: 4 * time.Minute},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			tracker := NewTracker(5 * time.Minute)
+			tracker.now = func() time.Time { return now }
+
+			for i, s := range tt.steps {
+				now = now.Add(s.after)
+
+				if s.release {
+					tracker.Release(s.user)
+					continue
+				}
+
+				err := tracker.Acquire(s.user)
+				if s.wantRemaining == 0 {
+					if err != nil {
+						t.Fatalf("step %d: Acquire: got error %v, want nil", i, err)
+					}
+					continue
+				}
+
+				var active *ActiveError
+				if !errors.As(err, &active) {
+					t.Fatalf("step %d: Acquire: got error %v, want %T", i, err, active)
+				}
+				if active.Remaining != s.wantRemaining {
+					t.Errorf("step %d: Acquire: got %s remaining, want %s", i, active.Remaining, s.wantRemaining)
+				}
+			}
+		})
+	}
+}
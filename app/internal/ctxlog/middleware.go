@@ -3,8 +3,10 @@ package ctxlog
 import (
 	"context"
 	"net/http"
+	"net/url"
 
 	"goa.design/clue/log"
+	"goa.design/goa/v3/grpc/middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
@@ -27,6 +29,22 @@ func UnaryServerInterceptor(logCtx context.Context) grpc.UnaryServerInterceptor
 	}
 }
 
+func StreamServerInterceptor(logCtx context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		requestID := reqid.RequestIDFromContext(stream.Context())
+
+		ctx := log.WithContext(stream.Context(), logCtx)
+		ctx = log.With(ctx, KV(log.RequestIDKey, requestID))
+
+		opts := []log.GRPCLogOption{
+			log.WithErrorFunc(func(_ codes.Code) bool { return false }),
+			log.WithDisableCallID(),
+		}
+
+		return log.StreamServerInterceptor(ctx, opts...)(srv, middleware.NewWrappedServerStream(ctx, stream), info, next)
+	}
+}
+
 func HTTP(logCtx context.Context) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -38,6 +56,7 @@ func HTTP(logCtx context.Context) func(http.Handler) http.Handler {
 
 			opts := []log.HTTPLogOption{
 				log.WithDisableRequestID(),
+				log.WithRequestLogFunc(printRedacted),
 			}
 
 			handler := log.HTTP(ctx, opts...)(next)
@@ -45,3 +64,38 @@ func HTTP(logCtx context.Context) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// redactedParams are query parameters that carry credentials, for clients such
+// as browsers that can't set headers on WebSocket connections.
+var redactedParams = []string{"access_token"}
+
+func printRedacted(ctx context.Context, kvs ...log.Fielder) {
+	for i, kv := range kvs {
+		f, ok := kv.(log.KV)
+		if !ok || f.K != log.HTTPURLKey {
+			continue
+		}
+
+		raw, ok := f.V.(string)
+		if !ok {
+			continue
+		}
+
+		u, err := url.Parse(raw)
+		if err != nil {
+			continue
+		}
+
+		query := u.Query()
+		for _, param := range redactedParams {
+			if query.Has(param) {
+				query.Set(param, "REDACTED")
+			}
+		}
+		u.RawQuery = query.Encode()
+
+		kvs[i] = KV(f.K, u.String())
+	}
+
+	log.Print(ctx, kvs...)
+}
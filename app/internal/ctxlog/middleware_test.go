@@ -0,0 +1,31 @@
+package ctxlog
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goa.design/clue/log"
+)
+
+func TestHTTPRedactsAccessToken(t *testing.T) {
+	var buf bytes.Buffer
+	logCtx := log.Context(t.Context(), log.WithOutput(&buf), log.WithFormat(log.FormatJSON))
+
+	handler := HTTP(logCtx)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/canvases/stream?access_token=secret&since=4", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	logs := buf.String()
+	if strings.Contains(logs, "secret") {
+		t.Errorf("got access token in logs: %s", logs)
+	}
+	if !strings.Contains(logs, "access_token=REDACTED") || !strings.Contains(logs, "since=4") {
+		t.Errorf("got logs %s, want the URL with its access token redacted", logs)
+	}
+}
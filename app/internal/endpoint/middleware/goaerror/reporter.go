@@ -23,15 +23,16 @@ func Reporter(e goa.Endpoint) goa.Endpoint {
 
 		var gerr *goa.ServiceError
 		if !errors.As(err, &gerr) {
+			var terr goa.GoaErrorNamer
+			if errors.As(err, &terr) {
+				report(ctx, err, terr.GoaErrorName())
+				return res, err
+			}
 			gerr = goa.Fault("an unexpected error occurred")
 		}
 		gerr.ID = reqid.RequestIDFromContext(ctx).String()
 
-		ctxlog.Error(ctx, "endpoint error", err, ctxlog.KV("err.name", gerr.Name))
-
-		span := trace.SpanFromContext(ctx)
-		span.SetStatus(codes.Error, gerr.Name)
-		span.SetAttributes(attribute.String("error", err.Error()))
+		report(ctx, err, gerr.Name)
 
 		switch gerr.Name {
 		case apiv1.ErrCodeUnauthenticated:
@@ -43,3 +44,11 @@ func Reporter(e goa.Endpoint) goa.Endpoint {
 		return res, gerr
 	}
 }
+
+func report(ctx context.Context, err error, name string) {
+	ctxlog.Error(ctx, "endpoint error", err, ctxlog.KV("err.name", name))
+
+	span := trace.SpanFromContext(ctx)
+	span.SetStatus(codes.Error, name)
+	span.SetAttributes(attribute.String("error", err.Error()))
+}
@@ -0,0 +1,26 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// Bus delivers placements, and notices of other changes to canvases, to every
+// instance of the service, including the instance that published them.
+type Bus interface {
+	// Publish delivers a placement once it has been stored. Placements on each
+	// canvas must be published in sequence order, and are delivered in it.
+	Publish(ctx context.Context, p canvas.Placement) error
+	Subscribe(fn HandlerFunc)
+
+	// PublishChange announces that a canvas has changed other than by a
+	// placement, such as by being resized, so that instances reload it.
+	PublishChange(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) error
+	SubscribeChanges(fn ChangeHandlerFunc)
+}
+
+type HandlerFunc func(ctx context.Context, p canvas.Placement)
+
+type ChangeHandlerFunc func(ctx context.Context, canvasID idgen.ID[idgen.Canvas])
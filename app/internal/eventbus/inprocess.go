@@ -0,0 +1,55 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// InProcess delivers placements synchronously to subscribers in the same
+// process, for running a single instance.
+type InProcess struct {
+	mu             sync.RWMutex
+	handlers       []HandlerFunc
+	changeHandlers []ChangeHandlerFunc
+}
+
+func NewInProcess() *InProcess {
+	return &InProcess{}
+}
+
+var _ Bus = (*InProcess)(nil)
+
+func (b *InProcess) Publish(ctx context.Context, p canvas.Placement) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, fn := range b.handlers {
+		fn(ctx, p)
+	}
+	return nil
+}
+
+func (b *InProcess) Subscribe(fn HandlerFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, fn)
+}
+
+func (b *InProcess) PublishChange(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, fn := range b.changeHandlers {
+		fn(ctx, canvasID)
+	}
+	return nil
+}
+
+func (b *InProcess) SubscribeChanges(fn ChangeHandlerFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.changeHandlers = append(b.changeHandlers, fn)
+}
@@ -0,0 +1,47 @@
+package eventbus
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+func TestInProcess(t *testing.T) {
+	bus := NewInProcess()
+
+	canvasID := idgen.New[idgen.Canvas]()
+	placements := []canvas.Placement{
+		{CanvasID: canvasID, X: 1, Y: 1, Color: 1, Seq: 1},
+		{CanvasID: canvasID, X: 2, Y: 1, Color: 2, Seq: 2},
+		{CanvasID: canvasID, X: 1, Y: 1, Color: 3, Seq: 3},
+	}
+
+	var first, second []canvas.Placement
+	bus.Subscribe(func(_ context.Context, p canvas.Placement) { first = append(first, p) })
+	bus.Subscribe(func(_ context.Context, p canvas.Placement) { second = append(second, p) })
+
+	var changes []idgen.ID[idgen.Canvas]
+	bus.SubscribeChanges(func(_ context.Context, id idgen.ID[idgen.Canvas]) { changes = append(changes, id) })
+
+	for _, p := range placements {
+		if err := bus.Publish(t.Context(), p); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+	if err := bus.PublishChange(t.Context(), canvasID); err != nil {
+		t.Fatalf("PublishChange: %v", err)
+	}
+
+	if !slices.Equal(first, placements) {
+		t.Errorf("first subscriber: got %v, want %v", first, placements)
+	}
+	if !slices.Equal(second, placements) {
+		t.Errorf("second subscriber: got %v, want %v", second, placements)
+	}
+	if want := []idgen.ID[idgen.Canvas]{canvasID}; !slices.Equal(changes, want) {
+		t.Errorf("change subscriber: got %v, want %v", changes, want)
+	}
+}
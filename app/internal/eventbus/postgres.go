@@ -0,0 +1,263 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alexliesenfeld/health"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/healthz"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/service"
+)
+
+const (
+	placementsChannel = "pikcel_placements"
+	changesChannel    = "pikcel_canvas_changes"
+	reconnectInterval = time.Second
+)
+
+// Postgres delivers placements to every instance connected to the same
+// database using LISTEN/NOTIFY. Placements are notified by a trigger when they
+// are inserted by the postgres store, so that they are delivered in the order
+// they were stored. Notifications sent while an instance is reconnecting its
+// listener are not redelivered.
+type Postgres struct {
+	pool *pgxpool.Pool
+
+	mu             sync.RWMutex
+	handlers       []HandlerFunc
+	changeHandlers []ChangeHandlerFunc
+	onListen       []func(ctx context.Context)
+
+	stopped context.Context
+	stop    context.CancelFunc
+	done    chan struct{}
+}
+
+func NewPostgres(ctx context.Context, dsn string) (*Postgres, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("create connection pool: %w", err)
+	}
+
+	stopped, stop := context.WithCancel(context.Background())
+	return &Postgres{
+		pool:    pool,
+		stopped: stopped,
+		stop:    stop,
+		done:    make(chan struct{}),
+	}, nil
+}
+
+func (b *Postgres) Close() {
+	b.pool.Close()
+}
+
+// placementMessage is the payload of a placement notification, which holds
+// the columns of the placement as they are stored, so IDs are unprefixed.
+type placementMessage struct {
+	CanvasID string    `json:"canvas_id"`
+	X        int       `json:"x"`
+	Y        int       `json:"y"`
+	Color    uint8     `json:"color"`
+	UserID   string    `json:"user_id"`
+	PlacedAt time.Time `json:"placed_at"`
+	Seq      int64     `json:"seq"`
+}
+
+func decodePlacement(payload string) (canvas.Placement, error) {
+	var msg placementMessage
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return canvas.Placement{}, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	canvasID, err := idgen.FromUnprefixed[idgen.Canvas](msg.CanvasID)
+	if err != nil {
+		return canvas.Placement{}, fmt.Errorf("parse canvas id: %w", err)
+	}
+
+	userID, err := idgen.FromUnprefixed[idgen.User](msg.UserID)
+	if err != nil {
+		return canvas.Placement{}, fmt.Errorf("parse user id: %w", err)
+	}
+
+	return canvas.Placement{
+		CanvasID: canvasID,
+		X:        msg.X,
+		Y:        msg.Y,
+		Color:    msg.Color,
+		UserID:   userID,
+		PlacedAt: msg.PlacedAt,
+		Seq:      msg.Seq,
+	}, nil
+}
+
+var _ Bus = (*Postgres)(nil)
+
+// Publish does nothing, since placements have already been notified by the
+// time they are stored.
+func (b *Postgres) Publish(ctx context.Context, p canvas.Placement) error {
+	return nil
+}
+
+func (b *Postgres) Subscribe(fn HandlerFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, fn)
+}
+
+func (b *Postgres) PublishChange(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) error {
+	if _, err := b.pool.Exec(ctx, "SELECT pg_notify($1, $2)", changesChannel, canvasID.String()); err != nil {
+		return fmt.Errorf("notify canvas change: %w", err)
+	}
+	return nil
+}
+
+func (b *Postgres) SubscribeChanges(fn ChangeHandlerFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.changeHandlers = append(b.changeHandlers, fn)
+}
+
+// OnListen registers fn to be called whenever the bus starts listening for
+// placements, including after reconnecting, so that subscribers can catch up
+// on placements published while the bus was not listening.
+func (b *Postgres) OnListen(fn func(ctx context.Context)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.onListen = append(b.onListen, fn)
+}
+
+var _ service.Server = (*Postgres)(nil)
+
+func (b *Postgres) Name() string {
+	return "placements"
+}
+
+func (b *Postgres) Kind() string {
+	return "eventbus"
+}
+
+func (b *Postgres) Addr() string {
+	return placementsChannel
+}
+
+func (b *Postgres) Serve(ctx context.Context) error {
+	defer close(b.done)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer context.AfterFunc(b.stopped, cancel)()
+
+	for {
+		err := b.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		ctxlog.Error(ctx, "error listening for placements, reconnecting", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+func (b *Postgres) listen(ctx context.Context) error {
+	pconn, err := b.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+
+	// The connection is taken out of the pool so that it is never reused while
+	// still listening on the channel.
+	conn := pconn.Hijack()
+	defer conn.Close(context.Background()) //nolint:errcheck
+
+	for _, channel := range []string{placementsChannel, changesChannel} {
+		if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return fmt.Errorf("listen on channel %s: %w", channel, err)
+		}
+	}
+
+	b.mu.RLock()
+	for _, fn := range b.onListen {
+		fn(ctx)
+	}
+	b.mu.RUnlock()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		if n.Channel == changesChannel {
+			canvasID, err := idgen.FromString[idgen.Canvas](n.Payload)
+			if err != nil {
+				ctxlog.Error(ctx, "error decoding canvas change notification", err)
+				continue
+			}
+
+			b.deliverChange(ctx, canvasID)
+			continue
+		}
+
+		p, err := decodePlacement(n.Payload)
+		if err != nil {
+			ctxlog.Error(ctx, "error decoding placement notification", err)
+			continue
+		}
+
+		b.deliver(ctx, p)
+	}
+}
+
+func (b *Postgres) deliver(ctx context.Context, p canvas.Placement) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, fn := range b.handlers {
+		fn(ctx, p)
+	}
+}
+
+func (b *Postgres) deliverChange(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, fn := range b.changeHandlers {
+		fn(ctx, canvasID)
+	}
+}
+
+func (b *Postgres) Shutdown(ctx context.Context) error {
+	b.stop()
+
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	}
+}
+
+var _ healthz.Target = (*Postgres)(nil)
+
+func (b *Postgres) HealthChecks() []health.Check {
+	return []health.Check{
+		{
+			Name:  "eventbus:postgres",
+			Check: b.pool.Ping,
+		},
+	}
+}
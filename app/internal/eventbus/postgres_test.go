@@ -0,0 +1,281 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/storage/postgres"
+)
+
+func TestPostgres(t *testing.T) {
+	dsn := os.Getenv("PIKCEL_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("PIKCEL_TEST_DATABASE_DSN not set")
+	}
+
+	admin, store, dsn := newStore(t, dsn)
+
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), 8, 4, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := store.CreateCanvas(t.Context(), cnv); err != nil {
+		t.Fatalf("CreateCanvas: %v", err)
+	}
+
+	bus, err := NewPostgres(t.Context(), dsn)
+	if err != nil {
+		t.Fatalf("NewPostgres: %v", err)
+	}
+	t.Cleanup(bus.Close)
+
+	// Notifications are sent to every listener on the database, so those for
+	// canvases of other tests are left out.
+	received := make(chan canvas.Placement, 16)
+	bus.Subscribe(func(_ context.Context, p canvas.Placement) {
+		if p.CanvasID == cnv.ID() {
+			received <- p
+		}
+	})
+	changed := make(chan idgen.ID[idgen.Canvas], 16)
+	bus.SubscribeChanges(func(_ context.Context, id idgen.ID[idgen.Canvas]) {
+		if id == cnv.ID() {
+			changed <- id
+		}
+	})
+	listening := make(chan struct{}, 16)
+	bus.OnListen(func(context.Context) {
+		listening <- struct{}{}
+	})
+
+	served := make(chan error, 1)
+	go func() {
+		served <- bus.Serve(context.Background())
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		bus.Shutdown(ctx) //nolint:errcheck
+	})
+
+	await(t, listening, "listen")
+
+	place := func(x, y int, color uint8) canvas.Placement {
+		t.Helper()
+		p := canvas.Placement{
+			CanvasID: cnv.ID(),
+			X:        x,
+			Y:        y,
+			Color:    color,
+			UserID:   idgen.New[idgen.User](),
+			PlacedAt: time.Now().UTC().Truncate(time.Microsecond),
+		}
+		seq, err := store.InsertPlacement(t.Context(), p, 0)
+		if err != nil {
+			t.Fatalf("InsertPlacement: %v", err)
+		}
+		p.Seq = seq
+		return p
+	}
+	assertReceived := func(want canvas.Placement) {
+		t.Helper()
+		got := await(t, received, "placement")
+		if got.CanvasID != want.CanvasID || got.Point() != want.Point() || got.Color != want.Color ||
+			got.UserID != want.UserID || !got.PlacedAt.Equal(want.PlacedAt) || got.Seq != want.Seq {
+			t.Errorf("received %v, want %v", got, want)
+		}
+	}
+
+	t.Run("Placements", func(t *testing.T) {
+		var want []canvas.Placement
+		for i, pt := range []image.Point{{1, 1}, {2, 1}, {1, 1}} {
+			want = append(want, place(pt.X, pt.Y, uint8(i+1))) //nolint:gosec
+		}
+		for _, p := range want {
+			assertReceived(p)
+		}
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		if err := bus.PublishChange(t.Context(), cnv.ID()); err != nil {
+			t.Fatalf("PublishChange: %v", err)
+		}
+		if got := await(t, changed, "change"); got != cnv.ID() {
+			t.Errorf("received change to %s, want %s", got, cnv.ID())
+		}
+	})
+
+	t.Run("Reconnect", func(t *testing.T) {
+		// Terminating the listening connection makes the bus reconnect, after
+		// which it calls the OnListen functions again so that subscribers can
+		// catch up on the placements they missed.
+		_, err := admin.Exec(t.Context(),
+			"SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE application_name = $1 AND query LIKE 'LISTEN%'",
+			applicationName(dsn))
+		if err != nil {
+			t.Fatalf("terminate listener: %v", err)
+		}
+
+		await(t, listening, "listen after reconnecting")
+		assertReceived(place(3, 3, 4))
+	})
+
+	t.Run("Shutdown", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+		defer cancel()
+
+		if err := bus.Shutdown(ctx); err != nil {
+			t.Fatalf("Shutdown: %v", err)
+		}
+		if err := await(t, served, "serve to return"); err != nil {
+			t.Errorf("Serve: %v", err)
+		}
+	})
+}
+
+// newStore returns a store backed by a fresh, fully migrated schema that is
+// dropped once the test completes, along with a pool to administer the
+// database and the DSN of the schema. Connections to the schema are named
+// after it, so that they can be told apart from those of other tests.
+func newStore(t *testing.T, dsn string) (*pgxpool.Pool, *postgres.Store, string) {
+	t.Helper()
+	ctx := t.Context()
+
+	schema := strings.ToLower(idgen.New[idgen.Canvas]().String())
+
+	admin, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(admin.Close)
+
+	if _, err := admin.Exec(ctx, fmt.Sprintf("CREATE SCHEMA %s", pgx.Identifier{schema}.Sanitize())); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		// The test context is already canceled by the time cleanups run.
+		ctx := context.WithoutCancel(ctx)
+		if _, err := admin.Exec(ctx, fmt.Sprintf("DROP SCHEMA %s CASCADE", pgx.Identifier{schema}.Sanitize())); err != nil {
+			t.Errorf("drop schema: %v", err)
+		}
+	})
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatalf("parse dsn: %v", err)
+	}
+	query := u.Query()
+	query.Set("search_path", schema)
+	query.Set("application_name", schema)
+	u.RawQuery = query.Encode()
+
+	store, err := postgres.NewStore(ctx, u.String())
+	if err != nil {
+		t.Fatalf("init store: %v", err)
+	}
+	t.Cleanup(store.Close)
+
+	migrator, err := store.Migrator()
+	if err != nil {
+		t.Fatalf("init migrator: %v", err)
+	}
+	t.Cleanup(func() { migrator.Close() })
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	return admin, store, u.String()
+}
+
+func applicationName(dsn string) string {
+	u, _ := url.Parse(dsn) //nolint:errcheck
+	return u.Query().Get("application_name")
+}
+
+func await[T any](t *testing.T, ch <-chan T, what string) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timed out waiting for %s", what)
+		var zero T
+		return zero
+	}
+}
+
+func TestDecodePlacement(t *testing.T) {
+	canvasID, userID := idgen.New[idgen.Canvas](), idgen.New[idgen.User]()
+	rawCanvasID := strings.TrimPrefix(canvasID.String(), "cnv_")
+	rawUserID := strings.TrimPrefix(userID.String(), "usr_")
+
+	tests := []struct {
+		name    string
+		payload string
+		want    canvas.Placement
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			payload: `{"canvas_id" : "` + rawCanvasID + `", "x" : 3, "y" : 4, "color" : 5, "user_id" : "` + rawUserID +
+				`", "placed_at" : "2024-01-02T03:04:05.123456+00:00", "seq" : 42}`,
+			want: canvas.Placement{
+				CanvasID: canvasID,
+				X:        3,
+				Y:        4,
+				Color:    5,
+				UserID:   userID,
+				PlacedAt: time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC),
+				Seq:      42,
+			},
+		},
+		{
+			name:    "PrefixedCanvasID",
+			payload: `{"canvas_id" : "` + canvasID.String() + `", "user_id" : "` + rawUserID + `"}`,
+			wantErr: true,
+		},
+		{
+			name:    "MissingUserID",
+			payload: `{"canvas_id" : "` + rawCanvasID + `"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Malformed",
+			payload: `{"canvas_id" :`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePlacement(tt.payload)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("decodePlacement: got %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodePlacement: %v", err)
+			}
+
+			if got.CanvasID != tt.want.CanvasID || got.X != tt.want.X || got.Y != tt.want.Y || got.Color != tt.want.Color ||
+				got.UserID != tt.want.UserID || !got.PlacedAt.Equal(tt.want.PlacedAt) || got.Seq != tt.want.Seq {
+				t.Errorf("decodePlacement: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/timelapse"
+)
+
+type Handler struct {
+	repo canvas.Repository
+}
+
+func NewHandler(repo canvas.Repository) *Handler {
+	return &Handler{
+		repo: repo,
+	}
+}
+
+type Router interface {
+	Route(method, pattern string, h http.HandlerFunc)
+}
+
+func (h *Handler) Register(r Router) {
+	r.Route(http.MethodGet, "/canvases/{id}/timelapse", h.Timelapse)
+}
+
+// Timelapse renders the placements on a canvas within a range of sequence
+// numbers or times as an animated GIF, or as a zip archive of PNG frames. The
+// frames are counted before any are rendered, so that a timelapse that would
+// fail is rejected up front, and are then streamed as they are encoded.
+func (h *Handler) Timelapse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	canvasID, err := idgen.FromString[idgen.Canvas](chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid canvas ID %q", chi.URLParam(r, "id")), http.StatusBadRequest)
+		return
+	}
+
+	req, err := parseTimelapseRequest(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Placements made after the frames are counted are left out, so that
+	// the same frames are rendered.
+	info, err := h.repo.GetCanvasInfo(ctx, canvasID)
+	if err == nil && info.Seq > 0 && (req.opts.Range.ToSeq == 0 || req.opts.Range.ToSeq > info.Seq) {
+		req.opts.Range.ToSeq = info.Seq
+	}
+
+	var frames int
+	if err == nil {
+		frames, err = timelapse.Count(ctx, h.repo, canvasID, req.opts)
+	}
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, timelapse.ErrTooManyFrames), errors.Is(err, timelapse.ErrFrameTooLarge), errors.Is(err, timelapse.ErrBeforeResize):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		ctxlog.Error(ctx, "error rendering timelapse", err)
+		http.Error(w, "error rendering timelapse", http.StatusInternalServerError)
+		return
+	}
+
+	var enc timelapse.Encoder
+	switch req.format {
+	case "gif":
+		w.Header().Set("Content-Type", "image/gif")
+		enc = timelapse.NewGIFEncoder(w, req.delay)
+	case "png":
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="timelapse.zip"`)
+		enc = timelapse.NewZipEncoder(w)
+	}
+	w.Header().Set("X-Timelapse-Frames", strconv.Itoa(frames))
+
+	// The response has started once the first frame is written, so errors
+	// from here on can only be logged.
+	if _, err := timelapse.Render(ctx, h.repo, canvasID, req.opts, enc); err != nil {
+		ctxlog.Error(ctx, "error rendering timelapse", err)
+		return
+	}
+	if err := enc.Close(); err != nil {
+		ctxlog.Error(ctx, "error writing timelapse", err)
+	}
+}
+
+type timelapseRequest struct {
+	opts   timelapse.Options
+	format string
+	delay  time.Duration
+}
+
+func parseTimelapseRequest(q url.Values) (timelapseRequest, error) {
+	req := timelapseRequest{
+		opts: timelapse.Options{
+			Interval: timelapse.Interval{Placements: 100},
+			Scale:    1,
+		},
+		format: "gif",
+		delay:  100 * time.Millisecond,
+	}
+
+	var err error
+	if v := q.Get("format"); v != "" {
+		if v != "gif" && v != "png" {
+			return req, fmt.Errorf("invalid format %q: must be gif or png", v)
+		}
+		req.format = v
+	}
+	if v := q.Get("from_seq"); v != "" {
+		if req.opts.Range.FromSeq, err = strconv.ParseInt(v, 10, 64); err != nil || req.opts.Range.FromSeq < 0 {
+			return req, fmt.Errorf("invalid from_seq %q", v)
+		}
+	}
+	if v := q.Get("to_seq"); v != "" {
+		if req.opts.Range.ToSeq, err = strconv.ParseInt(v, 10, 64); err != nil || req.opts.Range.ToSeq < 0 {
+			return req, fmt.Errorf("invalid to_seq %q", v)
+		}
+	}
+	if v := q.Get("from"); v != "" {
+		if req.opts.Range.From, err = time.Parse(time.RFC3339, v); err != nil {
+			return req, fmt.Errorf("invalid from %q: must be an RFC 3339 timestamp", v)
+		}
+	}
+	if v := q.Get("to"); v != "" {
+		if req.opts.Range.To, err = time.Parse(time.RFC3339, v); err != nil {
+			return req, fmt.Errorf("invalid to %q: must be an RFC 3339 timestamp", v)
+		}
+	}
+	if v := q.Get("every"); v != "" {
+		if req.opts.Interval, err = timelapse.ParseInterval(v); err != nil {
+			return req, fmt.Errorf("invalid every %q: %w", v, err)
+		}
+	}
+	if v := q.Get("scale"); v != "" {
+		if req.opts.Scale, err = strconv.Atoi(v); err != nil {
+			return req, fmt.Errorf("invalid scale %q", v)
+		}
+	}
+	if v := q.Get("delay"); v != "" {
+		if req.delay, err = time.ParseDuration(v); err != nil || req.delay <= 0 {
+			return req, fmt.Errorf("invalid delay %q", v)
+		}
+	}
+
+	if err := req.opts.Validate(); err != nil {
+		return req, err //nolint:wrapcheck
+	}
+
+	return req, nil
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"sync"
+
+	goa "goa.design/goa/v3/pkg"
+
+	"github.com/jace-ys/pikcel/api/v1/gen/api"
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/cooldown"
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/hub"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// liveCanvas is a canvas held in memory, along with the state needed to place
+// pixels on it and stream its updates to subscribers.
+type liveCanvas struct {
+	loaded chan struct{}
+	err    error
+
+	canvas   *canvas.Canvas
+	cooldown *cooldown.Tracker
+	updates  *hub.Hub[canvas.Placement, image.Point]
+	tiles    *tileCache
+
+	// placeMu serializes placements on the canvas from storing them through
+	// to publishing them, so that they reach the hub and the event bus in
+	// sequence order. Subscribers resuming from a sequence number rely on
+	// this to skip the placements they have already been replayed.
+	placeMu sync.Mutex
+
+	// loadedSeq is the sequence number of the canvas when it was loaded, so
+	// that placements delivered by the event bus that it already includes are
+	// not applied again.
+	loadedSeq int64
+}
+
+// liveCanvas returns the canvas with the given ID, loading it from storage the
+// first time it is needed.
+func (h *Handler) liveCanvas(ctx context.Context, id string) (*liveCanvas, error) {
+	canvasID, err := idgen.FromString[idgen.Canvas](id)
+	if err != nil {
+		return nil, goa.InvalidFieldTypeError("id", id, "canvas ID")
+	}
+
+	lc, err := h.loadCanvas(ctx, canvasID)
+	if errors.Is(err, canvas.ErrNotFound) {
+		return nil, api.MakeNotFound(fmt.Errorf("canvas %s not found", id))
+	}
+	return lc, err
+}
+
+func (h *Handler) loadCanvas(ctx context.Context, id idgen.ID[idgen.Canvas]) (*liveCanvas, error) {
+	h.canvasesMu.Lock()
+	lc, ok := h.canvases[id]
+	if !ok {
+		lc = &liveCanvas{loaded: make(chan struct{})}
+		h.canvases[id] = lc
+	}
+	h.canvasesMu.Unlock()
+
+	if !ok {
+		h.load(ctx, lc, id, cooldown.NewTracker(h.cooldownPeriod))
+	}
+
+	select {
+	case <-lc.loaded:
+	case <-ctx.Done():
+		return nil, ctx.Err() //nolint:wrapcheck
+	}
+	if lc.err != nil {
+		return nil, lc.err
+	}
+	return lc, nil
+}
+
+// load loads a canvas from storage into lc. The canvas is registered before it
+// is loaded, so that placements delivered while it is loading wait for it
+// rather than being missed, and unregistered if it fails to load.
+func (h *Handler) load(ctx context.Context, lc *liveCanvas, id idgen.ID[idgen.Canvas], tracker *cooldown.Tracker) {
+	defer close(lc.loaded)
+
+	cnv, err := h.repo.GetCanvas(context.WithoutCancel(ctx), id)
+	if err != nil {
+		h.canvasesMu.Lock()
+		if h.canvases[id] == lc {
+			delete(h.canvases, id)
+		}
+		h.canvasesMu.Unlock()
+
+		if !errors.Is(err, canvas.ErrNotFound) {
+			err = fmt.Errorf("get canvas: %w", err)
+		}
+		lc.err = err
+		return
+	}
+
+	lc.canvas = cnv
+	lc.cooldown = tracker
+	lc.updates = h.newUpdates()
+	lc.tiles = newTileCache()
+	lc.loadedSeq = cnv.Seq()
+}
+
+// reload replaces a loaded canvas with a fresh copy from storage, after it has
+// changed other than by a placement. Cooldowns carry over to the new copy, and
+// the updates of the old copy are closed so that its subscribers move over.
+func (h *Handler) reload(ctx context.Context, id idgen.ID[idgen.Canvas]) {
+	h.canvasesMu.Lock()
+	old, ok := h.canvases[id]
+	if !ok {
+		h.canvasesMu.Unlock()
+		return
+	}
+	lc := &liveCanvas{loaded: make(chan struct{})}
+	h.canvases[id] = lc
+	h.canvasesMu.Unlock()
+
+	<-old.loaded
+	if old.err != nil {
+		h.load(ctx, lc, id, cooldown.NewTracker(h.cooldownPeriod))
+		return
+	}
+
+	h.load(ctx, lc, id, old.cooldown)
+	if lc.err != nil {
+		ctxlog.Error(ctx, "error reloading canvas", lc.err, ctxlog.KV("canvas.id", id.String()))
+	}
+	old.updates.Close()
+}
+
+// replacement returns the canvas that replaced lc when it was reloaded, if that
+// is why err ended a subscription to its updates.
+func (h *Handler) replacement(ctx context.Context, lc *liveCanvas, err error) (*liveCanvas, bool) {
+	if !errors.Is(err, hub.ErrClosed) {
+		return nil, false
+	}
+
+	h.canvasesMu.Lock()
+	next, ok := h.canvases[lc.canvas.ID()]
+	h.canvasesMu.Unlock()
+	if !ok || next == lc {
+		return nil, false
+	}
+
+	select {
+	case <-next.loaded:
+		return next, next.err == nil
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+// loadedCanvas returns the canvas with the given ID if it has been loaded,
+// waiting for it if it is still loading.
+func (h *Handler) loadedCanvas(id idgen.ID[idgen.Canvas]) (*liveCanvas, bool) {
+	h.canvasesMu.Lock()
+	lc, ok := h.canvases[id]
+	h.canvasesMu.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	<-lc.loaded
+	return lc, lc.err == nil
+}
+
+func (h *Handler) loadedCanvases() []*liveCanvas {
+	h.canvasesMu.Lock()
+	defer h.canvasesMu.Unlock()
+
+	loaded := make([]*liveCanvas, 0, len(h.canvases))
+	for _, lc := range h.canvases {
+		select {
+		case <-lc.loaded:
+			if lc.err == nil {
+				loaded = append(loaded, lc)
+			}
+		default:
+		}
+	}
+	return loaded
+}
+
+// Close ends the streams of every subscriber to a canvas.
+func (h *Handler) Close() {
+	for _, lc := range h.loadedCanvases() {
+		lc.updates.Close()
+	}
+}
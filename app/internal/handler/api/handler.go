@@ -1,31 +1,1096 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"image"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/alexliesenfeld/health"
+	"github.com/gorilla/websocket"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 
+	apiv1 "github.com/jace-ys/pikcel/api/v1"
 	"github.com/jace-ys/pikcel/api/v1/gen/api"
+	"github.com/jace-ys/pikcel/internal/authn"
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/cooldown"
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/eventbus"
 	"github.com/jace-ys/pikcel/internal/healthz"
+	"github.com/jace-ys/pikcel/internal/hub"
 	"github.com/jace-ys/pikcel/internal/idgen"
 )
 
 var _ api.Service = (*Handler)(nil)
 
-type Handler struct{}
+type Handler struct {
+	auth           *authn.JWTAuthenticator
+	repo           canvas.Repository
+	bus            eventbus.Bus
+	cooldownPeriod time.Duration
+	newUpdates     func() *hub.Hub[canvas.Placement, image.Point]
 
-func NewHandler() (*Handler, error) {
-	return &Handler{}, nil
+	canvasesMu sync.Mutex
+	canvases   map[idgen.ID[idgen.Canvas]]*liveCanvas
+
+	replayLimit      int64
+	tileCacheControl string
+}
+
+// NewHandler returns a handler serving every canvas in the repository. Each
+// canvas has its own cooldown for placing pixels, and its own hub created by
+// newUpdates for streaming its updates. Map tiles may be cached for
+// tileMaxAge before they are revalidated.
+func NewHandler(auth *authn.JWTAuthenticator, repo canvas.Repository, bus eventbus.Bus, cooldownPeriod time.Duration, newUpdates func() *hub.Hub[canvas.Placement, image.Point], replayLimit int, tileMaxAge time.Duration) (*Handler, error) {
+	h := &Handler{
+		auth:             auth,
+		repo:             repo,
+		bus:              bus,
+		cooldownPeriod:   cooldownPeriod,
+		newUpdates:       newUpdates,
+		canvases:         make(map[idgen.ID[idgen.Canvas]]*liveCanvas),
+		replayLimit:      int64(max(replayLimit, 0)),
+		tileCacheControl: tileCacheControl(tileMaxAge),
+	}
+	bus.Subscribe(h.receive)
+	bus.SubscribeChanges(h.reload)
+
+	return h, nil
+}
+
+var _ api.Auther = (*Handler)(nil)
+
+func (h *Handler) JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error) {
+	ctx, err := h.auth.Authenticate(ctx, token, schema.RequiredScopes)
+	switch {
+	case errors.Is(err, authn.ErrMissingScopes):
+		return ctx, api.MakeAccessDenied(err)
+	case err != nil:
+		return ctx, api.MakeUnauthenticated(err)
+	}
+	return ctx, nil
+}
+
+func (h *Handler) CanvasCreate(ctx context.Context, p *api.CanvasCreatePayload) (*api.Canvas, error) {
+	palette := canvas.DefaultPalette
+	if len(p.Palette) > 0 {
+		var err error
+		palette, err = canvas.ParsePalette(p.Palette)
+		if err != nil {
+			return nil, goa.InvalidFormatError("palette", strings.Join(p.Palette, ","), "#RRGGBB", err)
+		}
+	}
+
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), int(p.Width), int(p.Height), palette)
+	if err != nil {
+		return nil, fmt.Errorf("init canvas: %w", err)
+	}
+
+	if err := h.repo.CreateCanvas(ctx, cnv); err != nil {
+		return nil, fmt.Errorf("create canvas: %w", err)
+	}
+
+	info, err := h.repo.GetCanvasInfo(ctx, cnv.ID())
+	if err != nil {
+		return nil, fmt.Errorf("get canvas: %w", err)
+	}
+
+	ctxlog.Print(ctx, "created new canvas", ctxlog.KV("canvas.id", cnv.ID().String()))
+	return toCanvas(info), nil
+}
+
+func (h *Handler) CanvasList(ctx context.Context, p *api.CanvasListPayload) (*api.Canvases, error) {
+	infos, err := h.repo.ListCanvases(ctx, p.IncludeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("list canvases: %w", err)
+	}
+
+	canvases := make([]*api.Canvas, len(infos))
+	for i, info := range infos {
+		canvases[i] = toCanvas(info)
+	}
+
+	return &api.Canvases{Canvases: canvases}, nil
+}
+
+func (h *Handler) CanvasGet(ctx context.Context, p *api.CanvasGetPayload) (*api.Canvas, error) {
+	info, err := h.canvasInfo(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toCanvas(info), nil
+}
+
+func (h *Handler) CanvasArchive(ctx context.Context, p *api.CanvasArchivePayload) (*api.Canvas, error) {
+	canvasID, err := idgen.FromString[idgen.Canvas](p.ID)
+	if err != nil {
+		return nil, goa.InvalidFieldTypeError("id", p.ID, "canvas ID")
+	}
+
+	info, err := h.repo.ArchiveCanvas(ctx, canvasID, time.Now().UTC())
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		return nil, api.MakeNotFound(fmt.Errorf("canvas %s not found", p.ID))
+	case err != nil:
+		return nil, fmt.Errorf("archive canvas: %w", err)
+	}
+
+	ctxlog.Print(ctx, "archived canvas", ctxlog.KV("canvas.id", p.ID))
+	return toCanvas(info), nil
+}
+
+func (h *Handler) CanvasResize(ctx context.Context, p *api.CanvasResizePayload) (*api.Canvas, error) {
+	info, err := h.canvasInfo(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case info.Width+int(p.Left+p.Right) > apiv1.MaxCanvasSize:
+		return nil, goa.InvalidRangeError("left + right", p.Left+p.Right, apiv1.MaxCanvasSize-info.Width, false)
+	case info.Height+int(p.Top+p.Bottom) > apiv1.MaxCanvasSize:
+		return nil, goa.InvalidRangeError("top + bottom", p.Top+p.Bottom, apiv1.MaxCanvasSize-info.Height, false)
+	case int(p.Fill) >= len(info.Palette):
+		return nil, goa.InvalidRangeError("fill", p.Fill, len(info.Palette)-1, false)
+	}
+
+	info, err = h.repo.ResizeCanvas(ctx, info.ID, canvas.Resize{
+		Left:   int(p.Left),
+		Top:    int(p.Top),
+		Right:  int(p.Right),
+		Bottom: int(p.Bottom),
+		Fill:   uint8(p.Fill), //nolint:gosec
+	})
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		return nil, api.MakeNotFound(fmt.Errorf("canvas %s not found", p.ID))
+	case errors.Is(err, canvas.ErrArchived):
+		return nil, api.MakeCanvasArchived(fmt.Errorf("canvas %s is archived", p.ID))
+	case err != nil:
+		return nil, fmt.Errorf("resize canvas: %w", err)
+	}
+
+	// The resize has been stored, so failing to publish it must not fail the
+	// request. This instance reloads the canvas regardless, while other
+	// instances will only see it once they resync.
+	if err := h.bus.PublishChange(ctx, info.ID); err != nil {
+		ctxlog.Error(ctx, "error publishing canvas change", err)
+		h.reload(ctx, info.ID)
+	}
+
+	ctxlog.Print(ctx, "resized canvas",
+		ctxlog.KV("canvas.id", p.ID),
+		ctxlog.KV("canvas.width", info.Width),
+		ctxlog.KV("canvas.height", info.Height),
+	)
+	return toCanvas(info), nil
+}
+
+func (h *Handler) ProtectedRegionCreate(ctx context.Context, p *api.ProtectedRegionCreatePayload) (*api.ProtectedRegion, error) {
+	info, err := h.canvasInfo(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case int(p.X)+int(p.Width) > info.Width:
+		return nil, goa.InvalidRangeError("x + width", int(p.X)+int(p.Width), info.Width, false)
+	case int(p.Y)+int(p.Height) > info.Height:
+		return nil, goa.InvalidRangeError("y + height", int(p.Y)+int(p.Height), info.Height, false)
+	}
+
+	now := time.Now().UTC()
+	region := canvas.ProtectedRegion{
+		ID:        idgen.New[idgen.Region](),
+		Bounds:    image.Rect(int(p.X), int(p.Y), int(p.X+p.Width), int(p.Y+p.Height)),
+		CreatedAt: now,
+	}
+	if p.Reason != nil {
+		region.Reason = *p.Reason
+	}
+	if p.Duration != nil {
+		region.ExpiresAt = now.Add(time.Duration(*p.Duration) * time.Second)
+	}
+
+	err = h.repo.ProtectRegion(ctx, info.ID, region)
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		return nil, api.MakeNotFound(fmt.Errorf("canvas %s not found", p.ID))
+	case err != nil:
+		return nil, fmt.Errorf("protect region: %w", err)
+	}
+
+	ctxlog.Print(ctx, "protected canvas region",
+		ctxlog.KV("canvas.id", p.ID),
+		ctxlog.KV("region.id", region.ID.String()),
+		ctxlog.KV("region.bounds", region.Bounds.String()),
+	)
+	return toProtectedRegion(region), nil
+}
+
+func (h *Handler) ProtectedRegionDelete(ctx context.Context, p *api.ProtectedRegionDeletePayload) error {
+	canvasID, err := idgen.FromString[idgen.Canvas](p.ID)
+	if err != nil {
+		return goa.InvalidFieldTypeError("id", p.ID, "canvas ID")
+	}
+
+	regionID, err := idgen.FromString[idgen.Region](p.RegionID)
+	if err != nil {
+		return goa.InvalidFieldTypeError("region_id", p.RegionID, "region ID")
+	}
+
+	err = h.repo.UnprotectRegion(ctx, canvasID, regionID)
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		return api.MakeNotFound(fmt.Errorf("canvas %s not found", p.ID))
+	case errors.Is(err, canvas.ErrRegionNotFound):
+		return api.MakeNotFound(fmt.Errorf("protected region %s not found", p.RegionID))
+	case err != nil:
+		return fmt.Errorf("unprotect region: %w", err)
+	}
+
+	ctxlog.Print(ctx, "deleted protected canvas region",
+		ctxlog.KV("canvas.id", p.ID),
+		ctxlog.KV("region.id", p.RegionID),
+	)
+	return nil
+}
+
+func toProtectedRegion(r canvas.ProtectedRegion) *api.ProtectedRegion {
+	res := &api.ProtectedRegion{
+		ID:        r.ID.String(),
+		X:         int32(r.Bounds.Min.X), //nolint:gosec
+		Y:         int32(r.Bounds.Min.Y), //nolint:gosec
+		Width:     int32(r.Bounds.Dx()),  //nolint:gosec
+		Height:    int32(r.Bounds.Dy()),  //nolint:gosec
+		Reason:    r.Reason,
+		CreatedAt: r.CreatedAt.Format(time.RFC3339Nano),
+	}
+	if !r.ExpiresAt.IsZero() {
+		expiresAt := r.ExpiresAt.Format(time.RFC3339Nano)
+		res.ExpiresAt = &expiresAt
+	}
+	return res
+}
+
+func (h *Handler) CanvasRollback(ctx context.Context, p *api.CanvasRollbackPayload) (*api.Rollback, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := toRollback(lc.canvas, p)
+	if err != nil {
+		return nil, err
+	}
+
+	reverts, err := h.rollback(ctx, lc, r, authn.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ctxlog.Print(ctx, "rolled back placements",
+		ctxlog.KV("canvas.id", p.ID),
+		ctxlog.KV("rollback", r.String()),
+		ctxlog.KV("rollback.reverted", len(reverts)),
+	)
+	return &api.Rollback{Reverted: int32(len(reverts))}, nil //nolint:gosec
+}
+
+func toRollback(cnv *canvas.Canvas, p *api.CanvasRollbackPayload) (canvas.Rollback, error) {
+	var r canvas.Rollback
+
+	if p.UserID != nil {
+		userID, err := idgen.FromString[idgen.User](*p.UserID)
+		if err != nil {
+			return r, goa.InvalidFieldTypeError("user_id", *p.UserID, "user ID")
+		}
+		r.UserID = userID
+	}
+
+	if p.X != nil || p.Y != nil || p.Width != nil || p.Height != nil {
+		switch {
+		case p.X == nil:
+			return r, goa.MissingFieldError("x", "body")
+		case p.Y == nil:
+			return r, goa.MissingFieldError("y", "body")
+		case p.Width == nil:
+			return r, goa.MissingFieldError("width", "body")
+		case p.Height == nil:
+			return r, goa.MissingFieldError("height", "body")
+		}
+		if err := validateRegion(cnv, *p.X, *p.Y, *p.Width, *p.Height); err != nil {
+			return r, err
+		}
+		r.Bounds = image.Rect(int(*p.X), int(*p.Y), int(*p.X+*p.Width), int(*p.Y+*p.Height))
+	}
+
+	// Without a user or a region, a rollback would revert every pixel placed
+	// within its time window, which is more likely a mistake than intended.
+	if p.UserID == nil && r.Bounds.Empty() {
+		return r, goa.MissingFieldError("user_id", "body")
+	}
+
+	var err error
+	if p.From != nil {
+		if r.From, err = time.Parse(time.RFC3339, *p.From); err != nil {
+			return r, goa.InvalidFormatError("from", *p.From, goa.FormatDateTime, err)
+		}
+	}
+	if p.To != nil {
+		if r.To, err = time.Parse(time.RFC3339, *p.To); err != nil {
+			return r, goa.InvalidFormatError("to", *p.To, goa.FormatDateTime, err)
+		}
+	}
+	if p.From != nil && p.To != nil && !r.To.After(r.From) {
+		return r, goa.InvalidFieldTypeError("to", *p.To, "time after from")
+	}
+
+	return r, nil
+}
+
+// rollback stores the placements that revert the pixels selected by a rollback
+// and applies them like those of place, holding the same lock so that they are
+// applied and published in sequence order along with any other placements.
+func (h *Handler) rollback(ctx context.Context, lc *liveCanvas, r canvas.Rollback, by idgen.ID[idgen.User]) ([]canvas.Placement, error) {
+	lc.placeMu.Lock()
+	defer lc.placeMu.Unlock()
+
+	reverts, err := h.repo.RollbackPlacements(ctx, lc.canvas.ID(), r, by, time.Now().UTC())
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		return nil, api.MakeNotFound(fmt.Errorf("canvas %s not found", lc.canvas.ID()))
+	case errors.Is(err, canvas.ErrArchived):
+		return nil, api.MakeCanvasArchived(fmt.Errorf("canvas %s is archived", lc.canvas.ID()))
+	case err != nil:
+		return nil, fmt.Errorf("rollback placements: %w", err)
+	}
+
+	for _, p := range reverts {
+		if _, err := lc.canvas.Apply(p); err != nil {
+			return nil, fmt.Errorf("apply placement: %w", err)
+		}
+
+		// The placements have been stored, so failing to publish them must not
+		// fail the request. Other instances will only see them once they reload.
+		if err := h.bus.Publish(ctx, p); err != nil {
+			ctxlog.Error(ctx, "error publishing placement", err)
+		}
+	}
+
+	return reverts, nil
+}
+
+func (h *Handler) AuditLogGet(ctx context.Context, p *api.AuditLogGetPayload) (*api.AuditLog, error) {
+	canvasID, err := idgen.FromString[idgen.Canvas](p.ID)
+	if err != nil {
+		return nil, goa.InvalidFieldTypeError("id", p.ID, "canvas ID")
+	}
+
+	entries, err := h.repo.ListAuditEntries(ctx, canvasID, int(p.Limit))
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		return nil, api.MakeNotFound(fmt.Errorf("canvas %s not found", p.ID))
+	case err != nil:
+		return nil, fmt.Errorf("list audit entries: %w", err)
+	}
+
+	res := &api.AuditLog{Entries: make([]*api.AuditEntry, len(entries))}
+	for i, entry := range entries {
+		res.Entries[i] = &api.AuditEntry{
+			Action:    entry.Action,
+			UserID:    entry.UserID.String(),
+			Details:   entry.Details,
+			CreatedAt: entry.CreatedAt.Format(time.RFC3339Nano),
+		}
+	}
+	return res, nil
+}
+
+func (h *Handler) canvasInfo(ctx context.Context, id string) (canvas.Info, error) {
+	canvasID, err := idgen.FromString[idgen.Canvas](id)
+	if err != nil {
+		return canvas.Info{}, goa.InvalidFieldTypeError("id", id, "canvas ID")
+	}
+
+	info, err := h.repo.GetCanvasInfo(ctx, canvasID)
+	switch {
+	case errors.Is(err, canvas.ErrNotFound):
+		return canvas.Info{}, api.MakeNotFound(fmt.Errorf("canvas %s not found", id))
+	case err != nil:
+		return canvas.Info{}, fmt.Errorf("get canvas: %w", err)
+	}
+	return info, nil
+}
+
+func toCanvas(info canvas.Info) *api.Canvas {
+	res := &api.Canvas{
+		ID:        info.ID.String(),
+		Width:     int32(info.Width),  //nolint:gosec
+		Height:    int32(info.Height), //nolint:gosec
+		Palette:   info.Palette.Hex(),
+		CreatedAt: info.CreatedAt.Format(time.RFC3339Nano),
+		Version:   int32(info.Version), //nolint:gosec
+
+		ProtectedRegions: make([]*api.ProtectedRegion, len(info.ProtectedRegions)),
+	}
+	for i, r := range info.ProtectedRegions {
+		res.ProtectedRegions[i] = toProtectedRegion(r)
+	}
+	if info.Archived() {
+		archivedAt := info.ArchivedAt.Format(time.RFC3339Nano)
+		res.ArchivedAt = &archivedAt
+	}
+	return res
+}
+
+func (h *Handler) CanvasPixelsGet(ctx context.Context, p *api.CanvasPixelsGetPayload) (*api.CanvasPixels, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CanvasPixels{
+		Width:  int32(lc.canvas.Width()),  //nolint:gosec
+		Height: int32(lc.canvas.Height()), //nolint:gosec
+		Pixels: lc.canvas.Pixels(),
+	}, nil
+}
+
+func (h *Handler) CanvasChunksGet(ctx context.Context, p *api.CanvasChunksGetPayload) (*api.CanvasChunks, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks, version := lc.canvas.ChunksSince(p.Since)
+
+	res := &api.CanvasChunks{
+		Version:   version,
+		ChunkSize: canvas.ChunkSize,
+		Chunks:    make([]*api.CanvasChunk, len(chunks)),
+	}
+	for i, ch := range chunks {
+		bounds := lc.canvas.ChunkBounds(ch.X, ch.Y)
+		res.Chunks[i] = &api.CanvasChunk{
+			X:       int32(ch.X),        //nolint:gosec
+			Y:       int32(ch.Y),        //nolint:gosec
+			Width:   int32(bounds.Dx()), //nolint:gosec
+			Height:  int32(bounds.Dy()), //nolint:gosec
+			Version: ch.Version,
+			Pixels:  ch.Pixels,
+		}
+	}
+
+	return res, nil
+}
+
+func (h *Handler) CanvasRegionGet(ctx context.Context, p *api.CanvasRegionGetPayload) (*api.CanvasRegion, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRegion(lc.canvas, p.X, p.Y, p.Width, p.Height); err != nil {
+		return nil, err
+	}
+
+	pixels, err := lc.canvas.Region(int(p.X), int(p.Y), int(p.Width), int(p.Height))
+	if err != nil {
+		return nil, fmt.Errorf("get region: %w", err)
+	}
+
+	return &api.CanvasRegion{
+		X:      p.X,
+		Y:      p.Y,
+		Width:  p.Width,
+		Height: p.Height,
+		Pixels: pixels,
+	}, nil
+}
+
+func (h *Handler) CanvasImageGet(ctx context.Context, p *api.CanvasImageGetPayload) ([]byte, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateImageScale(lc.canvas.Width(), lc.canvas.Height(), p.Scale); err != nil {
+		return nil, err
+	}
+
+	return renderPNG(lc.canvas.Pixels(), lc.canvas.Width(), lc.canvas.Height(), lc.canvas.Palette(), int(p.Scale))
+}
+
+func (h *Handler) CanvasRegionImageGet(ctx context.Context, p *api.CanvasRegionImageGetPayload) ([]byte, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRegion(lc.canvas, p.X, p.Y, p.Width, p.Height); err != nil {
+		return nil, err
+	}
+
+	if err := validateImageScale(int(p.Width), int(p.Height), p.Scale); err != nil {
+		return nil, err
+	}
+
+	pixels, err := lc.canvas.Region(int(p.X), int(p.Y), int(p.Width), int(p.Height))
+	if err != nil {
+		return nil, fmt.Errorf("get region: %w", err)
+	}
+
+	return renderPNG(pixels, int(p.Width), int(p.Height), lc.canvas.Palette(), int(p.Scale))
+}
+
+func (h *Handler) CanvasTileGet(ctx context.Context, p *api.CanvasTileGetPayload) (*api.CanvasTile, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tile, err := lc.tiles.get(lc.canvas, int(p.Z), int(p.X), int(p.Y))
+	switch {
+	case errors.Is(err, canvas.ErrOutOfBounds):
+		return nil, api.MakeNotFound(fmt.Errorf("tile %d/%d/%d not found", p.Z, p.X, p.Y))
+	case err != nil:
+		return nil, fmt.Errorf("render tile: %w", err)
+	}
+
+	res := &api.CanvasTile{
+		Status:       "ok",
+		Etag:         tile.etag,
+		CacheControl: h.tileCacheControl,
+	}
+
+	if p.IfNoneMatch != nil && etagMatches(*p.IfNoneMatch, tile.etag) {
+		res.Status = "not_modified"
+		return res, nil
+	}
+
+	res.Image = tile.image
+	return res, nil
+}
+
+func renderPNG(pixels []byte, width, height int, palette canvas.Palette, scale int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := canvas.RenderPNG(&buf, pixels, width, height, palette, scale); err != nil {
+		return nil, fmt.Errorf("render png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (h *Handler) CanvasSubscribe(ctx context.Context, p *api.CanvasSubscribePayload, stream api.CanvasSubscribeServerStream) error {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+
+	since, resume, err := resumeFrom(p)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before replaying so that no placement is missed in between.
+	sub, err := lc.updates.Subscribe(ctx)
+	if err != nil {
+		return fmt.Errorf("subscribe to updates: %w", err)
+	}
+	defer func() { sub.Close() }()
+
+	var replayed int64
+	if resume {
+		replayed, err = h.replay(ctx, lc.canvas, since, stream)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		placements, err := sub.Next(ctx)
+		if err != nil {
+			next, ok := h.replacement(ctx, lc, err)
+			if !ok {
+				return endOfUpdates(ctx, err)
+			}
+
+			// The canvas was reloaded after changing, such as by being resized,
+			// so carry on from a snapshot of the new copy.
+			sub.Close()
+			lc = next
+			sub, err = lc.updates.Subscribe(ctx)
+			if err != nil {
+				return fmt.Errorf("subscribe to updates: %w", err)
+			}
+
+			replayed, err = sendSnapshot(ctx, lc.canvas, stream)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, p := range placements {
+			if p.Seq <= replayed {
+				continue
+			}
+			if err := stream.SendWithContext(ctx, toCanvasEvent(p)); err != nil {
+				return fmt.Errorf("send event: %w", err)
+			}
+		}
+	}
+}
+
+// resumeFrom returns the sequence number a subscriber wants to resume from, if
+// any. Reconnecting SSE clients set the Last-Event-ID header to the ID of the
+// last event they received, which is a sequence number.
+func resumeFrom(p *api.CanvasSubscribePayload) (int64, bool, error) {
+	switch {
+	case p.Since != nil:
+		return *p.Since, true, nil
+	case p.LastEventID != nil:
+		since, err := strconv.ParseInt(*p.LastEventID, 10, 64)
+		if err != nil || since < 0 {
+			return 0, false, goa.InvalidFieldTypeError("last_event_id", *p.LastEventID, "sequence number")
+		}
+		return since, true, nil
+	}
+	return 0, false, nil
+}
+
+// replay sends the placements made after since, or a snapshot of the canvas if
+// there are more than the replay limit or the canvas has been resized since,
+// and returns the sequence number the subscriber has been brought up to.
+func (h *Handler) replay(ctx context.Context, cnv *canvas.Canvas, since int64, stream api.CanvasSubscribeServerStream) (int64, error) {
+	seq := cnv.Seq()
+	if since > seq || seq-since > h.replayLimit || since < cnv.ResizedSeq() {
+		return sendSnapshot(ctx, cnv, stream)
+	}
+
+	placements, err := h.repo.ListPlacements(ctx, cnv.ID(), since, int(h.replayLimit))
+	if err != nil {
+		return 0, fmt.Errorf("list placements: %w", err)
+	}
+
+	for _, p := range placements {
+		if err := stream.SendWithContext(ctx, toCanvasEvent(p)); err != nil {
+			return 0, fmt.Errorf("send event: %w", err)
+		}
+		since = p.Seq
+	}
+	return since, nil
+}
+
+// sendSnapshot sends every pixel on the canvas and returns the sequence number
+// the snapshot was taken at.
+func sendSnapshot(ctx context.Context, cnv *canvas.Canvas, stream api.CanvasSubscribeServerStream) (int64, error) {
+	pixels, seq := cnv.Capture()
+	event := &api.CanvasEvent{
+		ID:   strconv.FormatInt(seq, 10),
+		Type: "snapshot",
+		Snapshot: &api.CanvasSnapshot{
+			Seq:    seq,
+			Width:  int32(cnv.Width()),  //nolint:gosec
+			Height: int32(cnv.Height()), //nolint:gosec
+			Pixels: pixels,
+		},
+	}
+	if err := stream.SendWithContext(ctx, event); err != nil {
+		return 0, fmt.Errorf("send snapshot: %w", err)
+	}
+	return seq, nil
+}
+
+// endOfUpdates ends a stream once its subscription to updates has ended. This
+// is not treated as an error, and clients are expected to reconnect.
+func endOfUpdates(ctx context.Context, err error) error {
+	if errors.Is(err, hub.ErrSlowConsumer) {
+		ctxlog.Info(ctx, "ending stream of slow consumer")
+	}
+	return nil
+}
+
+func toCanvasEvent(p canvas.Placement) *api.CanvasEvent {
+	return &api.CanvasEvent{
+		ID:    strconv.FormatInt(p.Seq, 10),
+		Type:  "pixel",
+		Pixel: toPixelEvent(p),
+	}
+}
+
+func toPixelEvent(p canvas.Placement) *api.PixelEvent {
+	return &api.PixelEvent{
+		X:        int32(p.X), //nolint:gosec
+		Y:        int32(p.Y), //nolint:gosec
+		Color:    int32(p.Color),
+		UserID:   p.UserID.String(),
+		PlacedAt: p.PlacedAt.Format(time.RFC3339Nano),
+		Seq:      p.Seq,
+	}
+}
+
+func (h *Handler) CanvasSession(ctx context.Context, p *api.CanvasSessionPayload, stream api.CanvasSessionServerStream) error {
+	defer stream.Close()
+
+	info, err := h.canvasInfo(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+
+	// The first event sent upgrades the connection, so it must happen before
+	// the stream is read from and written to concurrently.
+	if err := stream.SendWithContext(ctx, &api.SessionEvent{Canvas: toCanvas(info)}); err != nil {
+		return fmt.Errorf("send canvas: %w", err)
+	}
+
+	sub, err := lc.updates.Subscribe(ctx)
+	if err != nil {
+		return fmt.Errorf("subscribe to updates: %w", err)
+	}
+	defer func() { sub.Close() }()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	placements := make(chan sessionPlacement)
+	go func() {
+		for {
+			p, err := stream.RecvWithContext(ctx)
+			select {
+			case placements <- sessionPlacement{p, err}:
+			case <-done:
+				return
+			}
+			if err != nil && !isServiceError(err) {
+				return
+			}
+		}
+	}()
+
+	userID := authn.UserIDFromContext(ctx)
+	for {
+		var events []*api.SessionEvent
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Done():
+			next, ok := h.replacement(ctx, lc, sub.Err())
+			if !ok {
+				return endOfUpdates(ctx, sub.Err())
+			}
+
+			// The canvas was reloaded after changing, such as by being resized,
+			// so send it again for the client to lay it out anew.
+			info, err := h.repo.GetCanvasInfo(ctx, next.canvas.ID())
+			if err != nil {
+				return fmt.Errorf("get canvas: %w", err)
+			}
+
+			sub.Close()
+			lc = next
+			sub, err = lc.updates.Subscribe(ctx)
+			if err != nil {
+				return fmt.Errorf("subscribe to updates: %w", err)
+			}
+			events = append(events, &api.SessionEvent{Canvas: toCanvas(info)})
+		case <-sub.Ready():
+			for _, p := range sub.Drain() {
+				events = append(events, &api.SessionEvent{Pixel: toPixelEvent(p)})
+			}
+		case p := <-placements:
+			switch {
+			case websocket.IsCloseError(p.err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived, websocket.CloseAbnormalClosure):
+				return nil
+			case isServiceError(p.err):
+				events = append(events, &api.SessionEvent{Rejection: toPlacementRejection(nil, p.err)})
+			case p.err != nil:
+				return fmt.Errorf("receive placement: %w", p.err)
+			default:
+				err := h.placePixel(ctx, lc, userID, p.X, p.Y, p.Color)
+				switch {
+				case err == nil:
+					continue
+				case isServiceError(err):
+					events = append(events, &api.SessionEvent{Rejection: toPlacementRejection(p.PixelPlacement, err)})
+				default:
+					return err
+				}
+			}
+		}
+
+		for _, event := range events {
+			if err := stream.SendWithContext(ctx, event); err != nil {
+				return fmt.Errorf("send event: %w", err)
+			}
+		}
+	}
 }
 
-func (h *Handler) CanvasGet(_ context.Context) (*api.Canvas, error) {
-	return &api.Canvas{
-		ID:     idgen.New[idgen.Canvas]().String(),
-		Width:  100,
-		Height: 100,
+type sessionPlacement struct {
+	*api.PixelPlacement
+	err error
+}
+
+func isServiceError(err error) bool {
+	var cerr *api.CooldownError
+	var serr *goa.ServiceError
+	return errors.As(err, &cerr) || errors.As(err, &serr)
+}
+
+func toPlacementRejection(p *api.PixelPlacement, err error) *api.PlacementRejection {
+	var rejection api.PlacementRejection
+
+	var cerr *api.CooldownError
+	var serr *goa.ServiceError
+	switch {
+	case errors.As(err, &cerr):
+		rejection.Name = apiv1.ErrCodeCooldownActive
+		rejection.Message = cerr.Message
+		rejection.RetryAfter = &cerr.RetryAfter
+	case errors.As(err, &serr):
+		rejection.Name = serr.GoaErrorName()
+		rejection.Message = serr.Message
+	}
+
+	if p != nil {
+		rejection.X = &p.X
+		rejection.Y = &p.Y
+	}
+
+	return &rejection
+}
+
+func (h *Handler) PixelPlace(ctx context.Context, p *api.PixelPlacePayload) (*api.Pixel, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.placePixel(ctx, lc, authn.UserIDFromContext(ctx), p.X, p.Y, p.Color); err != nil {
+		return nil, err
+	}
+
+	return &api.Pixel{
+		X:     p.X,
+		Y:     p.Y,
+		Color: p.Color,
+	}, nil
+}
+
+func (h *Handler) placePixel(ctx context.Context, lc *liveCanvas, userID idgen.ID[idgen.User], x, y, color int32) error {
+	if err := validatePixel(lc.canvas, x, y, color); err != nil {
+		return err
+	}
+
+	// The cooldown tracked by this instance turns away most placements made
+	// too soon without going to storage, which enforces the cooldown across
+	// instances.
+	if err := lc.cooldown.Acquire(userID); err != nil {
+		var cerr *cooldown.ActiveError
+		if errors.As(err, &cerr) {
+			return toCooldownError(cerr)
+		}
+		return fmt.Errorf("acquire cooldown: %w", err)
+	}
+
+	placement := canvas.Placement{
+		CanvasID: lc.canvas.ID(),
+		X:        int(x),
+		Y:        int(y),
+		Color:    uint8(color), //nolint:gosec
+		UserID:   userID,
+		PlacedAt: time.Now().UTC(),
+	}
+
+	if err := h.place(ctx, lc, placement); err != nil {
+		lc.cooldown.Release(userID)
+		return err
+	}
+
+	return nil
+}
+
+func toCooldownError(err *cooldown.ActiveError) *api.CooldownError {
+	return &api.CooldownError{
+		Message:    err.Error(),
+		RetryAfter: int32(math.Ceil(err.Remaining.Seconds())),
+	}
+}
+
+func (h *Handler) place(ctx context.Context, lc *liveCanvas, p canvas.Placement) error {
+	lc.placeMu.Lock()
+	defer lc.placeMu.Unlock()
+
+	seq, err := h.repo.InsertPlacement(ctx, p, h.cooldownPeriod)
+	var cerr *cooldown.ActiveError
+	switch {
+	case errors.As(err, &cerr):
+		return toCooldownError(cerr)
+	case errors.Is(err, canvas.ErrArchived):
+		return api.MakeCanvasArchived(fmt.Errorf("canvas %s is archived", p.CanvasID))
+	case errors.Is(err, canvas.ErrProtected):
+		return api.MakeAccessDenied(fmt.Errorf("pixel (%d, %d) is in a protected region", p.X, p.Y))
+	case err != nil:
+		return fmt.Errorf("store placement: %w", err)
+	}
+	p.Seq = seq
+
+	if _, err := lc.canvas.Apply(p); err != nil {
+		return fmt.Errorf("apply placement: %w", err)
+	}
+
+	// The placement has been stored, so failing to publish it must not fail
+	// the request. Other instances will only see it once they reload.
+	if err := h.bus.Publish(ctx, p); err != nil {
+		ctxlog.Error(ctx, "error publishing placement", err)
+	}
+
+	return nil
+}
+
+// receive handles placements delivered by the event bus, including those made
+// on this instance. The bus delivers each canvas's placements in sequence
+// order, but a placement made on this instance is applied before those
+// delivered ahead of it, so placements on a pixel already set by a later one
+// are ignored rather than applied and published out of order. Placements on
+// canvases that have not been loaded are ignored too, since they will be
+// included once the canvas is loaded from storage.
+func (h *Handler) receive(ctx context.Context, p canvas.Placement) {
+	lc, ok := h.loadedCanvas(p.CanvasID)
+	if !ok || p.Seq <= lc.loadedSeq {
+		return
+	}
+
+	applied, err := lc.canvas.Apply(p)
+	if err != nil {
+		ctxlog.Error(ctx, "error applying received placement", err)
+		return
+	}
+	if !applied {
+		return
+	}
+
+	lc.updates.Publish(ctx, p)
+}
+
+func (h *Handler) PixelInfoGet(ctx context.Context, p *api.PixelInfoGetPayload) (*api.PixelInfo, error) {
+	lc, err := h.liveCanvas(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePoint(lc.canvas, p.X, p.Y); err != nil {
+		return nil, err
+	}
+
+	placements, err := h.repo.ListPixelPlacements(ctx, lc.canvas.ID(), int(p.X), int(p.Y), int(p.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("list pixel placements: %w", err)
+	}
+
+	entries := make([]*api.PixelHistoryEntry, len(placements))
+	for i, placement := range placements {
+		entries[i] = &api.PixelHistoryEntry{
+			UserID:   placement.UserID.String(),
+			Color:    int32(placement.Color),
+			PlacedAt: placement.PlacedAt.Format(time.RFC3339Nano),
+			Seq:      placement.Seq,
+		}
+	}
+
+	return &api.PixelInfo{
+		X:          p.X,
+		Y:          p.Y,
+		Placements: entries,
 	}, nil
 }
 
+// Resync restores every loaded canvas from storage, catching up on placements
+// that the event bus may not have delivered.
+func (h *Handler) Resync(ctx context.Context) {
+	for _, lc := range h.loadedCanvases() {
+		cnv, err := h.repo.GetCanvas(ctx, lc.canvas.ID())
+		if err != nil {
+			ctxlog.Error(ctx, "error loading canvas to resync", err, ctxlog.KV("canvas.id", lc.canvas.ID().String()))
+			continue
+		}
+
+		// A canvas resized in the meantime can't be restored in place.
+		if cnv.Version() != lc.canvas.Version() {
+			h.reload(ctx, lc.canvas.ID())
+			continue
+		}
+
+		restored, err := lc.canvas.Restore(cnv.Snapshot())
+		if err != nil {
+			ctxlog.Error(ctx, "error restoring canvas to resync", err, ctxlog.KV("canvas.id", lc.canvas.ID().String()))
+			continue
+		}
+
+		if restored {
+			ctxlog.Debug(ctx, "resynced canvas",
+				ctxlog.KV("canvas.id", lc.canvas.ID().String()),
+				ctxlog.KV("canvas.seq", cnv.Seq()),
+			)
+		}
+	}
+}
+
+func validatePixel(cnv *canvas.Canvas, x, y, color int32) error {
+	if err := validatePoint(cnv, x, y); err != nil {
+		return err
+	}
+	if int(color) >= len(cnv.Palette()) {
+		return goa.InvalidRangeError("color", color, len(cnv.Palette())-1, false)
+	}
+	return nil
+}
+
+func validatePoint(cnv *canvas.Canvas, x, y int32) error {
+	switch {
+	case int(x) >= cnv.Width():
+		return goa.InvalidRangeError("x", x, cnv.Width()-1, false)
+	case int(y) >= cnv.Height():
+		return goa.InvalidRangeError("y", y, cnv.Height()-1, false)
+	}
+	return nil
+}
+
+func validateRegion(cnv *canvas.Canvas, x, y, width, height int32) error {
+	switch {
+	case int(x) >= cnv.Width():
+		return goa.InvalidRangeError("x", x, cnv.Width()-1, false)
+	case int(y) >= cnv.Height():
+		return goa.InvalidRangeError("y", y, cnv.Height()-1, false)
+	case int(x+width) > cnv.Width():
+		return goa.InvalidRangeError("width", width, cnv.Width()-int(x), false)
+	case int(y+height) > cnv.Height():
+		return goa.InvalidRangeError("height", height, cnv.Height()-int(y), false)
+	}
+	return nil
+}
+
+func validateImageScale(width, height int, scale int32) error {
+	if maxScale := apiv1.MaxImageLength / max(width, height); int(scale) > maxScale {
+		return goa.InvalidRangeError("scale", scale, maxScale, false)
+	}
+	return nil
+}
+
 var _ healthz.Target = (*Handler)(nil)
 
 func (h *Handler) HealthChecks() []health.Check {
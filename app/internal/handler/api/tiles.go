@@ -0,0 +1,98 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image/png"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+)
+
+// tileCache holds the latest rendering of each tile of a canvas, which is
+// served until a placement changes the version of the tile.
+type tileCache struct {
+	mu    sync.Mutex
+	tiles map[tileKey]renderedTile
+}
+
+type tileKey struct {
+	z, x, y int
+}
+
+type renderedTile struct {
+	version int64
+	image   []byte
+	etag    string
+}
+
+func newTileCache() *tileCache {
+	return &tileCache{tiles: make(map[tileKey]renderedTile)}
+}
+
+func (c *tileCache) get(cnv *canvas.Canvas, z, x, y int) (renderedTile, error) {
+	version, err := cnv.TileVersion(z, x, y)
+	if err != nil {
+		return renderedTile{}, err //nolint:wrapcheck
+	}
+
+	key := tileKey{z: z, x: x, y: y}
+	c.mu.Lock()
+	tile, ok := c.tiles[key]
+	c.mu.Unlock()
+	if ok && tile.version == version {
+		return tile, nil
+	}
+
+	img, version, err := cnv.Tile(z, x, y)
+	if err != nil {
+		return renderedTile{}, err //nolint:wrapcheck
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return renderedTile{}, fmt.Errorf("encode png: %w", err)
+	}
+
+	// The ETag is derived from the content rather than the version, so that it
+	// stays the same across instances and restarts.
+	sum := sha256.Sum256(buf.Bytes())
+	tile = renderedTile{
+		version: version,
+		image:   buf.Bytes(),
+		etag:    `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}
+
+	c.mu.Lock()
+	if cached, ok := c.tiles[key]; !ok || cached.version < tile.version {
+		c.tiles[key] = tile
+	}
+	c.mu.Unlock()
+
+	return tile, nil
+}
+
+// tileCacheControl returns the Cache-Control header for tiles, which lets
+// clients and shared caches reuse them for maxAge before revalidating them.
+func tileCacheControl(maxAge time.Duration) string {
+	if maxAge <= 0 {
+		return "public, no-cache"
+	}
+	return fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+}
+
+// etagMatches reports whether an If-None-Match header matches etag, using the
+// weak comparison that RFC 9110 requires for it.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestETagMatches(t *testing.T) {
+	const etag = `"0123456789abcdef"`
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{name: "Empty", ifNoneMatch: "", want: false},
+		{name: "Strong", ifNoneMatch: `"0123456789abcdef"`, want: true},
+		{name: "Weak", ifNoneMatch: `W/"0123456789abcdef"`, want: true},
+		{name: "Different", ifNoneMatch: `"fedcba9876543210"`, want: false},
+		{name: "WeakDifferent", ifNoneMatch: `W/"fedcba9876543210"`, want: false},
+		{name: "Unquoted", ifNoneMatch: `0123456789abcdef`, want: false},
+		{name: "Any", ifNoneMatch: "*", want: true},
+		{name: "List", ifNoneMatch: `"fedcba9876543210", "0123456789abcdef"`, want: true},
+		{name: "ListWeak", ifNoneMatch: `"fedcba9876543210",W/"0123456789abcdef"`, want: true},
+		{name: "ListNoMatch", ifNoneMatch: `"fedcba9876543210", W/"00000000"`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, etag); got != tt.want {
+				t.Errorf("etagMatches(%q, %q): got %t, want %t", tt.ifNoneMatch, etag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTileCacheControl(t *testing.T) {
+	tests := []struct {
+		maxAge time.Duration
+		want   string
+	}{
+		{maxAge: 0, want: "public, no-cache"},
+		{maxAge: 10 * time.Second, want: "public, max-age=10"},
+		{maxAge: time.Hour, want: "public, max-age=3600"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.maxAge.String(), func(t *testing.T) {
+			if got := tileCacheControl(tt.maxAge); got != tt.want {
+				t.Errorf("tileCacheControl(%s): got %q, want %q", tt.maxAge, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package hub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	ErrClosed       = errors.New("hub closed")
+	ErrSlowConsumer = errors.New("subscriber too slow to keep up")
+)
+
+// Policy determines what happens to messages published to a subscriber whose
+// buffer is full.
+type Policy string
+
+const (
+	// PolicyDrop discards new messages until the subscriber catches up.
+	PolicyDrop Policy = "drop"
+	// PolicyCoalesce discards pending messages superseded by a newer message
+	// with the same key, then new messages if there is still no room.
+	PolicyCoalesce Policy = "coalesce"
+	// PolicyDisconnect ends the subscription with ErrSlowConsumer.
+	PolicyDisconnect Policy = "disconnect"
+)
+
+func ParsePolicy(s string) (Policy, error) {
+	switch p := Policy(s); p {
+	case PolicyDrop, PolicyCoalesce, PolicyDisconnect:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown policy %q", s)
+	}
+}
+
+// Hub fans out published messages to every subscriber, each with its own
+// bounded buffer so that a slow subscriber never blocks publishers or other
+// subscribers. The key of a message identifies which messages it supersedes
+// when coalescing.
+type Hub[T any, K comparable] struct {
+	bufferSize int
+	policy     Policy
+	key        func(T) K
+	attrs      metric.MeasurementOption
+
+	mu     sync.RWMutex
+	subs   map[*Subscription[T, K]]struct{}
+	closed bool
+}
+
+func New[T any, K comparable](name string, bufferSize int, policy Policy, key func(T) K) *Hub[T, K] {
+	return &Hub[T, K]{
+		bufferSize: max(bufferSize, 1),
+		policy:     policy,
+		key:        key,
+		attrs: metric.WithAttributes(
+			attribute.String("hub.name", name),
+			attribute.String("hub.policy", string(policy)),
+		),
+		subs: make(map[*Subscription[T, K]]struct{}),
+	}
+}
+
+func (h *Hub[T, K]) Subscribe(ctx context.Context) (*Subscription[T, K], error) {
+	initMetrics(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrClosed
+	}
+
+	sub := &Subscription[T, K]{
+		hub:     h,
+		pending: make([]T, 0, h.bufferSize+1),
+		ready:   make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	h.subs[sub] = struct{}{}
+	metrics.hubSubscribers.Add(ctx, 1, h.attrs)
+
+	return sub, nil
+}
+
+func (h *Hub[T, K]) Publish(ctx context.Context, msg T) {
+	initMetrics(ctx)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subs {
+		sub.push(ctx, msg)
+	}
+}
+
+// Close ends every subscription with ErrClosed and rejects new subscribers.
+func (h *Hub[T, K]) Close() {
+	ctx := context.Background()
+	initMetrics(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subs {
+		sub.end(ErrClosed)
+		delete(h.subs, sub)
+		metrics.hubSubscribers.Add(ctx, -1, h.attrs)
+	}
+}
+
+func (h *Hub[T, K]) unsubscribe(sub *Subscription[T, K]) {
+	ctx := context.Background()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		metrics.hubSubscribers.Add(ctx, -1, h.attrs)
+	}
+}
+
+type Subscription[T any, K comparable] struct {
+	hub *Hub[T, K]
+
+	mu      sync.Mutex
+	pending []T
+	ready   chan struct{}
+	done    chan struct{}
+	err     error
+}
+
+// Ready is signalled whenever messages become pending.
+func (s *Subscription[T, K]) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Done is closed once the subscription has ended, after which Err reports why.
+func (s *Subscription[T, K]) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *Subscription[T, K]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Drain returns all pending messages in the order they were published.
+func (s *Subscription[T, K]) Drain() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	msgs := make([]T, len(s.pending))
+	copy(msgs, s.pending)
+	s.pending = s.pending[:0]
+
+	return msgs
+}
+
+// Next waits for and drains pending messages, returning an error once the
+// subscription has ended or ctx is done.
+func (s *Subscription[T, K]) Next(ctx context.Context) ([]T, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.done:
+			return nil, s.Err()
+		case <-s.ready:
+			if msgs := s.Drain(); len(msgs) > 0 {
+				return msgs, nil
+			}
+		}
+	}
+}
+
+func (s *Subscription[T, K]) Close() {
+	s.hub.unsubscribe(s)
+	s.end(ErrClosed)
+}
+
+func (s *Subscription[T, K]) push(ctx context.Context, msg T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	s.pending = append(s.pending, msg)
+
+	if len(s.pending) > s.hub.bufferSize {
+		switch s.hub.policy {
+		case PolicyDisconnect:
+			s.endLocked(ErrSlowConsumer)
+			metrics.hubSubscribersDisconnectedTotal.Add(ctx, 1, s.hub.attrs)
+			return
+		case PolicyCoalesce:
+			s.coalesce(ctx)
+		}
+	}
+
+	if n := len(s.pending); n > s.hub.bufferSize {
+		var zero T
+		s.pending[n-1] = zero
+		s.pending = s.pending[:n-1]
+		metrics.hubMessagesDroppedTotal.Add(ctx, 1, s.hub.attrs, metric.WithAttributes(attribute.String("reason", "buffer_full")))
+		return
+	}
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// coalesce removes pending messages superseded by a later message with the
+// same key, keeping the remaining messages in order.
+func (s *Subscription[T, K]) coalesce(ctx context.Context) {
+	seen := make(map[K]bool, len(s.pending))
+	superseded := make([]bool, len(s.pending))
+	for i := len(s.pending) - 1; i >= 0; i-- {
+		key := s.hub.key(s.pending[i])
+		superseded[i] = seen[key]
+		seen[key] = true
+	}
+
+	kept := s.pending[:0]
+	for i, msg := range s.pending {
+		if !superseded[i] {
+			kept = append(kept, msg)
+		}
+	}
+
+	if n := len(s.pending) - len(kept); n > 0 {
+		metrics.hubMessagesDroppedTotal.Add(ctx, int64(n), s.hub.attrs, metric.WithAttributes(attribute.String("reason", "coalesced")))
+	}
+	clear(s.pending[len(kept):])
+	s.pending = kept
+}
+
+func (s *Subscription[T, K]) end(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.endLocked(err)
+}
+
+func (s *Subscription[T, K]) endLocked(err error) {
+	if s.err != nil {
+		return
+	}
+	s.err = err
+	s.pending = nil
+	close(s.done)
+}
@@ -0,0 +1,119 @@
+package hub
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"testing"
+
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestMain(m *testing.M) {
+	// Record metrics with no-op instruments, since the tests run without the
+	// OTel provider that initMetrics expects.
+	metrics.init.Do(func() {
+		metrics.hubSubscribers, _ = noop.Meter{}.Int64UpDownCounter("")            //nolint:errcheck
+		metrics.hubMessagesDroppedTotal, _ = noop.Meter{}.Int64Counter("")         //nolint:errcheck
+		metrics.hubSubscribersDisconnectedTotal, _ = noop.Meter{}.Int64Counter("") //nolint:errcheck
+	})
+	os.Exit(m.Run())
+}
+
+type message struct {
+	key string
+	n   int
+}
+
+func (m message) Key() string {
+	return m.key
+}
+
+func TestSlowConsumerPolicy(t *testing.T) {
+	published := []message{{"a", 1}, {"b", 2}, {"a", 3}, {"c", 4}}
+
+	tests := []struct {
+		policy  Policy
+		want    []message
+		wantErr error
+	}{
+		{
+			policy: PolicyDrop,
+			want:   []message{{"a", 1}, {"b", 2}},
+		},
+		{
+			policy: PolicyCoalesce,
+			want:   []message{{"b", 2}, {"a", 3}},
+		},
+		{
+			policy:  PolicyDisconnect,
+			want:    []message{},
+			wantErr: ErrSlowConsumer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			h := New("test", 2, tt.policy, message.Key)
+			t.Cleanup(h.Close)
+
+			slow, err := h.Subscribe(t.Context())
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+			fast, err := h.Subscribe(t.Context())
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+
+			var received []message
+			for _, msg := range published {
+				h.Publish(t.Context(), msg)
+				received = append(received, fast.Drain()...)
+			}
+
+			if !slices.Equal(received, published) {
+				t.Errorf("fast subscriber: got %v, want %v", received, published)
+			}
+			if err := fast.Err(); err != nil {
+				t.Errorf("fast subscriber: got error %v, want nil", err)
+			}
+
+			if got := slow.Drain(); !slices.Equal(got, tt.want) {
+				t.Errorf("slow subscriber: got %v, want %v", got, tt.want)
+			}
+			if err := slow.Err(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("slow subscriber: got error %v, want %v", err, tt.wantErr)
+			}
+
+			select {
+			case <-slow.Done():
+				if tt.wantErr == nil {
+					t.Error("slow subscriber: ended, want it to stay subscribed")
+				}
+			default:
+				if tt.wantErr != nil {
+					t.Error("slow subscriber: still subscribed, want it to have ended")
+				}
+			}
+		})
+	}
+}
+
+func TestClose(t *testing.T) {
+	h := New("test", 2, PolicyDrop, message.Key)
+
+	sub, err := h.Subscribe(t.Context())
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	h.Close()
+
+	if _, err := sub.Next(t.Context()); !errors.Is(err, ErrClosed) {
+		t.Errorf("Next: got error %v, want %v", err, ErrClosed)
+	}
+	if _, err := h.Subscribe(t.Context()); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe: got error %v, want %v", err, ErrClosed)
+	}
+}
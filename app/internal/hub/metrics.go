@@ -0,0 +1,42 @@
+package hub
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/instrument"
+)
+
+var metrics struct {
+	init                            sync.Once
+	hubSubscribers                  metric.Int64UpDownCounter
+	hubMessagesDroppedTotal         metric.Int64Counter
+	hubSubscribersDisconnectedTotal metric.Int64Counter
+}
+
+func initMetrics(ctx context.Context) {
+	metrics.init.Do(func() {
+		var err error
+		metrics.hubSubscribers, err = instrument.OTel.Meter().Int64UpDownCounter("hub.subscribers")
+		if err != nil {
+			ctxlog.Error(ctx, "error initializing metric", err)
+			metrics.hubSubscribers, _ = noop.Meter{}.Int64UpDownCounter("") //nolint:errcheck
+		}
+
+		metrics.hubMessagesDroppedTotal, err = instrument.OTel.Meter().Int64Counter("hub.messages.dropped.total")
+		if err != nil {
+			ctxlog.Error(ctx, "error initializing metric", err)
+			metrics.hubMessagesDroppedTotal, _ = noop.Meter{}.Int64Counter("") //nolint:errcheck
+		}
+
+		metrics.hubSubscribersDisconnectedTotal, err = instrument.OTel.Meter().Int64Counter("hub.subscribers.disconnected.total")
+		if err != nil {
+			ctxlog.Error(ctx, "error initializing metric", err)
+			metrics.hubSubscribersDisconnectedTotal, _ = noop.Meter{}.Int64Counter("") //nolint:errcheck
+		}
+	})
+}
@@ -15,3 +15,11 @@ func (r Request) IDPrefix() string { return "req" }
 type Canvas struct{}
 
 func (r Canvas) IDPrefix() string { return "cnv" }
+
+type User struct{}
+
+func (r User) IDPrefix() string { return "usr" }
+
+type Region struct{}
+
+func (r Region) IDPrefix() string { return "rgn" }
@@ -24,7 +24,12 @@ func FromString[T Resource](id string) (ID[T], error) {
 		return ID[T]{ksuid.Nil}, fmt.Errorf("%T must have prefix %q", res, prefix)
 	}
 
-	uid, err := ksuid.Parse(strings.TrimPrefix(id, prefix))
+	return FromUnprefixed[T](strings.TrimPrefix(id, prefix))
+}
+
+// FromUnprefixed parses an ID without its prefix, as it is stored.
+func FromUnprefixed[T Resource](id string) (ID[T], error) {
+	uid, err := ksuid.Parse(id)
 	if err != nil {
 		return ID[T]{ksuid.Nil}, fmt.Errorf("parse uid value: %w", err)
 	}
@@ -61,3 +66,16 @@ var _ pgtype.TextScanner = (*ID[resource])(nil)
 func (id *ID[T]) ScanText(v pgtype.Text) error {
 	return id.uid.Scan(v.String) //nolint:wrapcheck
 }
+
+func (id ID[T]) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func (id *ID[T]) UnmarshalText(b []byte) error {
+	parsed, err := FromString[T](string(b))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
@@ -76,6 +76,12 @@ func (s *AdminServer) Shutdown(ctx context.Context) error {
 	return s.srv.Shutdown(ctx) //nolint:wrapcheck
 }
 
+// Route registers an admin-only endpoint, which is not exposed on the public
+// HTTP server.
+func (s *AdminServer) Route(method, pattern string, h http.HandlerFunc) {
+	s.mux.Method(method, pattern, h)
+}
+
 func (s *AdminServer) Administer(targets ...healthz.Target) {
 	for _, target := range targets {
 		s.checks = append(s.checks, target.HealthChecks()...)
@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
+	"goa.design/goa/v3/grpc/middleware"
 	"google.golang.org/grpc"
 	grpchealth "google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -28,6 +29,8 @@ type GRPCServer struct {
 	name string
 	addr string
 	srv  *grpc.Server
+
+	stopStreams context.CancelFunc
 }
 
 func NewGRPCServer[SS any](ctx context.Context, name string, port int) *GRPCServer {
@@ -40,6 +43,8 @@ func NewGRPCServer[SS any](ctx context.Context, name string, port int) *GRPCServ
 		healthpb.Health_Watch_FullMethodName:                                         true,
 	}
 
+	streams, stopStreams := context.WithCancel(context.Background())
+
 	logCtx := log.With(ctx, ctxlog.KV("server", name))
 	srv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
@@ -48,6 +53,13 @@ func NewGRPCServer[SS any](ctx context.Context, name string, port int) *GRPCServ
 			withMethodFilter(ctxlog.UnaryServerInterceptor(logCtx), excludedMethods),
 			withMethodFilter(debug.UnaryServerInterceptor(), excludedMethods),
 		),
+		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(logCtx),
+			withStreamMethodFilter(reqid.StreamServerInterceptor(), excludedMethods),
+			withStreamMethodFilter(ctxlog.StreamServerInterceptor(logCtx), excludedMethods),
+			withStreamMethodFilter(debug.StreamServerInterceptor(), excludedMethods),
+			endStreamsOnShutdown(streams),
+		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler(
 			otelgrpc.WithSpanAttributes(attribute.String("rpc.server.name", name)),
 			otelgrpc.WithFilter(func(info *stats.RPCTagInfo) bool {
@@ -60,9 +72,10 @@ func NewGRPCServer[SS any](ctx context.Context, name string, port int) *GRPCServ
 	healthpb.RegisterHealthServer(srv, grpchealth.NewServer())
 
 	return &GRPCServer{
-		name: name,
-		addr: addr,
-		srv:  srv,
+		name:        name,
+		addr:        addr,
+		srv:         srv,
+		stopStreams: stopStreams,
 	}
 }
 
@@ -75,6 +88,30 @@ func withMethodFilter(interceptor grpc.UnaryServerInterceptor, excluded map[stri
 	}
 }
 
+// endStreamsOnShutdown cancels the context of streaming calls once the server
+// starts shutting down, as they would otherwise hold up graceful shutdown until
+// it times out.
+func endStreamsOnShutdown(streams context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := context.WithCancel(stream.Context())
+		defer cancel()
+
+		stop := context.AfterFunc(streams, cancel)
+		defer stop()
+
+		return handler(srv, middleware.NewWrappedServerStream(ctx, stream))
+	}
+}
+
+func withStreamMethodFilter(interceptor grpc.StreamServerInterceptor, excluded map[string]bool) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if exclude := excluded[info.FullMethod]; exclude {
+			return handler(srv, stream)
+		}
+		return interceptor(srv, stream, info, handler)
+	}
+}
+
 func (s *GRPCServer) RegisterHandler(sd *grpc.ServiceDesc, ss any) {
 	s.srv.RegisterService(sd, ss)
 }
@@ -108,6 +145,8 @@ func (s *GRPCServer) Serve(ctx context.Context) error {
 }
 
 func (s *GRPCServer) Shutdown(ctx context.Context) error {
+	s.stopStreams()
+
 	ok := make(chan struct{})
 
 	go func() {
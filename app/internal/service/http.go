@@ -1,13 +1,18 @@
 package service
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/alexliesenfeld/health"
+	"github.com/felixge/httpsnoop"
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/otel/attribute"
 	"goa.design/clue/debug"
@@ -26,10 +31,15 @@ type HTTPServer struct {
 	addr string
 	srv  *http.Server
 	mux  *chi.Mux
+
+	streams     context.Context
+	stopStreams context.CancelFunc
+	hijacked    sync.WaitGroup
 }
 
 func NewHTTPServer(_ context.Context, name string, port int) *HTTPServer {
 	addr := fmt.Sprintf(":%d", port)
+	streams, stopStreams := context.WithCancel(context.Background())
 
 	return &HTTPServer{
 		name: name,
@@ -38,7 +48,9 @@ func NewHTTPServer(_ context.Context, name string, port int) *HTTPServer {
 			Addr:              addr,
 			ReadHeaderTimeout: time.Second,
 		},
-		mux: chi.NewRouter(),
+		mux:         chi.NewRouter(),
+		streams:     streams,
+		stopStreams: stopStreams,
 	}
 }
 
@@ -85,9 +97,66 @@ func (s *HTTPServer) router(ctx context.Context) http.Handler {
 		withPathFilter(reqid.HTTP(), excludedPaths),
 		withPathFilter(ctxlog.HTTP(logCtx), excludedPaths),
 		withPathFilter(debug.HTTP(), excludedPaths),
+		s.endStreamsOnShutdown,
 	)
 }
 
+// endStreamsOnShutdown cancels the context of requests that turn out to be
+// long-lived event streams or upgraded connections once the server starts
+// shutting down. Event streams would otherwise never become idle and hold up
+// graceful shutdown until it times out, while upgraded connections are not
+// tracked by the server at all and would be cut off without notice.
+func (s *HTTPServer) endStreamsOnShutdown(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		stop := func() bool { return false }
+		defer func() { stop() }()
+
+		var hijacked bool
+		defer func() {
+			if hijacked {
+				s.hijacked.Done()
+			}
+		}()
+
+		// Clients don't always announce that they expect a stream, so also look
+		// at what the handler ends up responding with.
+		if isEventStream(r.Header.Get("Accept")) {
+			stop = context.AfterFunc(s.streams, cancel)
+		} else {
+			w = httpsnoop.Wrap(w, httpsnoop.Hooks{
+				WriteHeader: func(writeHeader httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+					return func(code int) {
+						if isEventStream(w.Header().Get("Content-Type")) {
+							stop = context.AfterFunc(s.streams, cancel)
+						}
+						writeHeader(code)
+					}
+				},
+				Hijack: func(hijack httpsnoop.HijackFunc) httpsnoop.HijackFunc {
+					return func() (net.Conn, *bufio.ReadWriter, error) {
+						conn, rw, err := hijack()
+						if err == nil {
+							s.hijacked.Add(1)
+							hijacked = true
+							stop = context.AfterFunc(s.streams, cancel)
+						}
+						return conn, rw, err
+					}
+				},
+			})
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func isEventStream(mediaType string) bool {
+	return strings.Contains(mediaType, "text/event-stream")
+}
+
 func chainMiddleware(h http.Handler, m ...func(http.Handler) http.Handler) http.Handler {
 	for i := len(m) - 1; i >= 0; i-- {
 		h = m[i](h)
@@ -108,7 +177,25 @@ func withPathFilter(m func(http.Handler) http.Handler, excluded map[string]bool)
 }
 
 func (s *HTTPServer) Shutdown(ctx context.Context) error {
-	return s.srv.Shutdown(ctx) //nolint:wrapcheck
+	s.stopStreams()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	// The server doesn't wait for hijacked connections, so wait for their
+	// handlers to close them.
+	done := make(chan struct{})
+	go func() {
+		s.hijacked.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	}
 }
 
 var _ healthz.Target = (*HTTPServer)(nil)
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEndStreamsOnShutdown(t *testing.T) {
+	tests := []struct {
+		name        string
+		accept      string
+		contentType string
+		wantEnded   bool
+	}{
+		{
+			name:        "AcceptEventStream",
+			accept:      "text/event-stream",
+			contentType: "text/event-stream",
+			wantEnded:   true,
+		},
+		{
+			name:        "RespondEventStream",
+			contentType: "text/event-stream; charset=utf-8",
+			wantEnded:   true,
+		},
+		{
+			name:        "Other",
+			contentType: "application/json",
+			wantEnded:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewHTTPServer(t.Context(), "test", 0)
+
+			started := make(chan struct{})
+			ended := make(chan bool, 1)
+			srv := httptest.NewServer(s.endStreamsOnShutdown(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(http.StatusOK)
+				w.(http.Flusher).Flush()
+				close(started)
+
+				select {
+				case <-r.Context().Done():
+					ended <- true
+				case <-time.After(200 * time.Millisecond):
+					ended <- false
+				}
+			})))
+			t.Cleanup(srv.Close)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			res, err := srv.Client().Do(req)
+			if err != nil {
+				t.Fatalf("Do: %v", err)
+			}
+			defer res.Body.Close()
+			<-started
+
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			defer cancel()
+			if err := s.Shutdown(ctx); err != nil {
+				t.Fatalf("Shutdown: %v", err)
+			}
+
+			if got := <-ended; got != tt.wantEnded {
+				t.Errorf("got request ended on shutdown %t, want %t", got, tt.wantEnded)
+			}
+		})
+	}
+}
+
+func TestEndStreamsOnShutdownHijacked(t *testing.T) {
+	s := NewHTTPServer(t.Context(), "test", 0)
+
+	hijacked := make(chan struct{})
+	var closed atomic.Bool
+	srv := httptest.NewServer(s.endStreamsOnShutdown(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("Hijack: %v", err)
+			return
+		}
+		close(hijacked)
+
+		// Handlers take a moment to wind down their connection once told to.
+		<-r.Context().Done()
+		time.Sleep(50 * time.Millisecond)
+		closed.Store(true)
+		conn.Close()
+	})))
+	t.Cleanup(srv.Close)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	go srv.Client().Do(req) //nolint:errcheck,bodyclose
+	<-hijacked
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	if !closed.Load() {
+		t.Error("Shutdown: returned before the hijacked connection was closed")
+	}
+}
@@ -0,0 +1,147 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/ctxlog"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/service"
+)
+
+// Snapshotter periodically compacts the placements of every active canvas into
+// a snapshot, so that loading a canvas only replays the placements made since.
+// Snapshots are built from storage rather than from the canvases held in
+// memory, which may not yet have received every placement.
+type Snapshotter struct {
+	repo     canvas.Repository
+	interval time.Duration
+	retain   int
+
+	mu      sync.Mutex
+	lastSeq map[idgen.ID[idgen.Canvas]]int64
+
+	stopped context.Context
+	stop    context.CancelFunc
+	done    chan struct{}
+}
+
+func NewSnapshotter(repo canvas.Repository, interval time.Duration, retain int) *Snapshotter {
+	stopped, stop := context.WithCancel(context.Background())
+	return &Snapshotter{
+		repo:     repo,
+		interval: interval,
+		retain:   max(retain, 1),
+		lastSeq:  make(map[idgen.ID[idgen.Canvas]]int64),
+		stopped:  stopped,
+		stop:     stop,
+		done:     make(chan struct{}),
+	}
+}
+
+var _ service.Server = (*Snapshotter)(nil)
+
+func (s *Snapshotter) Name() string {
+	return "canvas"
+}
+
+func (s *Snapshotter) Kind() string {
+	return "snapshotter"
+}
+
+func (s *Snapshotter) Addr() string {
+	return ""
+}
+
+func (s *Snapshotter) Serve(ctx context.Context) error {
+	defer close(s.done)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer context.AfterFunc(s.stopped, cancel)()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.snapshotAll(ctx); err != nil {
+				ctxlog.Error(ctx, "error taking snapshots", err)
+			}
+		}
+	}
+}
+
+// Shutdown takes a final snapshot once the snapshotter has stopped, so that
+// the next instance to start has fewer placements to replay.
+func (s *Snapshotter) Shutdown(ctx context.Context) error {
+	s.stop()
+
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	}
+
+	return s.snapshotAll(ctx)
+}
+
+// snapshotAll snapshots every active canvas with placements since its last
+// snapshot, carrying on past canvases that fail.
+func (s *Snapshotter) snapshotAll(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos, err := s.repo.ListCanvases(ctx, false)
+	if err != nil {
+		return fmt.Errorf("list canvases: %w", err)
+	}
+
+	for _, info := range infos {
+		if info.Seq <= s.lastSeq[info.ID] {
+			continue
+		}
+
+		if err := s.snapshot(ctx, info.ID); err != nil {
+			ctxlog.Error(ctx, "error taking snapshot", err, ctxlog.KV("canvas.id", info.ID.String()))
+		}
+	}
+
+	return nil
+}
+
+func (s *Snapshotter) snapshot(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) error {
+	cnv, err := s.repo.GetCanvas(ctx, canvasID)
+	if err != nil {
+		return fmt.Errorf("get canvas: %w", err)
+	}
+
+	// Snapshots only include the chunks modified since the previous one. Until
+	// this snapshotter has taken one, every modified chunk is included.
+	snapshot := cnv.SnapshotSince(s.lastSeq[canvasID])
+	if snapshot.Seq <= s.lastSeq[canvasID] {
+		return nil
+	}
+
+	if err := s.repo.InsertSnapshot(ctx, snapshot); err != nil {
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+
+	if err := s.repo.PruneSnapshots(ctx, canvasID, s.retain); err != nil {
+		return fmt.Errorf("prune snapshots: %w", err)
+	}
+
+	s.lastSeq[canvasID] = snapshot.Seq
+	ctxlog.Debug(ctx, "took snapshot",
+		ctxlog.KV("canvas.id", canvasID.String()),
+		ctxlog.KV("snapshot.seq", snapshot.Seq),
+	)
+
+	return nil
+}
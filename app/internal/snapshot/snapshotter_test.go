@@ -0,0 +1,240 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"image"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/storage/memory"
+)
+
+// recordingRepo records the snapshots inserted into the repository it wraps.
+type recordingRepo struct {
+	canvas.Repository
+
+	mu       sync.Mutex
+	inserted []canvas.Snapshot
+}
+
+func (r *recordingRepo) InsertSnapshot(ctx context.Context, s canvas.Snapshot) error {
+	r.mu.Lock()
+	r.inserted = append(r.inserted, s)
+	r.mu.Unlock()
+	return r.Repository.InsertSnapshot(ctx, s)
+}
+
+func (r *recordingRepo) insertedSeqs() []int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seqs := make([]int64, len(r.inserted))
+	for i, s := range r.inserted {
+		seqs[i] = s.Seq
+	}
+	return seqs
+}
+
+func TestSnapshotAll(t *testing.T) {
+	repo := &recordingRepo{Repository: memory.NewStore()}
+	cnv := createCanvas(t, repo, 128, 64)
+	archived := createCanvas(t, repo, 8, 8)
+	place(t, repo, archived.ID(), image.Pt(0, 0))
+	if _, err := repo.ArchiveCanvas(t.Context(), archived.ID(), time.Now()); err != nil {
+		t.Fatalf("ArchiveCanvas: %v", err)
+	}
+
+	s := NewSnapshotter(repo, time.Hour, 3)
+
+	steps := []struct {
+		name   string
+		points []image.Point
+		// wantSeq is the sequence number of the snapshot taken, if any.
+		wantSeq int64
+		// wantChunks are the chunks included in the snapshot.
+		wantChunks []image.Point
+	}{
+		{
+			name:       "First",
+			points:     []image.Point{{0, 0}, {70, 0}},
+			wantSeq:    2,
+			wantChunks: []image.Point{{0, 0}, {1, 0}},
+		},
+		{
+			name: "Unchanged",
+		},
+		{
+			name:       "Incremental",
+			points:     []image.Point{{80, 10}},
+			wantSeq:    3,
+			wantChunks: []image.Point{{1, 0}},
+		},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			for _, pt := range step.points {
+				place(t, repo, cnv.ID(), pt)
+			}
+
+			before := len(repo.insertedSeqs())
+			if err := s.snapshotAll(t.Context()); err != nil {
+				t.Fatalf("snapshotAll: %v", err)
+			}
+
+			repo.mu.Lock()
+			inserted := slices.Clone(repo.inserted[before:])
+			repo.mu.Unlock()
+
+			if step.wantSeq == 0 {
+				if len(inserted) > 0 {
+					t.Fatalf("snapshotAll: took %d snapshots, want none", len(inserted))
+				}
+				return
+			}
+
+			if len(inserted) != 1 {
+				t.Fatalf("snapshotAll: took %d snapshots, want 1", len(inserted))
+			}
+			got := inserted[0]
+			if got.CanvasID != cnv.ID() || got.Seq != step.wantSeq {
+				t.Errorf("snapshotAll: took snapshot of %s at seq %d, want %s at seq %d", got.CanvasID, got.Seq, cnv.ID(), step.wantSeq)
+			}
+
+			var chunks []image.Point
+			for _, ch := range got.Chunks {
+				chunks = append(chunks, image.Pt(ch.X, ch.Y))
+			}
+			if !slices.Equal(chunks, step.wantChunks) {
+				t.Errorf("snapshotAll: took snapshot of chunks %v, want %v", chunks, step.wantChunks)
+			}
+		})
+	}
+}
+
+func TestSnapshotAllPrunes(t *testing.T) {
+	repo := memory.NewStore()
+	cnv := createCanvas(t, repo, 128, 64)
+	s := NewSnapshotter(repo, time.Hour, 2)
+
+	for _, pt := range []image.Point{{0, 0}, {70, 0}, {1, 0}} {
+		place(t, repo, cnv.ID(), pt)
+		if err := s.snapshotAll(t.Context()); err != nil {
+			t.Fatalf("snapshotAll: %v", err)
+		}
+	}
+
+	if _, err := repo.GetSnapshot(t.Context(), cnv.ID(), 1); !errors.Is(err, canvas.ErrSnapshotNotFound) {
+		t.Errorf("GetSnapshot(1): got error %v, want %v", err, canvas.ErrSnapshotNotFound)
+	}
+	for _, seq := range []int64{2, 3} {
+		if _, err := repo.GetSnapshot(t.Context(), cnv.ID(), seq); err != nil {
+			t.Errorf("GetSnapshot(%d): %v", seq, err)
+		}
+	}
+
+	// The snapshots kept still include the chunks of the pruned one.
+	latest, err := repo.GetLatestSnapshot(t.Context(), cnv.ID())
+	if err != nil {
+		t.Fatalf("GetLatestSnapshot: %v", err)
+	}
+	if len(latest.Chunks) != 2 {
+		t.Errorf("GetLatestSnapshot: got %d chunks, want 2", len(latest.Chunks))
+	}
+}
+
+func TestSnapshotterServe(t *testing.T) {
+	repo := &recordingRepo{Repository: memory.NewStore()}
+	cnv := createCanvas(t, repo, 8, 8)
+	place(t, repo, cnv.ID(), image.Pt(0, 0))
+
+	s := NewSnapshotter(repo, 10*time.Millisecond, 3)
+	served := make(chan error, 1)
+	go func() {
+		served <- s.Serve(context.Background())
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !slices.Equal(repo.insertedSeqs(), []int64{1}) {
+		if time.Now().After(deadline) {
+			t.Fatalf("took snapshots at %v, want one at seq 1", repo.insertedSeqs())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Ticks without placements since the last snapshot take none.
+	time.Sleep(50 * time.Millisecond)
+	if got := repo.insertedSeqs(); !slices.Equal(got, []int64{1}) {
+		t.Errorf("took snapshots at %v without new placements, want only seq 1", got)
+	}
+
+	shutdown(t, s, served)
+}
+
+func TestSnapshotterShutdown(t *testing.T) {
+	repo := &recordingRepo{Repository: memory.NewStore()}
+	cnv := createCanvas(t, repo, 8, 8)
+
+	s := NewSnapshotter(repo, time.Hour, 3)
+	served := make(chan error, 1)
+	go func() {
+		served <- s.Serve(context.Background())
+	}()
+
+	place(t, repo, cnv.ID(), image.Pt(0, 0))
+	place(t, repo, cnv.ID(), image.Pt(1, 0))
+
+	shutdown(t, s, served)
+
+	if got := repo.insertedSeqs(); !slices.Equal(got, []int64{2}) {
+		t.Errorf("took snapshots at %v, want a final one at seq 2", got)
+	}
+}
+
+func shutdown(t *testing.T, s *Snapshotter, served <-chan error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("Serve: %v", err)
+	}
+}
+
+func createCanvas(t *testing.T, repo canvas.Repository, width, height int) *canvas.Canvas {
+	t.Helper()
+
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), width, height, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := repo.CreateCanvas(t.Context(), cnv); err != nil {
+		t.Fatalf("CreateCanvas: %v", err)
+	}
+	return cnv
+}
+
+func place(t *testing.T, repo canvas.Repository, canvasID idgen.ID[idgen.Canvas], pt image.Point) {
+	t.Helper()
+
+	p := canvas.Placement{
+		CanvasID: canvasID,
+		X:        pt.X,
+		Y:        pt.Y,
+		Color:    1,
+		UserID:   idgen.New[idgen.User](),
+		PlacedAt: time.Now(),
+	}
+	if _, err := repo.InsertPlacement(t.Context(), p, 0); err != nil {
+		t.Fatalf("InsertPlacement: %v", err)
+	}
+}
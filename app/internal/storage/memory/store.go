@@ -0,0 +1,537 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"image"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/cooldown"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+type Store struct {
+	mu         sync.RWMutex
+	canvases   []canvasRecord
+	placements map[idgen.ID[idgen.Canvas]][]canvas.Placement
+	snapshots  map[idgen.ID[idgen.Canvas]][]canvas.Snapshot
+	auditLog   map[idgen.ID[idgen.Canvas]][]canvas.AuditEntry
+}
+
+type canvasRecord struct {
+	id      idgen.ID[idgen.Canvas]
+	width   int
+	height  int
+	palette canvas.Palette
+
+	seq        int64
+	version    int
+	resizedSeq int64
+
+	createdAt  time.Time
+	archivedAt time.Time
+
+	regions []canvas.ProtectedRegion
+
+	// lastPlaced is when each user last placed a pixel on the canvas, not
+	// counting the placements made by rollbacks.
+	lastPlaced map[idgen.ID[idgen.User]]time.Time
+}
+
+func NewStore() *Store {
+	return &Store{
+		placements: make(map[idgen.ID[idgen.Canvas]][]canvas.Placement),
+		snapshots:  make(map[idgen.ID[idgen.Canvas]][]canvas.Snapshot),
+		auditLog:   make(map[idgen.ID[idgen.Canvas]][]canvas.AuditEntry),
+	}
+}
+
+var _ canvas.Repository = (*Store)(nil)
+
+func (s *Store) CreateCanvas(_ context.Context, cnv *canvas.Canvas) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createCanvas(cnv)
+}
+
+func (s *Store) CreateInitialCanvas(_ context.Context, cnv *canvas.Canvas) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.canvases) > 0 {
+		return false, nil
+	}
+
+	if err := s.createCanvas(cnv); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Store) createCanvas(cnv *canvas.Canvas) error {
+	if _, ok := s.placements[cnv.ID()]; ok {
+		return canvas.ErrAlreadyExists
+	}
+
+	s.canvases = append(s.canvases, canvasRecord{
+		id:      cnv.ID(),
+		width:   cnv.Width(),
+		height:  cnv.Height(),
+		palette: slices.Clone(cnv.Palette()),
+		version: 1,
+
+		createdAt:  time.Now().UTC(),
+		lastPlaced: make(map[idgen.ID[idgen.User]]time.Time),
+	})
+	s.placements[cnv.ID()] = nil
+
+	return nil
+}
+
+func (s *Store) GetCanvas(_ context.Context, id idgen.ID[idgen.Canvas]) (*canvas.Canvas, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx := s.indexCanvas(id)
+	if idx < 0 {
+		return nil, canvas.ErrNotFound
+	}
+
+	return s.load(s.canvases[idx])
+}
+
+func (s *Store) GetCanvasInfo(_ context.Context, id idgen.ID[idgen.Canvas]) (canvas.Info, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx := s.indexCanvas(id)
+	if idx < 0 {
+		return canvas.Info{}, canvas.ErrNotFound
+	}
+
+	return s.info(s.canvases[idx]), nil
+}
+
+func (s *Store) ListCanvases(_ context.Context, includeArchived bool) ([]canvas.Info, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	infos := make([]canvas.Info, 0, len(s.canvases))
+	for _, rec := range s.canvases {
+		if !rec.archivedAt.IsZero() && !includeArchived {
+			continue
+		}
+		infos = append(infos, s.info(rec))
+	}
+	return infos, nil
+}
+
+func (s *Store) ArchiveCanvas(_ context.Context, id idgen.ID[idgen.Canvas], at time.Time) (canvas.Info, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.indexCanvas(id)
+	if idx < 0 {
+		return canvas.Info{}, canvas.ErrNotFound
+	}
+
+	if s.canvases[idx].archivedAt.IsZero() {
+		s.canvases[idx].archivedAt = at
+	}
+	return s.info(s.canvases[idx]), nil
+}
+
+func (s *Store) ResizeCanvas(_ context.Context, id idgen.ID[idgen.Canvas], r canvas.Resize) (canvas.Info, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.indexCanvas(id)
+	if idx < 0 {
+		return canvas.Info{}, canvas.ErrNotFound
+	}
+
+	rec := &s.canvases[idx]
+	if !rec.archivedAt.IsZero() {
+		return canvas.Info{}, canvas.ErrArchived
+	}
+
+	cnv, err := s.load(*rec)
+	if err != nil {
+		return canvas.Info{}, err
+	}
+
+	resized, err := cnv.Resize(r, rec.seq+1)
+	if err != nil {
+		return canvas.Info{}, fmt.Errorf("resize canvas: %w", err)
+	}
+
+	placements := s.placements[id]
+	for i := range placements {
+		placements[i].X += r.Left
+		placements[i].Y += r.Top
+	}
+	for i := range rec.regions {
+		rec.regions[i].Bounds = rec.regions[i].Bounds.Add(image.Pt(r.Left, r.Top))
+	}
+	s.snapshots[id] = []canvas.Snapshot{resized.Snapshot()}
+
+	rec.width = resized.Width()
+	rec.height = resized.Height()
+	rec.seq = resized.Seq()
+	rec.version = resized.Version()
+	rec.resizedSeq = resized.ResizedSeq()
+
+	return s.info(*rec), nil
+}
+
+func (s *Store) ProtectRegion(_ context.Context, canvasID idgen.ID[idgen.Canvas], r canvas.ProtectedRegion) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.indexCanvas(canvasID)
+	if idx < 0 {
+		return canvas.ErrNotFound
+	}
+
+	s.canvases[idx].regions = append(s.canvases[idx].regions, r)
+	return nil
+}
+
+func (s *Store) UnprotectRegion(_ context.Context, canvasID idgen.ID[idgen.Canvas], regionID idgen.ID[idgen.Region]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.indexCanvas(canvasID)
+	if idx < 0 {
+		return canvas.ErrNotFound
+	}
+
+	rec := &s.canvases[idx]
+	i := slices.IndexFunc(rec.regions, func(r canvas.ProtectedRegion) bool {
+		return r.ID == regionID
+	})
+	if i < 0 {
+		return canvas.ErrRegionNotFound
+	}
+
+	rec.regions = slices.Delete(rec.regions, i, i+1)
+	return nil
+}
+
+func (s *Store) indexCanvas(id idgen.ID[idgen.Canvas]) int {
+	return slices.IndexFunc(s.canvases, func(rec canvasRecord) bool {
+		return rec.id == id
+	})
+}
+
+func (s *Store) info(rec canvasRecord) canvas.Info {
+	return canvas.Info{
+		ID:         rec.id,
+		Width:      rec.width,
+		Height:     rec.height,
+		Palette:    slices.Clone(rec.palette),
+		Seq:        rec.seq,
+		Version:    rec.version,
+		ResizedSeq: rec.resizedSeq,
+		CreatedAt:  rec.createdAt,
+		ArchivedAt: rec.archivedAt,
+
+		ProtectedRegions: activeRegions(rec.regions, time.Now()),
+	}
+}
+
+func activeRegions(regions []canvas.ProtectedRegion, at time.Time) []canvas.ProtectedRegion {
+	active := make([]canvas.ProtectedRegion, 0, len(regions))
+	for _, r := range regions {
+		if r.Active(at) {
+			active = append(active, r)
+		}
+	}
+	return active
+}
+
+// load builds a canvas from its latest snapshot and the placements after it.
+func (s *Store) load(rec canvasRecord) (*canvas.Canvas, error) {
+	cnv, err := canvas.FromInfo(s.info(rec))
+	if err != nil {
+		return nil, fmt.Errorf("init canvas: %w", err)
+	}
+
+	if snapshots := s.snapshots[rec.id]; len(snapshots) > 0 {
+		if _, err := cnv.Restore(mergeSnapshots(snapshots)); err != nil {
+			return nil, fmt.Errorf("restore snapshot: %w", err)
+		}
+	}
+
+	placements := s.placements[rec.id]
+	for _, p := range placements[indexAfter(placements, cnv.Seq()):] {
+		if _, err := cnv.Apply(p); err != nil {
+			return nil, fmt.Errorf("apply placement %d: %w", p.Seq, err)
+		}
+	}
+
+	return cnv, nil
+}
+
+// resizeBase returns the canvas as of its latest resize, or a blank canvas if
+// it has never been resized.
+func (s *Store) resizeBase(rec canvasRecord) (*canvas.Canvas, error) {
+	cnv, err := canvas.FromInfo(s.info(rec))
+	if err != nil {
+		return nil, fmt.Errorf("init canvas: %w", err)
+	}
+	if rec.resizedSeq == 0 {
+		return cnv, nil
+	}
+
+	snapshot, err := s.snapshotAt(rec.id, rec.resizedSeq)
+	if err != nil {
+		return nil, fmt.Errorf("get resize snapshot: %w", err)
+	}
+	if _, err := cnv.Restore(snapshot); err != nil {
+		return nil, fmt.Errorf("restore resize snapshot: %w", err)
+	}
+	return cnv, nil
+}
+
+func (s *Store) InsertPlacement(_ context.Context, p canvas.Placement, cooldownPeriod time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.indexCanvas(p.CanvasID)
+	if idx < 0 {
+		return 0, canvas.ErrNotFound
+	}
+	if !s.canvases[idx].archivedAt.IsZero() {
+		return 0, canvas.ErrArchived
+	}
+	for _, r := range s.canvases[idx].regions {
+		if r.Protects(p.X, p.Y, p.PlacedAt) {
+			return 0, canvas.ErrProtected
+		}
+	}
+
+	rec := &s.canvases[idx]
+	if last, ok := rec.lastPlaced[p.UserID]; ok {
+		if remaining := last.Add(cooldownPeriod).Sub(p.PlacedAt); remaining > 0 {
+			return 0, &cooldown.ActiveError{Remaining: remaining}
+		}
+	}
+
+	rec.seq++
+	p.Seq = rec.seq
+	s.placements[p.CanvasID] = append(s.placements[p.CanvasID], p)
+	rec.lastPlaced[p.UserID] = p.PlacedAt
+
+	return p.Seq, nil
+}
+
+func (s *Store) ListPlacements(_ context.Context, canvasID idgen.ID[idgen.Canvas], afterSeq int64, limit int) ([]canvas.Placement, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	placements, ok := s.placements[canvasID]
+	if !ok {
+		return nil, canvas.ErrNotFound
+	}
+
+	start := indexAfter(placements, afterSeq)
+	end := min(start+max(limit, 0), len(placements))
+	return slices.Clone(placements[start:end]), nil
+}
+
+// indexAfter returns the index of the first placement with a sequence number
+// after seq. Placements are stored in sequence order, but not every sequence
+// number belongs to a placement since resizes take them up too.
+func indexAfter(placements []canvas.Placement, seq int64) int {
+	idx, _ := slices.BinarySearchFunc(placements, seq+1, func(p canvas.Placement, seq int64) int {
+		return cmp.Compare(p.Seq, seq)
+	})
+	return idx
+}
+
+func (s *Store) ListPixelPlacements(_ context.Context, canvasID idgen.ID[idgen.Canvas], x, y, limit int) ([]canvas.Placement, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	placements, ok := s.placements[canvasID]
+	if !ok {
+		return nil, canvas.ErrNotFound
+	}
+
+	var found []canvas.Placement
+	for i := len(placements) - 1; i >= 0 && len(found) < limit; i-- {
+		if p := placements[i]; p.X == x && p.Y == y {
+			found = append(found, p)
+		}
+	}
+	return found, nil
+}
+
+func (s *Store) RollbackPlacements(_ context.Context, canvasID idgen.ID[idgen.Canvas], r canvas.Rollback, by idgen.ID[idgen.User], at time.Time) ([]canvas.Placement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.indexCanvas(canvasID)
+	if idx < 0 {
+		return nil, canvas.ErrNotFound
+	}
+
+	rec := &s.canvases[idx]
+	if !rec.archivedAt.IsZero() {
+		return nil, canvas.ErrArchived
+	}
+
+	base, err := s.resizeBase(*rec)
+	if err != nil {
+		return nil, err
+	}
+
+	placements := s.placements[canvasID]
+	reverts := r.Reverts(base, placements[indexAfter(placements, rec.resizedSeq):])
+	for i := range reverts {
+		rec.seq++
+		reverts[i].CanvasID = canvasID
+		reverts[i].UserID = by
+		reverts[i].PlacedAt = at
+		reverts[i].Seq = rec.seq
+	}
+	s.placements[canvasID] = append(s.placements[canvasID], reverts...)
+	s.auditLog[canvasID] = append(s.auditLog[canvasID], r.AuditEntry(canvasID, by, at, len(reverts)))
+
+	return slices.Clone(reverts), nil
+}
+
+func (s *Store) ListAuditEntries(_ context.Context, canvasID idgen.ID[idgen.Canvas], limit int) ([]canvas.AuditEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.placements[canvasID]; !ok {
+		return nil, canvas.ErrNotFound
+	}
+
+	entries := s.auditLog[canvasID]
+	latest := make([]canvas.AuditEntry, 0, min(max(limit, 0), len(entries)))
+	for i := len(entries) - 1; i >= 0 && len(latest) < limit; i-- {
+		latest = append(latest, entries[i])
+	}
+	return latest, nil
+}
+
+func (s *Store) InsertSnapshot(_ context.Context, snapshot canvas.Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.placements[snapshot.CanvasID]; !ok {
+		return canvas.ErrNotFound
+	}
+
+	snapshots := s.snapshots[snapshot.CanvasID]
+	idx, found := slices.BinarySearchFunc(snapshots, snapshot.Seq, func(s canvas.Snapshot, seq int64) int {
+		return cmp.Compare(s.Seq, seq)
+	})
+	if found {
+		return nil
+	}
+
+	snapshot.Chunks = cloneChunks(snapshot.Chunks)
+	s.snapshots[snapshot.CanvasID] = slices.Insert(snapshots, idx, snapshot)
+
+	return nil
+}
+
+func (s *Store) GetLatestSnapshot(_ context.Context, canvasID idgen.ID[idgen.Canvas]) (canvas.Snapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	snapshots := s.snapshots[canvasID]
+	if len(snapshots) == 0 {
+		return canvas.Snapshot{}, canvas.ErrSnapshotNotFound
+	}
+
+	return mergeSnapshots(snapshots), nil
+}
+
+func (s *Store) GetSnapshot(_ context.Context, canvasID idgen.ID[idgen.Canvas], seq int64) (canvas.Snapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.snapshotAt(canvasID, seq)
+}
+
+func (s *Store) snapshotAt(canvasID idgen.ID[idgen.Canvas], seq int64) (canvas.Snapshot, error) {
+	snapshots := s.snapshots[canvasID]
+	i := slices.IndexFunc(snapshots, func(snapshot canvas.Snapshot) bool {
+		return snapshot.Seq == seq
+	})
+	if i < 0 {
+		return canvas.Snapshot{}, canvas.ErrSnapshotNotFound
+	}
+
+	return mergeSnapshots(snapshots[:i+1]), nil
+}
+
+func (s *Store) PruneSnapshots(_ context.Context, canvasID idgen.ID[idgen.Canvas], keep int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The snapshot of the latest resize is kept apart from the others, since
+	// placements before it cannot be replayed onto the resized canvas.
+	snapshots := s.snapshots[canvasID]
+	var kept []canvas.Snapshot
+	if idx := s.indexCanvas(canvasID); idx >= 0 && len(snapshots) > 0 {
+		if resizedSeq := s.canvases[idx].resizedSeq; resizedSeq > 0 && snapshots[0].Seq == resizedSeq {
+			kept = snapshots[:1]
+		}
+	}
+
+	switch {
+	case keep <= 0:
+		if len(kept) == 0 {
+			delete(s.snapshots, canvasID)
+			return nil
+		}
+		s.snapshots[canvasID] = kept
+	case len(snapshots) > keep:
+		// The oldest snapshot kept absorbs the chunks of the snapshots before
+		// it, which may not have changed since.
+		cutoff := len(snapshots) - keep
+		oldest := mergeSnapshots(snapshots[:cutoff+1])
+		s.snapshots[canvasID] = slices.Concat(kept, []canvas.Snapshot{oldest}, snapshots[cutoff+1:])
+	}
+
+	return nil
+}
+
+// mergeSnapshots returns the latest of a sequence of snapshots, including the
+// chunks carried over from earlier snapshots.
+func mergeSnapshots(snapshots []canvas.Snapshot) canvas.Snapshot {
+	type key struct{ x, y int }
+	chunks := make(map[key]canvas.Chunk)
+	for _, snapshot := range snapshots {
+		for _, ch := range snapshot.Chunks {
+			chunks[key{ch.X, ch.Y}] = ch
+		}
+	}
+
+	merged := snapshots[len(snapshots)-1]
+	merged.Chunks = cloneChunks(slices.SortedFunc(maps.Values(chunks), func(a, b canvas.Chunk) int {
+		return cmp.Or(cmp.Compare(a.Y, b.Y), cmp.Compare(a.X, b.X))
+	}))
+	return merged
+}
+
+func cloneChunks(chunks []canvas.Chunk) []canvas.Chunk {
+	cloned := make([]canvas.Chunk, len(chunks))
+	for i, ch := range chunks {
+		ch.Pixels = slices.Clone(ch.Pixels)
+		cloned[i] = ch
+	}
+	return cloned
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/storage/memory"
+	"github.com/jace-ys/pikcel/internal/storage/storagetest"
+)
+
+func TestStore(t *testing.T) {
+	storagetest.RunRepositoryTests(t, func(t *testing.T) canvas.Repository {
+		return memory.NewStore()
+	})
+}
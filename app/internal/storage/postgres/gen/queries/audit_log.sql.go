@@ -0,0 +1,77 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit_log.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+const insertAuditEntry = `-- name: InsertAuditEntry :exec
+INSERT INTO audit_log (canvas_id, action, user_id, details, created_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertAuditEntryParams struct {
+	CanvasID  idgen.ID[idgen.Canvas]
+	Action    string
+	UserID    idgen.ID[idgen.User]
+	Details   string
+	CreatedAt time.Time
+}
+
+func (q *Queries) InsertAuditEntry(ctx context.Context, arg InsertAuditEntryParams) error {
+	_, err := q.db.Exec(ctx, insertAuditEntry,
+		arg.CanvasID,
+		arg.Action,
+		arg.UserID,
+		arg.Details,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const listAuditEntries = `-- name: ListAuditEntries :many
+SELECT id, canvas_id, action, user_id, details, created_at
+FROM audit_log
+WHERE canvas_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListAuditEntriesParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	MaxCount int32
+}
+
+func (q *Queries) ListAuditEntries(ctx context.Context, arg ListAuditEntriesParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditEntries, arg.CanvasID, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.CanvasID,
+			&i.Action,
+			&i.UserID,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: canvases.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const archiveCanvas = `-- name: ArchiveCanvas :one
+UPDATE canvases
+SET archived_at = coalesce(archived_at, $2)
+WHERE id = $1
+RETURNING id, width, height, palette, created_at, seq, archived_at, version, resized_seq, initial
+`
+
+type ArchiveCanvasParams struct {
+	ID         idgen.ID[idgen.Canvas]
+	ArchivedAt pgtype.Timestamptz
+}
+
+func (q *Queries) ArchiveCanvas(ctx context.Context, arg ArchiveCanvasParams) (Canvas, error) {
+	row := q.db.QueryRow(ctx, archiveCanvas, arg.ID, arg.ArchivedAt)
+	var i Canvas
+	err := row.Scan(
+		&i.ID,
+		&i.Width,
+		&i.Height,
+		&i.Palette,
+		&i.CreatedAt,
+		&i.Seq,
+		&i.ArchivedAt,
+		&i.Version,
+		&i.ResizedSeq,
+		&i.Initial,
+	)
+	return i, err
+}
+
+const createCanvas = `-- name: CreateCanvas :exec
+INSERT INTO canvases (id, width, height, palette)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateCanvasParams struct {
+	ID      idgen.ID[idgen.Canvas]
+	Width   int32
+	Height  int32
+	Palette []string
+}
+
+func (q *Queries) CreateCanvas(ctx context.Context, arg CreateCanvasParams) error {
+	_, err := q.db.Exec(ctx, createCanvas,
+		arg.ID,
+		arg.Width,
+		arg.Height,
+		arg.Palette,
+	)
+	return err
+}
+
+const createInitialCanvas = `-- name: CreateInitialCanvas :execrows
+INSERT INTO canvases (id, width, height, palette, initial)
+SELECT $1, $2, $3, $4, TRUE
+WHERE NOT EXISTS (SELECT 1 FROM canvases)
+ON CONFLICT DO NOTHING
+`
+
+type CreateInitialCanvasParams struct {
+	ID      idgen.ID[idgen.Canvas]
+	Width   int32
+	Height  int32
+	Palette []string
+}
+
+// The unique index on initial canvases stops concurrent inserts from both
+// creating an initial canvas.
+func (q *Queries) CreateInitialCanvas(ctx context.Context, arg CreateInitialCanvasParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createInitialCanvas,
+		arg.ID,
+		arg.Width,
+		arg.Height,
+		arg.Palette,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCanvas = `-- name: GetCanvas :one
+SELECT id, width, height, palette, created_at, seq, archived_at, version, resized_seq, initial
+FROM canvases
+WHERE id = $1
+`
+
+func (q *Queries) GetCanvas(ctx context.Context, id idgen.ID[idgen.Canvas]) (Canvas, error) {
+	row := q.db.QueryRow(ctx, getCanvas, id)
+	var i Canvas
+	err := row.Scan(
+		&i.ID,
+		&i.Width,
+		&i.Height,
+		&i.Palette,
+		&i.CreatedAt,
+		&i.Seq,
+		&i.ArchivedAt,
+		&i.Version,
+		&i.ResizedSeq,
+		&i.Initial,
+	)
+	return i, err
+}
+
+const getCanvasForUpdate = `-- name: GetCanvasForUpdate :one
+SELECT id, width, height, palette, created_at, seq, archived_at, version, resized_seq, initial
+FROM canvases
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCanvasForUpdate(ctx context.Context, id idgen.ID[idgen.Canvas]) (Canvas, error) {
+	row := q.db.QueryRow(ctx, getCanvasForUpdate, id)
+	var i Canvas
+	err := row.Scan(
+		&i.ID,
+		&i.Width,
+		&i.Height,
+		&i.Palette,
+		&i.CreatedAt,
+		&i.Seq,
+		&i.ArchivedAt,
+		&i.Version,
+		&i.ResizedSeq,
+		&i.Initial,
+	)
+	return i, err
+}
+
+const listCanvases = `-- name: ListCanvases :many
+SELECT id, width, height, palette, created_at, seq, archived_at, version, resized_seq, initial
+FROM canvases
+WHERE archived_at IS NULL OR $1::BOOLEAN
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListCanvases(ctx context.Context, includeArchived bool) ([]Canvas, error) {
+	rows, err := q.db.Query(ctx, listCanvases, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Canvas
+	for rows.Next() {
+		var i Canvas
+		if err := rows.Scan(
+			&i.ID,
+			&i.Width,
+			&i.Height,
+			&i.Palette,
+			&i.CreatedAt,
+			&i.Seq,
+			&i.ArchivedAt,
+			&i.Version,
+			&i.ResizedSeq,
+			&i.Initial,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resizeCanvas = `-- name: ResizeCanvas :one
+UPDATE canvases
+SET width = $2, height = $3, seq = seq + 1, version = version + 1, resized_seq = seq + 1
+WHERE id = $1
+RETURNING id, width, height, palette, created_at, seq, archived_at, version, resized_seq, initial
+`
+
+type ResizeCanvasParams struct {
+	ID     idgen.ID[idgen.Canvas]
+	Width  int32
+	Height int32
+}
+
+// The resize takes up the next sequence number of the canvas.
+func (q *Queries) ResizeCanvas(ctx context.Context, arg ResizeCanvasParams) (Canvas, error) {
+	row := q.db.QueryRow(ctx, resizeCanvas, arg.ID, arg.Width, arg.Height)
+	var i Canvas
+	err := row.Scan(
+		&i.ID,
+		&i.Width,
+		&i.Height,
+		&i.Palette,
+		&i.CreatedAt,
+		&i.Seq,
+		&i.ArchivedAt,
+		&i.Version,
+		&i.ResizedSeq,
+		&i.Initial,
+	)
+	return i, err
+}
+
+const setCanvasSeq = `-- name: SetCanvasSeq :exec
+UPDATE canvases
+SET seq = $2
+WHERE id = $1
+`
+
+type SetCanvasSeqParams struct {
+	ID  idgen.ID[idgen.Canvas]
+	Seq int64
+}
+
+func (q *Queries) SetCanvasSeq(ctx context.Context, arg SetCanvasSeqParams) error {
+	_, err := q.db.Exec(ctx, setCanvasSeq, arg.ID, arg.Seq)
+	return err
+}
@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: copyfrom.go
+
+package queries
+
+import (
+	"context"
+)
+
+// iteratorForInsertPlacements implements pgx.CopyFromSource.
+type iteratorForInsertPlacements struct {
+	rows                 []InsertPlacementsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertPlacements) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertPlacements) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].CanvasID,
+		r.rows[0].Seq,
+		r.rows[0].X,
+		r.rows[0].Y,
+		r.rows[0].Color,
+		r.rows[0].UserID,
+		r.rows[0].PlacedAt,
+		r.rows[0].Revert,
+	}, nil
+}
+
+func (r iteratorForInsertPlacements) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertPlacements(ctx context.Context, arg []InsertPlacementsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"placements"}, []string{"canvas_id", "seq", "x", "y", "color", "user_id", "placed_at", "revert"}, &iteratorForInsertPlacements{rows: arg})
+}
+
+// iteratorForInsertSnapshotChunks implements pgx.CopyFromSource.
+type iteratorForInsertSnapshotChunks struct {
+	rows                 []InsertSnapshotChunksParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertSnapshotChunks) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertSnapshotChunks) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].CanvasID,
+		r.rows[0].Seq,
+		r.rows[0].ChunkX,
+		r.rows[0].ChunkY,
+		r.rows[0].Version,
+		r.rows[0].Pixels,
+	}, nil
+}
+
+func (r iteratorForInsertSnapshotChunks) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertSnapshotChunks(ctx context.Context, arg []InsertSnapshotChunksParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"snapshot_chunks"}, []string{"canvas_id", "seq", "chunk_x", "chunk_y", "version", "pixels"}, &iteratorForInsertSnapshotChunks{rows: arg})
+}
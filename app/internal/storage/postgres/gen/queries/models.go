@@ -0,0 +1,73 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package queries
+
+import (
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AuditLog struct {
+	ID        int64
+	CanvasID  idgen.ID[idgen.Canvas]
+	Action    string
+	UserID    idgen.ID[idgen.User]
+	Details   string
+	CreatedAt time.Time
+}
+
+type Canvas struct {
+	ID         idgen.ID[idgen.Canvas]
+	Width      int32
+	Height     int32
+	Palette    []string
+	CreatedAt  time.Time
+	Seq        int64
+	ArchivedAt pgtype.Timestamptz
+	Version    int32
+	ResizedSeq int64
+	Initial    bool
+}
+
+type Placement struct {
+	ID       int64
+	CanvasID idgen.ID[idgen.Canvas]
+	X        int32
+	Y        int32
+	Color    int16
+	UserID   idgen.ID[idgen.User]
+	PlacedAt time.Time
+	Seq      int64
+	Revert   bool
+}
+
+type ProtectedRegion struct {
+	ID        idgen.ID[idgen.Region]
+	CanvasID  idgen.ID[idgen.Canvas]
+	X         int32
+	Y         int32
+	Width     int32
+	Height    int32
+	Reason    string
+	CreatedAt time.Time
+	ExpiresAt pgtype.Timestamptz
+}
+
+type Snapshot struct {
+	CanvasID  idgen.ID[idgen.Canvas]
+	Seq       int64
+	CreatedAt time.Time
+}
+
+type SnapshotChunk struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Seq      int64
+	ChunkX   int32
+	ChunkY   int32
+	Version  int64
+	Pixels   []byte
+}
@@ -0,0 +1,269 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: placements.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getLastPlacedAt = `-- name: GetLastPlacedAt :one
+SELECT placed_at
+FROM placements
+WHERE canvas_id = $1 AND user_id = $2 AND NOT revert
+ORDER BY placed_at DESC
+LIMIT 1
+`
+
+type GetLastPlacedAtParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	UserID   idgen.ID[idgen.User]
+}
+
+// Placements that revert a rollback are left out, so that rollbacks do not
+// put moderators on cooldown.
+func (q *Queries) GetLastPlacedAt(ctx context.Context, arg GetLastPlacedAtParams) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getLastPlacedAt, arg.CanvasID, arg.UserID)
+	var placed_at time.Time
+	err := row.Scan(&placed_at)
+	return placed_at, err
+}
+
+const insertPlacement = `-- name: InsertPlacement :one
+WITH canvas AS (
+  UPDATE canvases
+  SET seq = seq + 1
+  WHERE id = $1
+  RETURNING seq
+)
+INSERT INTO placements (canvas_id, seq, x, y, color, user_id, placed_at)
+SELECT $1, canvas.seq, $2, $3, $4, $5, $6
+FROM canvas
+RETURNING seq
+`
+
+type InsertPlacementParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	X        int32
+	Y        int32
+	Color    int16
+	UserID   idgen.ID[idgen.User]
+	PlacedAt time.Time
+}
+
+// Placements are numbered consecutively per canvas by incrementing the
+// sequence number of the canvas, whose row stays locked until the insert
+// commits.
+func (q *Queries) InsertPlacement(ctx context.Context, arg InsertPlacementParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertPlacement,
+		arg.CanvasID,
+		arg.X,
+		arg.Y,
+		arg.Color,
+		arg.UserID,
+		arg.PlacedAt,
+	)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+type InsertPlacementsParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Seq      int64
+	X        int32
+	Y        int32
+	Color    int16
+	UserID   idgen.ID[idgen.User]
+	PlacedAt time.Time
+	Revert   bool
+}
+
+const listPixelPlacements = `-- name: ListPixelPlacements :many
+SELECT id, canvas_id, x, y, color, user_id, placed_at, seq, revert
+FROM placements
+WHERE canvas_id = $1 AND x = $2 AND y = $3
+ORDER BY seq DESC
+LIMIT $4
+`
+
+type ListPixelPlacementsParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	X        int32
+	Y        int32
+	MaxCount int32
+}
+
+func (q *Queries) ListPixelPlacements(ctx context.Context, arg ListPixelPlacementsParams) ([]Placement, error) {
+	rows, err := q.db.Query(ctx, listPixelPlacements,
+		arg.CanvasID,
+		arg.X,
+		arg.Y,
+		arg.MaxCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Placement
+	for rows.Next() {
+		var i Placement
+		if err := rows.Scan(
+			&i.ID,
+			&i.CanvasID,
+			&i.X,
+			&i.Y,
+			&i.Color,
+			&i.UserID,
+			&i.PlacedAt,
+			&i.Seq,
+			&i.Revert,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlacements = `-- name: ListPlacements :many
+SELECT id, canvas_id, x, y, color, user_id, placed_at, seq, revert
+FROM placements
+WHERE canvas_id = $1 AND seq > $2
+ORDER BY seq
+LIMIT $3
+`
+
+type ListPlacementsParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	AfterSeq int64
+	MaxCount int32
+}
+
+func (q *Queries) ListPlacements(ctx context.Context, arg ListPlacementsParams) ([]Placement, error) {
+	rows, err := q.db.Query(ctx, listPlacements, arg.CanvasID, arg.AfterSeq, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Placement
+	for rows.Next() {
+		var i Placement
+		if err := rows.Scan(
+			&i.ID,
+			&i.CanvasID,
+			&i.X,
+			&i.Y,
+			&i.Color,
+			&i.UserID,
+			&i.PlacedAt,
+			&i.Seq,
+			&i.Revert,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRollbackHistory = `-- name: ListRollbackHistory :many
+SELECT history.id, history.canvas_id, history.x, history.y, history.color, history.user_id, history.placed_at, history.seq, history.revert
+FROM placements AS history
+WHERE history.canvas_id = $1 AND history.seq > $2 AND (history.x, history.y) IN (
+  SELECT selected.x, selected.y
+  FROM placements AS selected
+  WHERE selected.canvas_id = $1 AND selected.seq > $2
+    AND ($3::BOOLEAN OR selected.user_id = $4)
+    AND selected.x >= $5 AND selected.x < $6
+    AND selected.y >= $7 AND selected.y < $8
+    AND ($9::TIMESTAMPTZ IS NULL OR selected.placed_at >= $9)
+    AND ($10::TIMESTAMPTZ IS NULL OR selected.placed_at < $10)
+)
+ORDER BY history.seq
+`
+
+type ListRollbackHistoryParams struct {
+	CanvasID   idgen.ID[idgen.Canvas]
+	AfterSeq   int64
+	AnyUser    bool
+	UserID     idgen.ID[idgen.User]
+	MinX       int32
+	MaxX       int32
+	MinY       int32
+	MaxY       int32
+	PlacedFrom pgtype.Timestamptz
+	PlacedTo   pgtype.Timestamptz
+}
+
+// Lists every placement after a sequence number on the pixels with a placement
+// after it selected by a rollback.
+func (q *Queries) ListRollbackHistory(ctx context.Context, arg ListRollbackHistoryParams) ([]Placement, error) {
+	rows, err := q.db.Query(ctx, listRollbackHistory,
+		arg.CanvasID,
+		arg.AfterSeq,
+		arg.AnyUser,
+		arg.UserID,
+		arg.MinX,
+		arg.MaxX,
+		arg.MinY,
+		arg.MaxY,
+		arg.PlacedFrom,
+		arg.PlacedTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Placement
+	for rows.Next() {
+		var i Placement
+		if err := rows.Scan(
+			&i.ID,
+			&i.CanvasID,
+			&i.X,
+			&i.Y,
+			&i.Color,
+			&i.UserID,
+			&i.PlacedAt,
+			&i.Seq,
+			&i.Revert,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const shiftPlacements = `-- name: ShiftPlacements :exec
+UPDATE placements
+SET x = x + $2, y = y + $3
+WHERE canvas_id = $1
+`
+
+type ShiftPlacementsParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Dx       int32
+	Dy       int32
+}
+
+func (q *Queries) ShiftPlacements(ctx context.Context, arg ShiftPlacementsParams) error {
+	_, err := q.db.Exec(ctx, shiftPlacements, arg.CanvasID, arg.Dx, arg.Dy)
+	return err
+}
@@ -0,0 +1,185 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: protected_regions.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteProtectedRegion = `-- name: DeleteProtectedRegion :execrows
+DELETE FROM protected_regions
+WHERE canvas_id = $1 AND id = $2
+`
+
+type DeleteProtectedRegionParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	ID       idgen.ID[idgen.Region]
+}
+
+func (q *Queries) DeleteProtectedRegion(ctx context.Context, arg DeleteProtectedRegionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProtectedRegion, arg.CanvasID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertProtectedRegion = `-- name: InsertProtectedRegion :exec
+INSERT INTO protected_regions (id, canvas_id, x, y, width, height, reason, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type InsertProtectedRegionParams struct {
+	ID        idgen.ID[idgen.Region]
+	CanvasID  idgen.ID[idgen.Canvas]
+	X         int32
+	Y         int32
+	Width     int32
+	Height    int32
+	Reason    string
+	CreatedAt time.Time
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertProtectedRegion(ctx context.Context, arg InsertProtectedRegionParams) error {
+	_, err := q.db.Exec(ctx, insertProtectedRegion,
+		arg.ID,
+		arg.CanvasID,
+		arg.X,
+		arg.Y,
+		arg.Width,
+		arg.Height,
+		arg.Reason,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const isPixelProtected = `-- name: IsPixelProtected :one
+SELECT EXISTS (
+  SELECT 1
+  FROM protected_regions
+  WHERE canvas_id = $1
+    AND $2 >= x AND $2 < x + width
+    AND $3 >= y AND $3 < y + height
+    AND (expires_at IS NULL OR expires_at > $4::TIMESTAMPTZ)
+)
+`
+
+type IsPixelProtectedParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	X        int32
+	Y        int32
+	At       time.Time
+}
+
+func (q *Queries) IsPixelProtected(ctx context.Context, arg IsPixelProtectedParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isPixelProtected,
+		arg.CanvasID,
+		arg.X,
+		arg.Y,
+		arg.At,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listAllProtectedRegions = `-- name: ListAllProtectedRegions :many
+SELECT id, canvas_id, x, y, width, height, reason, created_at, expires_at
+FROM protected_regions
+WHERE expires_at IS NULL OR expires_at > now()
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListAllProtectedRegions(ctx context.Context) ([]ProtectedRegion, error) {
+	rows, err := q.db.Query(ctx, listAllProtectedRegions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProtectedRegion
+	for rows.Next() {
+		var i ProtectedRegion
+		if err := rows.Scan(
+			&i.ID,
+			&i.CanvasID,
+			&i.X,
+			&i.Y,
+			&i.Width,
+			&i.Height,
+			&i.Reason,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProtectedRegions = `-- name: ListProtectedRegions :many
+SELECT id, canvas_id, x, y, width, height, reason, created_at, expires_at
+FROM protected_regions
+WHERE canvas_id = $1 AND (expires_at IS NULL OR expires_at > now())
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListProtectedRegions(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) ([]ProtectedRegion, error) {
+	rows, err := q.db.Query(ctx, listProtectedRegions, canvasID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProtectedRegion
+	for rows.Next() {
+		var i ProtectedRegion
+		if err := rows.Scan(
+			&i.ID,
+			&i.CanvasID,
+			&i.X,
+			&i.Y,
+			&i.Width,
+			&i.Height,
+			&i.Reason,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const shiftProtectedRegions = `-- name: ShiftProtectedRegions :exec
+UPDATE protected_regions
+SET x = x + $2, y = y + $3
+WHERE canvas_id = $1
+`
+
+type ShiftProtectedRegionsParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Dx       int32
+	Dy       int32
+}
+
+func (q *Queries) ShiftProtectedRegions(ctx context.Context, arg ShiftProtectedRegionsParams) error {
+	_, err := q.db.Exec(ctx, shiftProtectedRegions, arg.CanvasID, arg.Dx, arg.Dy)
+	return err
+}
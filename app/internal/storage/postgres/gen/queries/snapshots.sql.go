@@ -0,0 +1,203 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: snapshots.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+const deleteSnapshotChunks = `-- name: DeleteSnapshotChunks :exec
+DELETE FROM snapshot_chunks AS deleted
+WHERE deleted.canvas_id = $1
+  AND deleted.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = deleted.canvas_id AND resized_seq > 0)
+`
+
+func (q *Queries) DeleteSnapshotChunks(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) error {
+	_, err := q.db.Exec(ctx, deleteSnapshotChunks, canvasID)
+	return err
+}
+
+const deleteSnapshots = `-- name: DeleteSnapshots :exec
+DELETE FROM snapshots AS deleted
+WHERE deleted.canvas_id = $1
+  AND deleted.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = deleted.canvas_id AND resized_seq > 0)
+`
+
+// Like PruneSnapshots, this keeps the snapshot of the latest resize.
+func (q *Queries) DeleteSnapshots(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) error {
+	_, err := q.db.Exec(ctx, deleteSnapshots, canvasID)
+	return err
+}
+
+const getLatestSnapshot = `-- name: GetLatestSnapshot :one
+SELECT canvas_id, seq, created_at
+FROM snapshots
+WHERE canvas_id = $1
+ORDER BY seq DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestSnapshot(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) (Snapshot, error) {
+	row := q.db.QueryRow(ctx, getLatestSnapshot, canvasID)
+	var i Snapshot
+	err := row.Scan(&i.CanvasID, &i.Seq, &i.CreatedAt)
+	return i, err
+}
+
+const getSnapshot = `-- name: GetSnapshot :one
+SELECT canvas_id, seq, created_at
+FROM snapshots
+WHERE canvas_id = $1 AND seq = $2
+`
+
+type GetSnapshotParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Seq      int64
+}
+
+func (q *Queries) GetSnapshot(ctx context.Context, arg GetSnapshotParams) (Snapshot, error) {
+	row := q.db.QueryRow(ctx, getSnapshot, arg.CanvasID, arg.Seq)
+	var i Snapshot
+	err := row.Scan(&i.CanvasID, &i.Seq, &i.CreatedAt)
+	return i, err
+}
+
+const getSnapshotCutoff = `-- name: GetSnapshotCutoff :one
+SELECT seq
+FROM snapshots
+WHERE canvas_id = $1
+ORDER BY seq DESC
+OFFSET $2
+LIMIT 1
+`
+
+type GetSnapshotCutoffParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Skip     int32
+}
+
+func (q *Queries) GetSnapshotCutoff(ctx context.Context, arg GetSnapshotCutoffParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getSnapshotCutoff, arg.CanvasID, arg.Skip)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+const insertSnapshot = `-- name: InsertSnapshot :execrows
+INSERT INTO snapshots (canvas_id, seq)
+VALUES ($1, $2)
+ON CONFLICT (canvas_id, seq) DO NOTHING
+`
+
+type InsertSnapshotParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Seq      int64
+}
+
+func (q *Queries) InsertSnapshot(ctx context.Context, arg InsertSnapshotParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertSnapshot, arg.CanvasID, arg.Seq)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+type InsertSnapshotChunksParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Seq      int64
+	ChunkX   int32
+	ChunkY   int32
+	Version  int64
+	Pixels   []byte
+}
+
+const listSnapshotChunks = `-- name: ListSnapshotChunks :many
+SELECT DISTINCT ON (chunk_x, chunk_y) canvas_id, seq, chunk_x, chunk_y, version, pixels
+FROM snapshot_chunks
+WHERE canvas_id = $1 AND seq <= $2
+ORDER BY chunk_x, chunk_y, seq DESC
+`
+
+type ListSnapshotChunksParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Seq      int64
+}
+
+// A snapshot only stores the chunks that changed since the previous one, so
+// its other chunks are the latest stored before it.
+func (q *Queries) ListSnapshotChunks(ctx context.Context, arg ListSnapshotChunksParams) ([]SnapshotChunk, error) {
+	rows, err := q.db.Query(ctx, listSnapshotChunks, arg.CanvasID, arg.Seq)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SnapshotChunk
+	for rows.Next() {
+		var i SnapshotChunk
+		if err := rows.Scan(
+			&i.CanvasID,
+			&i.Seq,
+			&i.ChunkX,
+			&i.ChunkY,
+			&i.Version,
+			&i.Pixels,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pruneSnapshotChunks = `-- name: PruneSnapshotChunks :exec
+DELETE FROM snapshot_chunks AS superseded
+WHERE superseded.canvas_id = $1 AND superseded.seq < $2
+  AND superseded.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = superseded.canvas_id AND resized_seq > 0)
+  AND EXISTS (
+  SELECT 1
+  FROM snapshot_chunks AS newer
+  WHERE newer.canvas_id = superseded.canvas_id
+    AND newer.chunk_x = superseded.chunk_x
+    AND newer.chunk_y = superseded.chunk_y
+    AND newer.seq > superseded.seq
+    AND newer.seq <= $2
+)
+`
+
+type PruneSnapshotChunksParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Cutoff   int64
+}
+
+// Chunks stored before the cutoff are kept if they are still the latest of
+// their chunk as of the cutoff, since the snapshot at the cutoff includes them.
+func (q *Queries) PruneSnapshotChunks(ctx context.Context, arg PruneSnapshotChunksParams) error {
+	_, err := q.db.Exec(ctx, pruneSnapshotChunks, arg.CanvasID, arg.Cutoff)
+	return err
+}
+
+const pruneSnapshots = `-- name: PruneSnapshots :exec
+DELETE FROM snapshots AS pruned
+WHERE pruned.canvas_id = $1 AND pruned.seq < $2
+  AND pruned.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = pruned.canvas_id AND resized_seq > 0)
+`
+
+type PruneSnapshotsParams struct {
+	CanvasID idgen.ID[idgen.Canvas]
+	Cutoff   int64
+}
+
+// The snapshot of the latest resize of the canvas is kept, since placements
+// before it cannot be replayed onto the resized canvas.
+func (q *Queries) PruneSnapshots(ctx context.Context, arg PruneSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, pruneSnapshots, arg.CanvasID, arg.Cutoff)
+	return err
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+//go:embed sql/migrations/*.sql
+var migrationsFS embed.FS
+
+type Migrator struct {
+	db       *sql.DB
+	provider *goose.Provider
+}
+
+func NewMigrator(pool *pgxpool.Pool) (*Migrator, error) {
+	migrations, err := fs.Sub(migrationsFS, "sql/migrations")
+	if err != nil {
+		return nil, fmt.Errorf("migrations fs: %w", err)
+	}
+
+	db := stdlib.OpenDBFromPool(pool)
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("init goose provider: %w", err)
+	}
+
+	return &Migrator{
+		db:       db,
+		provider: provider,
+	}, nil
+}
+
+func (m *Migrator) Close() error {
+	return m.db.Close() //nolint:wrapcheck
+}
+
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	return m.provider.Up(ctx) //nolint:wrapcheck
+}
+
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	return m.provider.Down(ctx) //nolint:wrapcheck
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx) //nolint:wrapcheck
+}
+
+func (m *Migrator) HasPending(ctx context.Context) (bool, error) {
+	return m.provider.HasPending(ctx) //nolint:wrapcheck
+}
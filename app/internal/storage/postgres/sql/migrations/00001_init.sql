@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE canvases (
+  id TEXT PRIMARY KEY,
+  width INTEGER NOT NULL CHECK (width > 0),
+  height INTEGER NOT NULL CHECK (height > 0),
+  palette TEXT[] NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE placements (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  canvas_id TEXT NOT NULL REFERENCES canvases (id),
+  x INTEGER NOT NULL,
+  y INTEGER NOT NULL,
+  color SMALLINT NOT NULL,
+  user_id TEXT NOT NULL,
+  placed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX placements_canvas_id_id_idx ON placements (canvas_id, id);
+
+-- +goose Down
+DROP TABLE placements;
+
+DROP TABLE canvases;
@@ -0,0 +1,30 @@
+-- +goose Up
+ALTER TABLE canvases ADD COLUMN seq BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE placements ADD COLUMN seq BIGINT;
+
+UPDATE placements
+SET seq = numbered.seq
+FROM (
+  SELECT id, row_number() OVER (PARTITION BY canvas_id ORDER BY id) AS seq
+  FROM placements
+) AS numbered
+WHERE placements.id = numbered.id;
+
+UPDATE canvases
+SET seq = coalesce((SELECT max(seq) FROM placements WHERE canvas_id = canvases.id), 0);
+
+ALTER TABLE placements ALTER COLUMN seq SET NOT NULL;
+
+CREATE UNIQUE INDEX placements_canvas_id_seq_idx ON placements (canvas_id, seq);
+
+DROP INDEX placements_canvas_id_id_idx;
+
+-- +goose Down
+CREATE INDEX placements_canvas_id_id_idx ON placements (canvas_id, id);
+
+DROP INDEX placements_canvas_id_seq_idx;
+
+ALTER TABLE placements DROP COLUMN seq;
+
+ALTER TABLE canvases DROP COLUMN seq;
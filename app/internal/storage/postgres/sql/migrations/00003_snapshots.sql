@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE snapshots (
+  canvas_id TEXT NOT NULL REFERENCES canvases (id),
+  seq BIGINT NOT NULL,
+  pixels BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (canvas_id, seq)
+);
+
+-- +goose Down
+DROP TABLE snapshots;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX placements_canvas_id_x_y_seq_idx ON placements (canvas_id, x, y, seq DESC);
+
+-- +goose Down
+DROP INDEX placements_canvas_id_x_y_seq_idx;
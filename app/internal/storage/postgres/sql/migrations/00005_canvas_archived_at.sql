@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE canvases ADD COLUMN archived_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE canvases DROP COLUMN archived_at;
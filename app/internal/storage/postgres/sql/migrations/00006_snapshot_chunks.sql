@@ -0,0 +1,23 @@
+-- +goose Up
+-- Snapshots can always be taken again from placements, so existing ones are
+-- discarded rather than split into chunks.
+DELETE FROM snapshots;
+
+ALTER TABLE snapshots DROP COLUMN pixels;
+
+CREATE TABLE snapshot_chunks (
+  canvas_id TEXT NOT NULL REFERENCES canvases (id),
+  seq BIGINT NOT NULL,
+  chunk_x INTEGER NOT NULL,
+  chunk_y INTEGER NOT NULL,
+  version BIGINT NOT NULL,
+  pixels BYTEA NOT NULL,
+  PRIMARY KEY (canvas_id, chunk_x, chunk_y, seq)
+);
+
+-- +goose Down
+DROP TABLE snapshot_chunks;
+
+DELETE FROM snapshots;
+
+ALTER TABLE snapshots ADD COLUMN pixels BYTEA NOT NULL;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE canvases ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE canvases ADD COLUMN resized_seq BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE canvases DROP COLUMN resized_seq;
+
+ALTER TABLE canvases DROP COLUMN version;
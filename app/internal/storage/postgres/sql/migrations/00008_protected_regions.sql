@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE protected_regions (
+  id TEXT PRIMARY KEY,
+  canvas_id TEXT NOT NULL REFERENCES canvases (id),
+  x INTEGER NOT NULL,
+  y INTEGER NOT NULL,
+  width INTEGER NOT NULL CHECK (width > 0),
+  height INTEGER NOT NULL CHECK (height > 0),
+  reason TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX protected_regions_canvas_id_idx ON protected_regions (canvas_id);
+
+-- +goose Down
+DROP TABLE protected_regions;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE audit_log (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  canvas_id TEXT NOT NULL REFERENCES canvases (id),
+  action TEXT NOT NULL,
+  user_id TEXT NOT NULL,
+  details TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX audit_log_canvas_id_id_idx ON audit_log (canvas_id, id);
+
+-- +goose Down
+DROP TABLE audit_log;
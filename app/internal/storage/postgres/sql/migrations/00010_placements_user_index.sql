@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX placements_canvas_id_user_id_placed_at_idx ON placements (canvas_id, user_id, placed_at DESC);
+
+-- +goose Down
+DROP INDEX placements_canvas_id_user_id_placed_at_idx;
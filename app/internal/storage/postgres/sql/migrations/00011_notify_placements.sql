@@ -0,0 +1,30 @@
+-- +goose Up
+-- Placements are notified from the transaction inserting them, so that they
+-- are delivered to listeners in the order their transactions commit, which is
+-- the order of their sequence numbers on each canvas. IDs are notified as they
+-- are stored, without the prefixes that listeners add when decoding them.
+-- +goose StatementBegin
+CREATE FUNCTION notify_placement() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_notify('pikcel_placements', json_build_object(
+    'canvas_id', NEW.canvas_id,
+    'x', NEW.x,
+    'y', NEW.y,
+    'color', NEW.color,
+    'user_id', NEW.user_id,
+    'placed_at', NEW.placed_at,
+    'seq', NEW.seq
+  )::TEXT);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER placements_notify
+AFTER INSERT ON placements
+FOR EACH ROW EXECUTE FUNCTION notify_placement();
+
+-- +goose Down
+DROP TRIGGER placements_notify ON placements;
+
+DROP FUNCTION notify_placement();
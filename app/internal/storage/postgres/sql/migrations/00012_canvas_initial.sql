@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE canvases ADD COLUMN initial BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE canvases
+SET initial = TRUE
+WHERE id = (SELECT id FROM canvases ORDER BY created_at, id LIMIT 1);
+
+CREATE UNIQUE INDEX canvases_initial_idx ON canvases (initial) WHERE initial;
+
+-- +goose Down
+DROP INDEX canvases_initial_idx;
+
+ALTER TABLE canvases DROP COLUMN initial;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE placements ADD COLUMN revert BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE placements DROP COLUMN revert;
@@ -0,0 +1,10 @@
+-- name: InsertAuditEntry :exec
+INSERT INTO audit_log (canvas_id, action, user_id, details, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListAuditEntries :many
+SELECT *
+FROM audit_log
+WHERE canvas_id = $1
+ORDER BY id DESC
+LIMIT sqlc.arg(max_count);
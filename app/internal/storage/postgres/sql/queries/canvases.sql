@@ -0,0 +1,46 @@
+-- name: CreateCanvas :exec
+INSERT INTO canvases (id, width, height, palette)
+VALUES ($1, $2, $3, $4);
+
+-- name: CreateInitialCanvas :execrows
+-- The unique index on initial canvases stops concurrent inserts from both
+-- creating an initial canvas.
+INSERT INTO canvases (id, width, height, palette, initial)
+SELECT $1, $2, $3, $4, TRUE
+WHERE NOT EXISTS (SELECT 1 FROM canvases)
+ON CONFLICT DO NOTHING;
+
+-- name: GetCanvas :one
+SELECT *
+FROM canvases
+WHERE id = $1;
+
+-- name: ListCanvases :many
+SELECT *
+FROM canvases
+WHERE archived_at IS NULL OR sqlc.arg(include_archived)::BOOLEAN
+ORDER BY created_at, id;
+
+-- name: ArchiveCanvas :one
+UPDATE canvases
+SET archived_at = coalesce(archived_at, sqlc.arg(archived_at))
+WHERE id = $1
+RETURNING *;
+
+-- name: GetCanvasForUpdate :one
+SELECT *
+FROM canvases
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ResizeCanvas :one
+-- The resize takes up the next sequence number of the canvas.
+UPDATE canvases
+SET width = $2, height = $3, seq = seq + 1, version = version + 1, resized_seq = seq + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: SetCanvasSeq :exec
+UPDATE canvases
+SET seq = $2
+WHERE id = $1;
@@ -0,0 +1,63 @@
+-- name: InsertPlacement :one
+-- Placements are numbered consecutively per canvas by incrementing the
+-- sequence number of the canvas, whose row stays locked until the insert
+-- commits.
+WITH canvas AS (
+  UPDATE canvases
+  SET seq = seq + 1
+  WHERE id = sqlc.arg(canvas_id)
+  RETURNING seq
+)
+INSERT INTO placements (canvas_id, seq, x, y, color, user_id, placed_at)
+SELECT sqlc.arg(canvas_id), canvas.seq, sqlc.arg(x), sqlc.arg(y), sqlc.arg(color), sqlc.arg(user_id), sqlc.arg(placed_at)
+FROM canvas
+RETURNING seq;
+
+-- name: GetLastPlacedAt :one
+-- Placements that revert a rollback are left out, so that rollbacks do not
+-- put moderators on cooldown.
+SELECT placed_at
+FROM placements
+WHERE canvas_id = $1 AND user_id = $2 AND NOT revert
+ORDER BY placed_at DESC
+LIMIT 1;
+
+-- name: ListPlacements :many
+SELECT *
+FROM placements
+WHERE canvas_id = $1 AND seq > sqlc.arg(after_seq)
+ORDER BY seq
+LIMIT sqlc.arg(max_count);
+
+-- name: ListPixelPlacements :many
+SELECT *
+FROM placements
+WHERE canvas_id = $1 AND x = $2 AND y = $3
+ORDER BY seq DESC
+LIMIT sqlc.arg(max_count);
+
+-- name: ShiftPlacements :exec
+UPDATE placements
+SET x = x + sqlc.arg(dx), y = y + sqlc.arg(dy)
+WHERE canvas_id = $1;
+
+-- name: ListRollbackHistory :many
+-- Lists every placement after a sequence number on the pixels with a placement
+-- after it selected by a rollback.
+SELECT history.*
+FROM placements AS history
+WHERE history.canvas_id = sqlc.arg(canvas_id) AND history.seq > sqlc.arg(after_seq) AND (history.x, history.y) IN (
+  SELECT selected.x, selected.y
+  FROM placements AS selected
+  WHERE selected.canvas_id = sqlc.arg(canvas_id) AND selected.seq > sqlc.arg(after_seq)
+    AND (sqlc.arg(any_user)::BOOLEAN OR selected.user_id = sqlc.arg(user_id))
+    AND selected.x >= sqlc.arg(min_x) AND selected.x < sqlc.arg(max_x)
+    AND selected.y >= sqlc.arg(min_y) AND selected.y < sqlc.arg(max_y)
+    AND (sqlc.narg(placed_from)::TIMESTAMPTZ IS NULL OR selected.placed_at >= sqlc.narg(placed_from))
+    AND (sqlc.narg(placed_to)::TIMESTAMPTZ IS NULL OR selected.placed_at < sqlc.narg(placed_to))
+)
+ORDER BY history.seq;
+
+-- name: InsertPlacements :copyfrom
+INSERT INTO placements (canvas_id, seq, x, y, color, user_id, placed_at, revert)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
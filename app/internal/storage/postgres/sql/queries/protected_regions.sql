@@ -0,0 +1,34 @@
+-- name: InsertProtectedRegion :exec
+INSERT INTO protected_regions (id, canvas_id, x, y, width, height, reason, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: DeleteProtectedRegion :execrows
+DELETE FROM protected_regions
+WHERE canvas_id = $1 AND id = $2;
+
+-- name: ListProtectedRegions :many
+SELECT *
+FROM protected_regions
+WHERE canvas_id = $1 AND (expires_at IS NULL OR expires_at > now())
+ORDER BY created_at, id;
+
+-- name: ListAllProtectedRegions :many
+SELECT *
+FROM protected_regions
+WHERE expires_at IS NULL OR expires_at > now()
+ORDER BY created_at, id;
+
+-- name: IsPixelProtected :one
+SELECT EXISTS (
+  SELECT 1
+  FROM protected_regions
+  WHERE canvas_id = sqlc.arg(canvas_id)
+    AND sqlc.arg(x) >= x AND sqlc.arg(x) < x + width
+    AND sqlc.arg(y) >= y AND sqlc.arg(y) < y + height
+    AND (expires_at IS NULL OR expires_at > sqlc.arg(at)::TIMESTAMPTZ)
+);
+
+-- name: ShiftProtectedRegions :exec
+UPDATE protected_regions
+SET x = x + sqlc.arg(dx), y = y + sqlc.arg(dy)
+WHERE canvas_id = $1;
@@ -0,0 +1,70 @@
+-- name: InsertSnapshot :execrows
+INSERT INTO snapshots (canvas_id, seq)
+VALUES ($1, $2)
+ON CONFLICT (canvas_id, seq) DO NOTHING;
+
+-- name: InsertSnapshotChunks :copyfrom
+INSERT INTO snapshot_chunks (canvas_id, seq, chunk_x, chunk_y, version, pixels)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetLatestSnapshot :one
+SELECT *
+FROM snapshots
+WHERE canvas_id = $1
+ORDER BY seq DESC
+LIMIT 1;
+
+-- name: GetSnapshot :one
+SELECT *
+FROM snapshots
+WHERE canvas_id = $1 AND seq = $2;
+
+-- name: ListSnapshotChunks :many
+-- A snapshot only stores the chunks that changed since the previous one, so
+-- its other chunks are the latest stored before it.
+SELECT DISTINCT ON (chunk_x, chunk_y) *
+FROM snapshot_chunks
+WHERE canvas_id = $1 AND seq <= $2
+ORDER BY chunk_x, chunk_y, seq DESC;
+
+-- name: GetSnapshotCutoff :one
+SELECT seq
+FROM snapshots
+WHERE canvas_id = $1
+ORDER BY seq DESC
+OFFSET sqlc.arg(skip)
+LIMIT 1;
+
+-- name: PruneSnapshots :exec
+-- The snapshot of the latest resize of the canvas is kept, since placements
+-- before it cannot be replayed onto the resized canvas.
+DELETE FROM snapshots AS pruned
+WHERE pruned.canvas_id = $1 AND pruned.seq < sqlc.arg(cutoff)
+  AND pruned.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = pruned.canvas_id AND resized_seq > 0);
+
+-- name: PruneSnapshotChunks :exec
+-- Chunks stored before the cutoff are kept if they are still the latest of
+-- their chunk as of the cutoff, since the snapshot at the cutoff includes them.
+DELETE FROM snapshot_chunks AS superseded
+WHERE superseded.canvas_id = sqlc.arg(canvas_id) AND superseded.seq < sqlc.arg(cutoff)
+  AND superseded.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = superseded.canvas_id AND resized_seq > 0)
+  AND EXISTS (
+  SELECT 1
+  FROM snapshot_chunks AS newer
+  WHERE newer.canvas_id = superseded.canvas_id
+    AND newer.chunk_x = superseded.chunk_x
+    AND newer.chunk_y = superseded.chunk_y
+    AND newer.seq > superseded.seq
+    AND newer.seq <= sqlc.arg(cutoff)
+);
+
+-- name: DeleteSnapshots :exec
+-- Like PruneSnapshots, this keeps the snapshot of the latest resize.
+DELETE FROM snapshots AS deleted
+WHERE deleted.canvas_id = $1
+  AND deleted.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = deleted.canvas_id AND resized_seq > 0);
+
+-- name: DeleteSnapshotChunks :exec
+DELETE FROM snapshot_chunks AS deleted
+WHERE deleted.canvas_id = $1
+  AND deleted.seq IS DISTINCT FROM (SELECT resized_seq FROM canvases WHERE id = deleted.canvas_id AND resized_seq > 0);
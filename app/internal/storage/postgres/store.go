@@ -0,0 +1,792 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"math"
+	"time"
+
+	"github.com/alexliesenfeld/health"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/cooldown"
+	"github.com/jace-ys/pikcel/internal/healthz"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/storage/postgres/gen/queries"
+)
+
+const replayBatchSize = 10000
+
+var _ canvas.Repository = (*Store)(nil)
+
+type Store struct {
+	pool    *pgxpool.Pool
+	queries *queries.Queries
+}
+
+func NewStore(ctx context.Context, dsn string) (*Store, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("create connection pool: %w", err)
+	}
+
+	return &Store{
+		pool:    pool,
+		queries: queries.New(pool),
+	}, nil
+}
+
+func (s *Store) Close() {
+	s.pool.Close()
+}
+
+func (s *Store) Migrator() (*Migrator, error) {
+	return NewMigrator(s.pool)
+}
+
+func (s *Store) CreateCanvas(ctx context.Context, cnv *canvas.Canvas) error {
+	err := s.queries.CreateCanvas(ctx, queries.CreateCanvasParams{
+		ID:      cnv.ID(),
+		Width:   int32(cnv.Width()),  //nolint:gosec
+		Height:  int32(cnv.Height()), //nolint:gosec
+		Palette: cnv.Palette().Hex(),
+	})
+	if err != nil {
+		if isViolation(err, pgerrcode.UniqueViolation) {
+			return canvas.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert canvas: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) CreateInitialCanvas(ctx context.Context, cnv *canvas.Canvas) (bool, error) {
+	n, err := s.queries.CreateInitialCanvas(ctx, queries.CreateInitialCanvasParams{
+		ID:      cnv.ID(),
+		Width:   int32(cnv.Width()),  //nolint:gosec
+		Height:  int32(cnv.Height()), //nolint:gosec
+		Palette: cnv.Palette().Hex(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("insert canvas: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (s *Store) GetCanvas(ctx context.Context, id idgen.ID[idgen.Canvas]) (*canvas.Canvas, error) {
+	row, err := s.queries.GetCanvas(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, canvas.ErrNotFound
+		}
+		return nil, fmt.Errorf("query canvas: %w", err)
+	}
+
+	return s.load(ctx, row)
+}
+
+func (s *Store) GetCanvasInfo(ctx context.Context, id idgen.ID[idgen.Canvas]) (canvas.Info, error) {
+	row, err := s.queries.GetCanvas(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return canvas.Info{}, canvas.ErrNotFound
+		}
+		return canvas.Info{}, fmt.Errorf("query canvas: %w", err)
+	}
+
+	return s.info(ctx, s.queries, row)
+}
+
+func (s *Store) ListCanvases(ctx context.Context, includeArchived bool) ([]canvas.Info, error) {
+	rows, err := s.queries.ListCanvases(ctx, includeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("list canvases: %w", err)
+	}
+
+	regionRows, err := s.queries.ListAllProtectedRegions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list protected regions: %w", err)
+	}
+
+	regions := make(map[idgen.ID[idgen.Canvas]][]canvas.ProtectedRegion)
+	for _, row := range regionRows {
+		regions[row.CanvasID] = append(regions[row.CanvasID], toProtectedRegion(row))
+	}
+
+	infos := make([]canvas.Info, len(rows))
+	for i, row := range rows {
+		infos[i], err = toInfo(row)
+		if err != nil {
+			return nil, err
+		}
+		infos[i].ProtectedRegions = regions[row.ID]
+	}
+	return infos, nil
+}
+
+func (s *Store) ArchiveCanvas(ctx context.Context, id idgen.ID[idgen.Canvas], at time.Time) (canvas.Info, error) {
+	row, err := s.queries.ArchiveCanvas(ctx, queries.ArchiveCanvasParams{
+		ID:         id,
+		ArchivedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return canvas.Info{}, canvas.ErrNotFound
+		}
+		return canvas.Info{}, fmt.Errorf("archive canvas: %w", err)
+	}
+
+	return s.info(ctx, s.queries, row)
+}
+
+func (s *Store) ResizeCanvas(ctx context.Context, id idgen.ID[idgen.Canvas], r canvas.Resize) (canvas.Info, error) {
+	var info canvas.Info
+	err := s.withTx(ctx, func(q *queries.Queries) error {
+		row, err := q.GetCanvasForUpdate(ctx, id)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return canvas.ErrNotFound
+		case err != nil:
+			return fmt.Errorf("query canvas: %w", err)
+		case row.ArchivedAt.Valid:
+			return canvas.ErrArchived
+		}
+
+		// No placements can be inserted while the canvas is locked, so it is
+		// safe to load it outside the transaction.
+		cnv, err := s.load(ctx, row)
+		if err != nil {
+			return err
+		}
+
+		resized, err := cnv.Resize(r, row.Seq+1)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		row, err = q.ResizeCanvas(ctx, queries.ResizeCanvasParams{
+			ID:     id,
+			Width:  int32(resized.Width()),  //nolint:gosec
+			Height: int32(resized.Height()), //nolint:gosec
+		})
+		if err != nil {
+			return fmt.Errorf("update canvas: %w", err)
+		}
+
+		err = q.ShiftPlacements(ctx, queries.ShiftPlacementsParams{
+			CanvasID: id,
+			Dx:       int32(r.Left), //nolint:gosec
+			Dy:       int32(r.Top),  //nolint:gosec
+		})
+		if err != nil {
+			return fmt.Errorf("shift placements: %w", err)
+		}
+
+		err = q.ShiftProtectedRegions(ctx, queries.ShiftProtectedRegionsParams{
+			CanvasID: id,
+			Dx:       int32(r.Left), //nolint:gosec
+			Dy:       int32(r.Top),  //nolint:gosec
+		})
+		if err != nil {
+			return fmt.Errorf("shift protected regions: %w", err)
+		}
+
+		// Snapshots from before the resize no longer line up with the chunks of
+		// the canvas, so they are replaced by one of the resized canvas. The
+		// canvas has already been updated, so the snapshot of the previous
+		// resize is deleted along with the rest.
+		if err := q.DeleteSnapshotChunks(ctx, id); err != nil {
+			return fmt.Errorf("delete snapshot chunks: %w", err)
+		}
+		if err := q.DeleteSnapshots(ctx, id); err != nil {
+			return fmt.Errorf("delete snapshots: %w", err)
+		}
+		if err := insertSnapshot(ctx, q, resized.Snapshot()); err != nil {
+			return fmt.Errorf("insert snapshot: %w", err)
+		}
+
+		info, err = s.info(ctx, q, row)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, canvas.ErrNotFound) || errors.Is(err, canvas.ErrArchived) {
+			return canvas.Info{}, err
+		}
+		return canvas.Info{}, fmt.Errorf("resize canvas: %w", err)
+	}
+	return info, nil
+}
+
+func (s *Store) ProtectRegion(ctx context.Context, canvasID idgen.ID[idgen.Canvas], r canvas.ProtectedRegion) error {
+	err := s.queries.InsertProtectedRegion(ctx, queries.InsertProtectedRegionParams{
+		ID:        r.ID,
+		CanvasID:  canvasID,
+		X:         int32(r.Bounds.Min.X), //nolint:gosec
+		Y:         int32(r.Bounds.Min.Y), //nolint:gosec
+		Width:     int32(r.Bounds.Dx()),  //nolint:gosec
+		Height:    int32(r.Bounds.Dy()),  //nolint:gosec
+		Reason:    r.Reason,
+		CreatedAt: r.CreatedAt,
+		ExpiresAt: pgtype.Timestamptz{Time: r.ExpiresAt, Valid: !r.ExpiresAt.IsZero()},
+	})
+	if err != nil {
+		if isViolation(err, pgerrcode.ForeignKeyViolation) {
+			return canvas.ErrNotFound
+		}
+		return fmt.Errorf("insert protected region: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) UnprotectRegion(ctx context.Context, canvasID idgen.ID[idgen.Canvas], regionID idgen.ID[idgen.Region]) error {
+	deleted, err := s.queries.DeleteProtectedRegion(ctx, queries.DeleteProtectedRegionParams{
+		CanvasID: canvasID,
+		ID:       regionID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete protected region: %w", err)
+	}
+	if deleted > 0 {
+		return nil
+	}
+
+	if _, err := s.queries.GetCanvas(ctx, canvasID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return canvas.ErrNotFound
+		}
+		return fmt.Errorf("query canvas: %w", err)
+	}
+	return canvas.ErrRegionNotFound
+}
+
+// info returns the info of a canvas along with its protected regions.
+func (s *Store) info(ctx context.Context, q *queries.Queries, row queries.Canvas) (canvas.Info, error) {
+	info, err := toInfo(row)
+	if err != nil {
+		return canvas.Info{}, err
+	}
+
+	rows, err := q.ListProtectedRegions(ctx, row.ID)
+	if err != nil {
+		return canvas.Info{}, fmt.Errorf("list protected regions: %w", err)
+	}
+
+	info.ProtectedRegions = make([]canvas.ProtectedRegion, len(rows))
+	for i, row := range rows {
+		info.ProtectedRegions[i] = toProtectedRegion(row)
+	}
+	return info, nil
+}
+
+// load builds a canvas from its latest snapshot and the placements after it.
+func (s *Store) load(ctx context.Context, row queries.Canvas) (*canvas.Canvas, error) {
+	info, err := toInfo(row)
+	if err != nil {
+		return nil, err
+	}
+
+	cnv, err := canvas.FromInfo(info)
+	if err != nil {
+		return nil, fmt.Errorf("init canvas: %w", err)
+	}
+
+	snapshot, err := s.GetLatestSnapshot(ctx, cnv.ID())
+	switch {
+	case err == nil:
+		if _, err := cnv.Restore(snapshot); err != nil {
+			return nil, fmt.Errorf("restore snapshot: %w", err)
+		}
+	case !errors.Is(err, canvas.ErrSnapshotNotFound):
+		return nil, err
+	}
+
+	if err := s.replay(ctx, cnv); err != nil {
+		return nil, fmt.Errorf("replay placements: %w", err)
+	}
+
+	return cnv, nil
+}
+
+func (s *Store) replay(ctx context.Context, cnv *canvas.Canvas) error {
+	for {
+		placements, err := s.ListPlacements(ctx, cnv.ID(), cnv.Seq(), replayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range placements {
+			if _, err := cnv.Apply(p); err != nil {
+				return fmt.Errorf("apply placement %d: %w", p.Seq, err)
+			}
+		}
+
+		if len(placements) < replayBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Store) InsertPlacement(ctx context.Context, p canvas.Placement, cooldownPeriod time.Duration) (int64, error) {
+	var seq int64
+	err := s.withTx(ctx, func(q *queries.Queries) error {
+		// Locking the canvas serializes placements on it, so that the checks
+		// below see every placement committed before this one, including those
+		// made on other instances.
+		row, err := q.GetCanvasForUpdate(ctx, p.CanvasID)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return canvas.ErrNotFound
+		case err != nil:
+			return fmt.Errorf("query canvas: %w", err)
+		case row.ArchivedAt.Valid:
+			return canvas.ErrArchived
+		}
+
+		protected, err := q.IsPixelProtected(ctx, queries.IsPixelProtectedParams{
+			CanvasID: p.CanvasID,
+			X:        int32(p.X), //nolint:gosec
+			Y:        int32(p.Y), //nolint:gosec
+			At:       p.PlacedAt,
+		})
+		switch {
+		case err != nil:
+			return fmt.Errorf("query protected regions: %w", err)
+		case protected:
+			return canvas.ErrProtected
+		}
+
+		last, err := q.GetLastPlacedAt(ctx, queries.GetLastPlacedAtParams{
+			CanvasID: p.CanvasID,
+			UserID:   p.UserID,
+		})
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return fmt.Errorf("query last placement: %w", err)
+		default:
+			if remaining := last.Add(cooldownPeriod).Sub(p.PlacedAt); remaining > 0 {
+				return &cooldown.ActiveError{Remaining: remaining}
+			}
+		}
+
+		seq, err = q.InsertPlacement(ctx, queries.InsertPlacementParams{
+			CanvasID: p.CanvasID,
+			X:        int32(p.X), //nolint:gosec
+			Y:        int32(p.Y), //nolint:gosec
+			Color:    int16(p.Color),
+			UserID:   p.UserID,
+			PlacedAt: p.PlacedAt,
+		})
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		var cerr *cooldown.ActiveError
+		if errors.Is(err, canvas.ErrNotFound) || errors.Is(err, canvas.ErrArchived) ||
+			errors.Is(err, canvas.ErrProtected) || errors.As(err, &cerr) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("insert placement: %w", err)
+	}
+	return seq, nil
+}
+
+func (s *Store) ListPlacements(ctx context.Context, canvasID idgen.ID[idgen.Canvas], afterSeq int64, limit int) ([]canvas.Placement, error) {
+	rows, err := s.queries.ListPlacements(ctx, queries.ListPlacementsParams{
+		CanvasID: canvasID,
+		AfterSeq: afterSeq,
+		MaxCount: int32(min(limit, math.MaxInt32)), //nolint:gosec
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list placements: %w", err)
+	}
+
+	placements := make([]canvas.Placement, len(rows))
+	for i, row := range rows {
+		placements[i] = toPlacement(row)
+	}
+	return placements, nil
+}
+
+func (s *Store) ListPixelPlacements(ctx context.Context, canvasID idgen.ID[idgen.Canvas], x, y, limit int) ([]canvas.Placement, error) {
+	rows, err := s.queries.ListPixelPlacements(ctx, queries.ListPixelPlacementsParams{
+		CanvasID: canvasID,
+		X:        int32(x),                         //nolint:gosec
+		Y:        int32(y),                         //nolint:gosec
+		MaxCount: int32(min(limit, math.MaxInt32)), //nolint:gosec
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pixel placements: %w", err)
+	}
+
+	placements := make([]canvas.Placement, len(rows))
+	for i, row := range rows {
+		placements[i] = toPlacement(row)
+	}
+	return placements, nil
+}
+
+func (s *Store) RollbackPlacements(ctx context.Context, canvasID idgen.ID[idgen.Canvas], r canvas.Rollback, by idgen.ID[idgen.User], at time.Time) ([]canvas.Placement, error) {
+	var reverts []canvas.Placement
+	err := s.withTx(ctx, func(q *queries.Queries) error {
+		// Locking the canvas keeps placements from being inserted while the
+		// rollback is worked out, so that none are reverted to stale colors.
+		row, err := q.GetCanvasForUpdate(ctx, canvasID)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return canvas.ErrNotFound
+		case err != nil:
+			return fmt.Errorf("query canvas: %w", err)
+		case row.ArchivedAt.Valid:
+			return canvas.ErrArchived
+		}
+
+		bounds := image.Rect(0, 0, int(row.Width), int(row.Height))
+		if !r.Bounds.Empty() {
+			bounds = bounds.Intersect(r.Bounds)
+		}
+
+		base, err := resizeBase(ctx, q, row)
+		if err != nil {
+			return err
+		}
+
+		rows, err := q.ListRollbackHistory(ctx, queries.ListRollbackHistoryParams{
+			CanvasID:   canvasID,
+			AfterSeq:   row.ResizedSeq,
+			AnyUser:    r.UserID == (idgen.ID[idgen.User]{}),
+			UserID:     r.UserID,
+			MinX:       int32(bounds.Min.X), //nolint:gosec
+			MaxX:       int32(bounds.Max.X), //nolint:gosec
+			MinY:       int32(bounds.Min.Y), //nolint:gosec
+			MaxY:       int32(bounds.Max.Y), //nolint:gosec
+			PlacedFrom: pgtype.Timestamptz{Time: r.From, Valid: !r.From.IsZero()},
+			PlacedTo:   pgtype.Timestamptz{Time: r.To, Valid: !r.To.IsZero()},
+		})
+		if err != nil {
+			return fmt.Errorf("list placement history: %w", err)
+		}
+
+		history := make([]canvas.Placement, len(rows))
+		for i, row := range rows {
+			history[i] = toPlacement(row)
+		}
+
+		reverts = r.Reverts(base, history)
+		params := make([]queries.InsertPlacementsParams, len(reverts))
+		for i := range reverts {
+			p := &reverts[i]
+			p.CanvasID = canvasID
+			p.UserID = by
+			p.PlacedAt = at
+			p.Seq = row.Seq + int64(i) + 1
+
+			params[i] = queries.InsertPlacementsParams{
+				CanvasID: p.CanvasID,
+				Seq:      p.Seq,
+				X:        int32(p.X), //nolint:gosec
+				Y:        int32(p.Y), //nolint:gosec
+				Color:    int16(p.Color),
+				UserID:   p.UserID,
+				PlacedAt: p.PlacedAt,
+				Revert:   true,
+			}
+		}
+
+		if _, err := q.InsertPlacements(ctx, params); err != nil {
+			return fmt.Errorf("insert placements: %w", err)
+		}
+
+		err = q.SetCanvasSeq(ctx, queries.SetCanvasSeqParams{
+			ID:  canvasID,
+			Seq: row.Seq + int64(len(reverts)),
+		})
+		if err != nil {
+			return fmt.Errorf("update canvas: %w", err)
+		}
+
+		entry := r.AuditEntry(canvasID, by, at, len(reverts))
+		err = q.InsertAuditEntry(ctx, queries.InsertAuditEntryParams{
+			CanvasID:  entry.CanvasID,
+			Action:    entry.Action,
+			UserID:    entry.UserID,
+			Details:   entry.Details,
+			CreatedAt: entry.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("insert audit entry: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, canvas.ErrNotFound) || errors.Is(err, canvas.ErrArchived) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("rollback placements: %w", err)
+	}
+	return reverts, nil
+}
+
+func (s *Store) ListAuditEntries(ctx context.Context, canvasID idgen.ID[idgen.Canvas], limit int) ([]canvas.AuditEntry, error) {
+	if _, err := s.queries.GetCanvas(ctx, canvasID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, canvas.ErrNotFound
+		}
+		return nil, fmt.Errorf("query canvas: %w", err)
+	}
+
+	rows, err := s.queries.ListAuditEntries(ctx, queries.ListAuditEntriesParams{
+		CanvasID: canvasID,
+		MaxCount: int32(min(limit, math.MaxInt32)), //nolint:gosec
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list audit entries: %w", err)
+	}
+
+	entries := make([]canvas.AuditEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = canvas.AuditEntry{
+			CanvasID:  row.CanvasID,
+			Action:    row.Action,
+			UserID:    row.UserID,
+			Details:   row.Details,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return entries, nil
+}
+
+func (s *Store) InsertSnapshot(ctx context.Context, snapshot canvas.Snapshot) error {
+	err := s.withTx(ctx, func(q *queries.Queries) error {
+		return insertSnapshot(ctx, q, snapshot)
+	})
+	if err != nil {
+		if isViolation(err, pgerrcode.ForeignKeyViolation) {
+			return canvas.ErrNotFound
+		}
+		return fmt.Errorf("insert snapshot: %w", err)
+	}
+	return nil
+}
+
+func insertSnapshot(ctx context.Context, q *queries.Queries, snapshot canvas.Snapshot) error {
+	inserted, err := q.InsertSnapshot(ctx, queries.InsertSnapshotParams{
+		CanvasID: snapshot.CanvasID,
+		Seq:      snapshot.Seq,
+	})
+	if err != nil || inserted == 0 {
+		return err //nolint:wrapcheck
+	}
+
+	chunks := make([]queries.InsertSnapshotChunksParams, len(snapshot.Chunks))
+	for i, ch := range snapshot.Chunks {
+		chunks[i] = queries.InsertSnapshotChunksParams{
+			CanvasID: snapshot.CanvasID,
+			Seq:      snapshot.Seq,
+			ChunkX:   int32(ch.X), //nolint:gosec
+			ChunkY:   int32(ch.Y), //nolint:gosec
+			Version:  ch.Version,
+			Pixels:   ch.Pixels,
+		}
+	}
+
+	_, err = q.InsertSnapshotChunks(ctx, chunks)
+	return err //nolint:wrapcheck
+}
+
+func (s *Store) GetLatestSnapshot(ctx context.Context, canvasID idgen.ID[idgen.Canvas]) (canvas.Snapshot, error) {
+	row, err := s.queries.GetLatestSnapshot(ctx, canvasID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return canvas.Snapshot{}, canvas.ErrSnapshotNotFound
+		}
+		return canvas.Snapshot{}, fmt.Errorf("query snapshot: %w", err)
+	}
+
+	return snapshot(ctx, s.queries, row)
+}
+
+func (s *Store) GetSnapshot(ctx context.Context, canvasID idgen.ID[idgen.Canvas], seq int64) (canvas.Snapshot, error) {
+	row, err := s.queries.GetSnapshot(ctx, queries.GetSnapshotParams{
+		CanvasID: canvasID,
+		Seq:      seq,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return canvas.Snapshot{}, canvas.ErrSnapshotNotFound
+		}
+		return canvas.Snapshot{}, fmt.Errorf("query snapshot: %w", err)
+	}
+
+	return snapshot(ctx, s.queries, row)
+}
+
+func snapshot(ctx context.Context, q *queries.Queries, row queries.Snapshot) (canvas.Snapshot, error) {
+	rows, err := q.ListSnapshotChunks(ctx, queries.ListSnapshotChunksParams{
+		CanvasID: row.CanvasID,
+		Seq:      row.Seq,
+	})
+	if err != nil {
+		return canvas.Snapshot{}, fmt.Errorf("list snapshot chunks: %w", err)
+	}
+
+	chunks := make([]canvas.Chunk, len(rows))
+	for i, row := range rows {
+		chunks[i] = canvas.Chunk{
+			X:       int(row.ChunkX),
+			Y:       int(row.ChunkY),
+			Version: row.Version,
+			Pixels:  row.Pixels,
+		}
+	}
+
+	return canvas.Snapshot{
+		CanvasID: row.CanvasID,
+		Seq:      row.Seq,
+		Chunks:   chunks,
+	}, nil
+}
+
+// resizeBase returns the canvas as of its latest resize, or a blank canvas if
+// it has never been resized.
+func resizeBase(ctx context.Context, q *queries.Queries, row queries.Canvas) (*canvas.Canvas, error) {
+	info, err := toInfo(row)
+	if err != nil {
+		return nil, err
+	}
+
+	cnv, err := canvas.FromInfo(info)
+	if err != nil {
+		return nil, fmt.Errorf("init canvas: %w", err)
+	}
+	if row.ResizedSeq == 0 {
+		return cnv, nil
+	}
+
+	snapshotRow, err := q.GetSnapshot(ctx, queries.GetSnapshotParams{
+		CanvasID: row.ID,
+		Seq:      row.ResizedSeq,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query resize snapshot: %w", err)
+	}
+
+	resize, err := snapshot(ctx, q, snapshotRow)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := cnv.Restore(resize); err != nil {
+		return nil, fmt.Errorf("restore resize snapshot: %w", err)
+	}
+	return cnv, nil
+}
+
+func (s *Store) PruneSnapshots(ctx context.Context, canvasID idgen.ID[idgen.Canvas], keep int) error {
+	err := s.withTx(ctx, func(q *queries.Queries) error {
+		if keep <= 0 {
+			if err := q.DeleteSnapshotChunks(ctx, canvasID); err != nil {
+				return err //nolint:wrapcheck
+			}
+			return q.DeleteSnapshots(ctx, canvasID) //nolint:wrapcheck
+		}
+
+		cutoff, err := q.GetSnapshotCutoff(ctx, queries.GetSnapshotCutoffParams{
+			CanvasID: canvasID,
+			Skip:     int32(min(keep-1, math.MaxInt32)), //nolint:gosec
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err //nolint:wrapcheck
+		}
+
+		err = q.PruneSnapshotChunks(ctx, queries.PruneSnapshotChunksParams{
+			CanvasID: canvasID,
+			Cutoff:   cutoff,
+		})
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		return q.PruneSnapshots(ctx, queries.PruneSnapshotsParams{ //nolint:wrapcheck
+			CanvasID: canvasID,
+			Cutoff:   cutoff,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("delete snapshots: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) withTx(ctx context.Context, fn func(q *queries.Queries) error) error {
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error { //nolint:wrapcheck
+		return fn(s.queries.WithTx(tx))
+	})
+}
+
+func isViolation(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
+
+func toInfo(row queries.Canvas) (canvas.Info, error) {
+	palette, err := canvas.ParsePalette(row.Palette)
+	if err != nil {
+		return canvas.Info{}, fmt.Errorf("parse palette: %w", err)
+	}
+
+	return canvas.Info{
+		ID:         row.ID,
+		Width:      int(row.Width),
+		Height:     int(row.Height),
+		Palette:    palette,
+		Seq:        row.Seq,
+		Version:    int(row.Version),
+		ResizedSeq: row.ResizedSeq,
+		CreatedAt:  row.CreatedAt,
+		ArchivedAt: row.ArchivedAt.Time,
+	}, nil
+}
+
+func toProtectedRegion(row queries.ProtectedRegion) canvas.ProtectedRegion {
+	x, y := int(row.X), int(row.Y)
+	return canvas.ProtectedRegion{
+		ID:        row.ID,
+		Bounds:    image.Rect(x, y, x+int(row.Width), y+int(row.Height)),
+		Reason:    row.Reason,
+		CreatedAt: row.CreatedAt,
+		ExpiresAt: row.ExpiresAt.Time,
+	}
+}
+
+func toPlacement(row queries.Placement) canvas.Placement {
+	return canvas.Placement{
+		CanvasID: row.CanvasID,
+		X:        int(row.X),
+		Y:        int(row.Y),
+		Color:    uint8(row.Color), //nolint:gosec
+		UserID:   row.UserID,
+		PlacedAt: row.PlacedAt,
+		Seq:      row.Seq,
+	}
+}
+
+var _ healthz.Target = (*Store)(nil)
+
+func (s *Store) HealthChecks() []health.Check {
+	return []health.Check{
+		{
+			Name:  "storage:postgres",
+			Check: s.pool.Ping,
+		},
+	}
+}
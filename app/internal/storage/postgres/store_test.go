@@ -0,0 +1,81 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/storage/postgres"
+	"github.com/jace-ys/pikcel/internal/storage/storagetest"
+)
+
+func TestStore(t *testing.T) {
+	dsn := os.Getenv("PIKCEL_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("PIKCEL_TEST_DATABASE_DSN not set")
+	}
+
+	storagetest.RunRepositoryTests(t, func(t *testing.T) canvas.Repository {
+		return newStore(t, dsn)
+	})
+}
+
+// newStore returns a store backed by a fresh, fully migrated schema that is
+// dropped once the test completes.
+func newStore(t *testing.T, dsn string) *postgres.Store {
+	t.Helper()
+	ctx := t.Context()
+
+	schema := strings.ToLower(idgen.New[idgen.Canvas]().String())
+
+	admin, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(admin.Close)
+
+	if _, err := admin.Exec(ctx, fmt.Sprintf("CREATE SCHEMA %s", pgx.Identifier{schema}.Sanitize())); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		// The test context is already canceled by the time cleanups run.
+		ctx := context.WithoutCancel(ctx)
+		if _, err := admin.Exec(ctx, fmt.Sprintf("DROP SCHEMA %s CASCADE", pgx.Identifier{schema}.Sanitize())); err != nil {
+			t.Errorf("drop schema: %v", err)
+		}
+	})
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatalf("parse dsn: %v", err)
+	}
+	query := u.Query()
+	query.Set("search_path", schema)
+	u.RawQuery = query.Encode()
+
+	store, err := postgres.NewStore(ctx, u.String())
+	if err != nil {
+		t.Fatalf("init store: %v", err)
+	}
+	t.Cleanup(store.Close)
+
+	migrator, err := store.Migrator()
+	if err != nil {
+		t.Fatalf("init migrator: %v", err)
+	}
+	t.Cleanup(func() { migrator.Close() })
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	return store
+}
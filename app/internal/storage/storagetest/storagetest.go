@@ -0,0 +1,1034 @@
+package storagetest
+
+import (
+	"errors"
+	"image"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/cooldown"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+// RunRepositoryTests runs the conformance suite that every canvas.Repository
+// implementation is expected to pass. newRepo must return an empty repository.
+func RunRepositoryTests(t *testing.T, newRepo func(t *testing.T) canvas.Repository) {
+	t.Helper()
+
+	t.Run("CreateCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+		want := newCanvas(t, 8, 4)
+		createCanvas(t, repo, want)
+
+		got := getCanvas(t, repo, want.ID())
+		assertCanvas(t, got, want)
+	})
+
+	t.Run("CreateCanvasDuplicate", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		err := repo.CreateCanvas(t.Context(), cnv)
+		if !errors.Is(err, canvas.ErrAlreadyExists) {
+			t.Fatalf("CreateCanvas: got error %v, want %v", err, canvas.ErrAlreadyExists)
+		}
+	})
+
+	t.Run("CreateInitialCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+		want := newCanvas(t, 8, 4)
+
+		created, err := repo.CreateInitialCanvas(t.Context(), want)
+		if err != nil {
+			t.Fatalf("CreateInitialCanvas: %v", err)
+		}
+		if !created {
+			t.Fatalf("CreateInitialCanvas: got created false, want true")
+		}
+
+		created, err = repo.CreateInitialCanvas(t.Context(), newCanvas(t, 16, 16))
+		if err != nil {
+			t.Fatalf("CreateInitialCanvas: %v", err)
+		}
+		if created {
+			t.Fatalf("CreateInitialCanvas: got created true, want false")
+		}
+
+		infos, err := repo.ListCanvases(t.Context(), true)
+		if err != nil {
+			t.Fatalf("ListCanvases: %v", err)
+		}
+		if len(infos) != 1 || infos[0].ID != want.ID() {
+			t.Fatalf("ListCanvases: got %d canvases, want only %s", len(infos), want.ID())
+		}
+	})
+
+	t.Run("CreateInitialCanvasConcurrent", func(t *testing.T) {
+		repo := newRepo(t)
+
+		var wg sync.WaitGroup
+		var created atomic.Int32
+		for range 8 {
+			wg.Go(func() {
+				ok, err := repo.CreateInitialCanvas(t.Context(), newCanvas(t, 8, 4))
+				if err != nil {
+					t.Errorf("CreateInitialCanvas: %v", err)
+				}
+				if ok {
+					created.Add(1)
+				}
+			})
+		}
+		wg.Wait()
+
+		if got := created.Load(); got != 1 {
+			t.Fatalf("CreateInitialCanvas: created %d canvases, want 1", got)
+		}
+	})
+
+	t.Run("GetCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+		createCanvas(t, repo, newCanvas(t, 8, 4))
+		want := newCanvas(t, 16, 16)
+		createCanvas(t, repo, want)
+
+		got, err := repo.GetCanvas(t.Context(), want.ID())
+		if err != nil {
+			t.Fatalf("GetCanvas: %v", err)
+		}
+		assertCanvas(t, got, want)
+	})
+
+	t.Run("GetCanvasNotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetCanvas(t.Context(), idgen.New[idgen.Canvas]())
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("GetCanvas: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("GetCanvasInfo", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		insertPlacement(t, repo, newPlacement(cnv.ID(), 0, 0, 1))
+
+		got, err := repo.GetCanvasInfo(t.Context(), cnv.ID())
+		if err != nil {
+			t.Fatalf("GetCanvasInfo: %v", err)
+		}
+		assertInfo(t, got, cnv)
+		if got.Seq != 1 {
+			t.Errorf("GetCanvasInfo: got seq %d, want 1", got.Seq)
+		}
+		if got.CreatedAt.IsZero() || got.Archived() {
+			t.Errorf("GetCanvasInfo: got created at %v and archived at %v, want only created at set", got.CreatedAt, got.ArchivedAt)
+		}
+	})
+
+	t.Run("GetCanvasInfoNotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetCanvasInfo(t.Context(), idgen.New[idgen.Canvas]())
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("GetCanvasInfo: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("ListCanvases", func(t *testing.T) {
+		repo := newRepo(t)
+		var want []*canvas.Canvas
+		for i := range 3 {
+			cnv := newCanvas(t, 8+i, 4)
+			createCanvas(t, repo, cnv)
+			want = append(want, cnv)
+			time.Sleep(10 * time.Millisecond)
+		}
+		archiveCanvas(t, repo, want[1].ID())
+
+		got, err := repo.ListCanvases(t.Context(), false)
+		if err != nil {
+			t.Fatalf("ListCanvases: %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("ListCanvases: got %d canvases, want 2", len(got))
+		}
+		assertInfo(t, got[0], want[0])
+		assertInfo(t, got[1], want[2])
+
+		got, err = repo.ListCanvases(t.Context(), true)
+		if err != nil {
+			t.Fatalf("ListCanvases: %v", err)
+		}
+		if len(got) != 3 {
+			t.Fatalf("ListCanvases: got %d canvases, want 3", len(got))
+		}
+		for i := range got {
+			assertInfo(t, got[i], want[i])
+		}
+	})
+
+	t.Run("ArchiveCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		at := time.Now().UTC().Truncate(time.Microsecond)
+		info, err := repo.ArchiveCanvas(t.Context(), cnv.ID(), at)
+		if err != nil {
+			t.Fatalf("ArchiveCanvas: %v", err)
+		}
+		if !info.ArchivedAt.Equal(at) {
+			t.Errorf("ArchiveCanvas: got archived at %v, want %v", info.ArchivedAt, at)
+		}
+
+		info, err = repo.ArchiveCanvas(t.Context(), cnv.ID(), at.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("ArchiveCanvas: %v", err)
+		}
+		if !info.ArchivedAt.Equal(at) {
+			t.Errorf("ArchiveCanvas: got archived at %v after archiving again, want %v", info.ArchivedAt, at)
+		}
+	})
+
+	t.Run("ArchiveCanvasNotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.ArchiveCanvas(t.Context(), idgen.New[idgen.Canvas](), time.Now())
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("ArchiveCanvas: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("ResizeCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		applyPlacements(t, repo, cnv,
+			newPlacement(cnv.ID(), 1, 1, 2),
+			newPlacement(cnv.ID(), 7, 3, 3),
+		)
+
+		r := canvas.Resize{Left: 2, Top: 1, Right: 60, Bottom: 70, Fill: 4}
+		info, err := repo.ResizeCanvas(t.Context(), cnv.ID(), r)
+		if err != nil {
+			t.Fatalf("ResizeCanvas: %v", err)
+		}
+
+		want, err := cnv.Resize(r, 3)
+		if err != nil {
+			t.Fatalf("Resize: %v", err)
+		}
+		assertInfo(t, info, want)
+		if info.Seq != 3 || info.Version != 2 || info.ResizedSeq != 3 {
+			t.Errorf("ResizeCanvas: got seq %d, version %d and resized seq %d, want 3, 2 and 3", info.Seq, info.Version, info.ResizedSeq)
+		}
+
+		got, err := repo.ListPlacements(t.Context(), cnv.ID(), 0, 10)
+		if err != nil {
+			t.Fatalf("ListPlacements: %v", err)
+		}
+		if len(got) != 2 || got[0].Point() != image.Pt(3, 2) || got[1].Point() != image.Pt(9, 4) {
+			t.Errorf("ListPlacements: got %v, want placements shifted to (3, 2) and (9, 4)", got)
+		}
+
+		snapshot, err := repo.GetLatestSnapshot(t.Context(), cnv.ID())
+		if err != nil {
+			t.Fatalf("GetLatestSnapshot: %v", err)
+		}
+		if snapshot.Seq != 3 {
+			t.Errorf("GetLatestSnapshot: got seq %d, want 3", snapshot.Seq)
+		}
+
+		applyPlacements(t, repo, want, newPlacement(cnv.ID(), 69, 74, 5))
+		loaded, err := repo.GetCanvas(t.Context(), cnv.ID())
+		if err != nil {
+			t.Fatalf("GetCanvas: %v", err)
+		}
+		assertCanvas(t, loaded, want)
+		if loaded.Seq() != 4 {
+			t.Errorf("GetCanvas: got seq %d, want 4", loaded.Seq())
+		}
+	})
+
+	t.Run("ResizeCanvasNotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.ResizeCanvas(t.Context(), idgen.New[idgen.Canvas](), canvas.Resize{Right: 1})
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("ResizeCanvas: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("ResizeCanvasArchived", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		archiveCanvas(t, repo, cnv.ID())
+
+		_, err := repo.ResizeCanvas(t.Context(), cnv.ID(), canvas.Resize{Right: 1})
+		if !errors.Is(err, canvas.ErrArchived) {
+			t.Fatalf("ResizeCanvas: got error %v, want %v", err, canvas.ErrArchived)
+		}
+	})
+
+	t.Run("InsertPlacement", func(t *testing.T) {
+		repo := newRepo(t)
+		want := newCanvas(t, 8, 4)
+		createCanvas(t, repo, want)
+
+		placements := []canvas.Placement{
+			newPlacement(want.ID(), 0, 0, 1),
+			newPlacement(want.ID(), 7, 3, 2),
+			newPlacement(want.ID(), 3, 2, 4),
+			newPlacement(want.ID(), 3, 2, 5),
+		}
+		for i, p := range placements {
+			p.Seq = insertPlacement(t, repo, p)
+			if wantSeq := int64(i) + 1; p.Seq != wantSeq {
+				t.Errorf("InsertPlacement: got seq %d, want %d", p.Seq, wantSeq)
+			}
+			if _, err := want.Apply(p); err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+		}
+
+		got := getCanvas(t, repo, want.ID())
+		assertCanvas(t, got, want)
+	})
+
+	t.Run("InsertPlacementSeqPerCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+		first := newCanvas(t, 8, 4)
+		createCanvas(t, repo, first)
+		second := newCanvas(t, 8, 4)
+		createCanvas(t, repo, second)
+
+		insertPlacement(t, repo, newPlacement(first.ID(), 0, 0, 1))
+		insertPlacement(t, repo, newPlacement(first.ID(), 1, 0, 1))
+
+		if seq := insertPlacement(t, repo, newPlacement(second.ID(), 0, 0, 1)); seq != 1 {
+			t.Errorf("InsertPlacement: got seq %d, want 1", seq)
+		}
+	})
+
+	t.Run("InsertPlacementUnknownCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.InsertPlacement(t.Context(), newPlacement(idgen.New[idgen.Canvas](), 0, 0, 1), 0)
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("InsertPlacement: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("InsertPlacementArchivedCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		archiveCanvas(t, repo, cnv.ID())
+
+		_, err := repo.InsertPlacement(t.Context(), newPlacement(cnv.ID(), 0, 0, 1), 0)
+		if !errors.Is(err, canvas.ErrArchived) {
+			t.Fatalf("InsertPlacement: got error %v, want %v", err, canvas.ErrArchived)
+		}
+	})
+
+	t.Run("InsertPlacementCooldown", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv, other := newCanvas(t, 8, 4), newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		createCanvas(t, repo, other)
+
+		first := newPlacement(cnv.ID(), 0, 0, 1)
+		if _, err := repo.InsertPlacement(t.Context(), first, 5*time.Minute); err != nil {
+			t.Fatalf("InsertPlacement: %v", err)
+		}
+
+		again := first
+		again.PlacedAt = first.PlacedAt.Add(time.Minute)
+		_, err := repo.InsertPlacement(t.Context(), again, 5*time.Minute)
+		var cerr *cooldown.ActiveError
+		if !errors.As(err, &cerr) || cerr.Remaining != 4*time.Minute {
+			t.Fatalf("InsertPlacement: got error %v, want cooldown with 4m remaining", err)
+		}
+
+		elsewhere := again
+		elsewhere.CanvasID = other.ID()
+		if _, err := repo.InsertPlacement(t.Context(), elsewhere, 5*time.Minute); err != nil {
+			t.Errorf("InsertPlacement on another canvas: %v", err)
+		}
+
+		again.PlacedAt = first.PlacedAt.Add(5 * time.Minute)
+		if _, err := repo.InsertPlacement(t.Context(), again, 5*time.Minute); err != nil {
+			t.Errorf("InsertPlacement after cooldown: %v", err)
+		}
+	})
+
+	t.Run("ProtectRegion", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		region := newProtectedRegion(image.Rect(2, 1, 5, 3), time.Time{})
+		protectRegion(t, repo, cnv.ID(), region)
+		protectRegion(t, repo, cnv.ID(), newProtectedRegion(image.Rect(0, 0, 8, 4), time.Now().Add(-time.Minute)))
+
+		_, err := repo.InsertPlacement(t.Context(), newPlacement(cnv.ID(), 4, 2, 1), 0)
+		if !errors.Is(err, canvas.ErrProtected) {
+			t.Fatalf("InsertPlacement: got error %v, want %v", err, canvas.ErrProtected)
+		}
+		if seq := insertPlacement(t, repo, newPlacement(cnv.ID(), 5, 2, 1)); seq != 1 {
+			t.Errorf("InsertPlacement: got seq %d, want 1", seq)
+		}
+
+		info, err := repo.GetCanvasInfo(t.Context(), cnv.ID())
+		if err != nil {
+			t.Fatalf("GetCanvasInfo: %v", err)
+		}
+		assertProtectedRegions(t, info.ProtectedRegions, region)
+
+		infos, err := repo.ListCanvases(t.Context(), false)
+		if err != nil {
+			t.Fatalf("ListCanvases: %v", err)
+		}
+		assertProtectedRegions(t, infos[0].ProtectedRegions, region)
+	})
+
+	t.Run("ProtectRegionNotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		err := repo.ProtectRegion(t.Context(), idgen.New[idgen.Canvas](), newProtectedRegion(image.Rect(0, 0, 1, 1), time.Time{}))
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("ProtectRegion: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("UnprotectRegion", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		region := newProtectedRegion(image.Rect(0, 0, 8, 4), time.Time{})
+		protectRegion(t, repo, cnv.ID(), region)
+
+		if err := repo.UnprotectRegion(t.Context(), cnv.ID(), region.ID); err != nil {
+			t.Fatalf("UnprotectRegion: %v", err)
+		}
+		insertPlacement(t, repo, newPlacement(cnv.ID(), 0, 0, 1))
+
+		err := repo.UnprotectRegion(t.Context(), cnv.ID(), region.ID)
+		if !errors.Is(err, canvas.ErrRegionNotFound) {
+			t.Fatalf("UnprotectRegion: got error %v, want %v", err, canvas.ErrRegionNotFound)
+		}
+
+		err = repo.UnprotectRegion(t.Context(), idgen.New[idgen.Canvas](), region.ID)
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("UnprotectRegion: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("ResizeCanvasShiftsProtectedRegions", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		region := newProtectedRegion(image.Rect(2, 1, 5, 3), time.Time{})
+		protectRegion(t, repo, cnv.ID(), region)
+
+		info, err := repo.ResizeCanvas(t.Context(), cnv.ID(), canvas.Resize{Left: 2, Top: 1})
+		if err != nil {
+			t.Fatalf("ResizeCanvas: %v", err)
+		}
+		region.Bounds = image.Rect(4, 2, 7, 4)
+		assertProtectedRegions(t, info.ProtectedRegions, region)
+
+		_, err = repo.InsertPlacement(t.Context(), newPlacement(cnv.ID(), 6, 3, 1), 0)
+		if !errors.Is(err, canvas.ErrProtected) {
+			t.Fatalf("InsertPlacement: got error %v, want %v", err, canvas.ErrProtected)
+		}
+		insertPlacement(t, repo, newPlacement(cnv.ID(), 2, 1, 1))
+	})
+
+	t.Run("ListPlacements", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		var placements []canvas.Placement
+		for i := range 5 {
+			p := newPlacement(cnv.ID(), i, 0, 1)
+			p.Seq = insertPlacement(t, repo, p)
+			placements = append(placements, p)
+		}
+
+		tests := []struct {
+			afterSeq int64
+			limit    int
+			want     []canvas.Placement
+		}{
+			{afterSeq: 0, limit: 10, want: placements},
+			{afterSeq: 2, limit: 10, want: placements[2:]},
+			{afterSeq: 1, limit: 2, want: placements[1:3]},
+			{afterSeq: 5, limit: 10, want: nil},
+		}
+		for _, tt := range tests {
+			got, err := repo.ListPlacements(t.Context(), cnv.ID(), tt.afterSeq, tt.limit)
+			if err != nil {
+				t.Fatalf("ListPlacements: %v", err)
+			}
+			if !slices.EqualFunc(got, tt.want, equalPlacement) {
+				t.Errorf("ListPlacements(%d, %d): got %v, want %v", tt.afterSeq, tt.limit, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("ListPixelPlacements", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		var atPoint []canvas.Placement
+		for i := range 4 {
+			p := newPlacement(cnv.ID(), 3, 2, uint8(i))
+			p.Seq = insertPlacement(t, repo, p)
+			atPoint = append(atPoint, p)
+			insertPlacement(t, repo, newPlacement(cnv.ID(), 2, 3, 1))
+		}
+
+		got, err := repo.ListPixelPlacements(t.Context(), cnv.ID(), 3, 2, 3)
+		if err != nil {
+			t.Fatalf("ListPixelPlacements: %v", err)
+		}
+
+		want := []canvas.Placement{atPoint[3], atPoint[2], atPoint[1]}
+		if !slices.EqualFunc(got, want, equalPlacement) {
+			t.Errorf("ListPixelPlacements: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("RollbackPlacements", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		griefer, moderator := idgen.New[idgen.User](), idgen.New[idgen.User]()
+		placeAs := func(user idgen.ID[idgen.User], x, y int, color uint8) canvas.Placement {
+			p := newPlacement(cnv.ID(), x, y, color)
+			p.UserID = user
+			return p
+		}
+		applyPlacements(t, repo, cnv,
+			newPlacement(cnv.ID(), 1, 1, 2),
+			placeAs(griefer, 1, 1, 5),
+			placeAs(griefer, 2, 2, 5),
+			placeAs(griefer, 3, 3, 5),
+			newPlacement(cnv.ID(), 3, 3, 6),
+			placeAs(griefer, 1, 1, 7),
+		)
+		protectRegion(t, repo, cnv.ID(), newProtectedRegion(image.Rect(0, 0, 8, 4), time.Time{}))
+
+		at := time.Now().UTC().Truncate(time.Microsecond)
+		r := canvas.Rollback{UserID: griefer}
+		got, err := repo.RollbackPlacements(t.Context(), cnv.ID(), r, moderator, at)
+		if err != nil {
+			t.Fatalf("RollbackPlacements: %v", err)
+		}
+
+		want := []canvas.Placement{
+			{CanvasID: cnv.ID(), X: 2, Y: 2, Color: 0, UserID: moderator, PlacedAt: at, Seq: 7},
+			{CanvasID: cnv.ID(), X: 1, Y: 1, Color: 2, UserID: moderator, PlacedAt: at, Seq: 8},
+		}
+		if !slices.EqualFunc(got, want, equalPlacement) {
+			t.Fatalf("RollbackPlacements: got %v, want %v", got, want)
+		}
+
+		for _, p := range want {
+			if _, err := cnv.Apply(p); err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+		}
+		loaded, err := repo.GetCanvas(t.Context(), cnv.ID())
+		if err != nil {
+			t.Fatalf("GetCanvas: %v", err)
+		}
+		assertCanvas(t, loaded, cnv)
+
+		entries, err := repo.ListAuditEntries(t.Context(), cnv.ID(), 10)
+		if err != nil {
+			t.Fatalf("ListAuditEntries: %v", err)
+		}
+		wantEntry := r.AuditEntry(cnv.ID(), moderator, at, 2)
+		if len(entries) != 1 || entries[0].Action != wantEntry.Action || entries[0].UserID != moderator ||
+			entries[0].Details != wantEntry.Details || !entries[0].CreatedAt.Equal(at) {
+			t.Errorf("ListAuditEntries: got %v, want %v", entries, wantEntry)
+		}
+	})
+
+	t.Run("RollbackPlacementsAfterResize", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 4, 4)
+		createCanvas(t, repo, cnv)
+
+		griefer, moderator := idgen.New[idgen.User](), idgen.New[idgen.User]()
+		placeAs := func(user idgen.ID[idgen.User], x, y int, color uint8) canvas.Placement {
+			p := newPlacement(cnv.ID(), x, y, color)
+			p.UserID = user
+			return p
+		}
+		applyPlacements(t, repo, cnv,
+			newPlacement(cnv.ID(), 1, 1, 2),
+			placeAs(griefer, 2, 2, 3),
+		)
+
+		r := canvas.Resize{Left: 2, Fill: 4}
+		info, err := repo.ResizeCanvas(t.Context(), cnv.ID(), r)
+		if err != nil {
+			t.Fatalf("ResizeCanvas: %v", err)
+		}
+		resized, err := cnv.Resize(r, info.ResizedSeq)
+		if err != nil {
+			t.Fatalf("Resize: %v", err)
+		}
+
+		// The placements before the resize are part of the resized canvas, so
+		// the griefer's placement at (4, 2) is left as it is.
+		applyPlacements(t, repo, resized,
+			placeAs(griefer, 0, 0, 5),
+			placeAs(griefer, 3, 1, 5),
+		)
+
+		at := time.Now().UTC().Truncate(time.Microsecond)
+		got, err := repo.RollbackPlacements(t.Context(), cnv.ID(), canvas.Rollback{UserID: griefer}, moderator, at)
+		if err != nil {
+			t.Fatalf("RollbackPlacements: %v", err)
+		}
+
+		want := []canvas.Placement{
+			{CanvasID: cnv.ID(), X: 0, Y: 0, Color: 4, UserID: moderator, PlacedAt: at, Seq: 6},
+			{CanvasID: cnv.ID(), X: 3, Y: 1, Color: 2, UserID: moderator, PlacedAt: at, Seq: 7},
+		}
+		if !slices.EqualFunc(got, want, equalPlacement) {
+			t.Fatalf("RollbackPlacements: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("RollbackPlacementsWithin", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		before := newPlacement(cnv.ID(), 3, 3, 2)
+		before.PlacedAt = before.PlacedAt.Add(-time.Hour)
+		applyPlacements(t, repo, cnv,
+			before,
+			newPlacement(cnv.ID(), 3, 3, 5),
+			newPlacement(cnv.ID(), 2, 2, 5),
+			newPlacement(cnv.ID(), 0, 0, 5),
+		)
+
+		r := canvas.Rollback{
+			Bounds: image.Rect(2, 2, 4, 4),
+			From:   before.PlacedAt.Add(time.Minute),
+		}
+		got, err := repo.RollbackPlacements(t.Context(), cnv.ID(), r, idgen.New[idgen.User](), time.Now().UTC())
+		if err != nil {
+			t.Fatalf("RollbackPlacements: %v", err)
+		}
+		if len(got) != 2 || got[0].Point() != image.Pt(3, 3) || got[0].Color != 2 || got[1].Point() != image.Pt(2, 2) || got[1].Color != 0 {
+			t.Errorf("RollbackPlacements: got %v, want (3, 3) reverted to 2 and (2, 2) reverted to 0", got)
+		}
+	})
+
+	t.Run("RollbackPlacementsCooldown", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		griefer := newPlacement(cnv.ID(), 1, 1, 5)
+		applyPlacements(t, repo, cnv, griefer)
+
+		moderator := idgen.New[idgen.User]()
+		at := griefer.PlacedAt.Add(time.Minute)
+		reverts, err := repo.RollbackPlacements(t.Context(), cnv.ID(), canvas.Rollback{UserID: griefer.UserID}, moderator, at)
+		if err != nil {
+			t.Fatalf("RollbackPlacements: %v", err)
+		}
+		if len(reverts) != 1 {
+			t.Fatalf("RollbackPlacements: got %d placements, want 1", len(reverts))
+		}
+
+		p := newPlacement(cnv.ID(), 2, 2, 1)
+		p.UserID = moderator
+		p.PlacedAt = at.Add(time.Minute)
+		if _, err := repo.InsertPlacement(t.Context(), p, 5*time.Minute); err != nil {
+			t.Errorf("InsertPlacement after rollback: %v", err)
+		}
+	})
+
+	t.Run("RollbackPlacementsNotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.RollbackPlacements(t.Context(), idgen.New[idgen.Canvas](), canvas.Rollback{}, idgen.New[idgen.User](), time.Now())
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("RollbackPlacements: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("RollbackPlacementsArchived", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		archiveCanvas(t, repo, cnv.ID())
+
+		_, err := repo.RollbackPlacements(t.Context(), cnv.ID(), canvas.Rollback{}, idgen.New[idgen.User](), time.Now())
+		if !errors.Is(err, canvas.ErrArchived) {
+			t.Fatalf("RollbackPlacements: got error %v, want %v", err, canvas.ErrArchived)
+		}
+	})
+
+	t.Run("GetLatestSnapshotEmpty", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		_, err := repo.GetLatestSnapshot(t.Context(), cnv.ID())
+		if !errors.Is(err, canvas.ErrSnapshotNotFound) {
+			t.Fatalf("GetLatestSnapshot: got error %v, want %v", err, canvas.ErrSnapshotNotFound)
+		}
+	})
+
+	t.Run("InsertSnapshot", func(t *testing.T) {
+		repo := newRepo(t)
+		want := newCanvas(t, 8, 4)
+		createCanvas(t, repo, want)
+
+		applyPlacements(t, repo, want, newPlacement(want.ID(), 0, 0, 1), newPlacement(want.ID(), 1, 1, 2))
+		insertSnapshot(t, repo, want.Snapshot())
+		applyPlacements(t, repo, want, newPlacement(want.ID(), 2, 2, 3))
+
+		latest, err := repo.GetLatestSnapshot(t.Context(), want.ID())
+		if err != nil {
+			t.Fatalf("GetLatestSnapshot: %v", err)
+		}
+		if latest.Seq != 2 {
+			t.Errorf("GetLatestSnapshot: got seq %d, want 2", latest.Seq)
+		}
+
+		got := getCanvas(t, repo, want.ID())
+		assertCanvas(t, got, want)
+	})
+
+	t.Run("InsertSnapshotIncremental", func(t *testing.T) {
+		repo := newRepo(t)
+		want := newCanvas(t, 2*canvas.ChunkSize, canvas.ChunkSize+1)
+		createCanvas(t, repo, want)
+
+		applyPlacements(t, repo, want, newPlacement(want.ID(), 0, 0, 1), newPlacement(want.ID(), canvas.ChunkSize, 0, 2))
+		insertSnapshot(t, repo, want.SnapshotSince(0))
+		applyPlacements(t, repo, want, newPlacement(want.ID(), 0, canvas.ChunkSize, 3))
+		insertSnapshot(t, repo, want.SnapshotSince(2))
+
+		latest, err := repo.GetLatestSnapshot(t.Context(), want.ID())
+		if err != nil {
+			t.Fatalf("GetLatestSnapshot: %v", err)
+		}
+		if latest.Seq != 3 || len(latest.Chunks) != 3 {
+			t.Errorf("GetLatestSnapshot: got seq %d with %d chunks, want seq 3 with 3 chunks", latest.Seq, len(latest.Chunks))
+		}
+
+		got := restoreCanvas(t, want.ID(), want.Width(), want.Height(), latest)
+		assertCanvas(t, got, want)
+	})
+
+	t.Run("InsertSnapshotDuplicate", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		applyPlacements(t, repo, cnv, newPlacement(cnv.ID(), 0, 0, 1))
+
+		insertSnapshot(t, repo, cnv.Snapshot())
+		insertSnapshot(t, repo, cnv.Snapshot())
+	})
+
+	t.Run("InsertSnapshotUnknownCanvas", func(t *testing.T) {
+		repo := newRepo(t)
+
+		err := repo.InsertSnapshot(t.Context(), newCanvas(t, 8, 4).Snapshot())
+		if !errors.Is(err, canvas.ErrNotFound) {
+			t.Fatalf("InsertSnapshot: got error %v, want %v", err, canvas.ErrNotFound)
+		}
+	})
+
+	t.Run("PruneSnapshots", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+
+		for i := range 4 {
+			applyPlacements(t, repo, cnv, newPlacement(cnv.ID(), i, 0, 1))
+			insertSnapshot(t, repo, cnv.Snapshot())
+		}
+
+		if err := repo.PruneSnapshots(t.Context(), cnv.ID(), 1); err != nil {
+			t.Fatalf("PruneSnapshots: %v", err)
+		}
+
+		latest, err := repo.GetLatestSnapshot(t.Context(), cnv.ID())
+		if err != nil {
+			t.Fatalf("GetLatestSnapshot: %v", err)
+		}
+		if latest.Seq != 4 {
+			t.Errorf("GetLatestSnapshot: got seq %d, want 4", latest.Seq)
+		}
+
+		got := getCanvas(t, repo, cnv.ID())
+		assertCanvas(t, got, cnv)
+	})
+
+	t.Run("PruneSnapshotsKeepsResize", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 8, 4)
+		createCanvas(t, repo, cnv)
+		applyPlacements(t, repo, cnv, newPlacement(cnv.ID(), 1, 1, 2))
+
+		r := canvas.Resize{Left: 2, Right: 2, Fill: 4}
+		info, err := repo.ResizeCanvas(t.Context(), cnv.ID(), r)
+		if err != nil {
+			t.Fatalf("ResizeCanvas: %v", err)
+		}
+
+		want, err := cnv.Resize(r, info.ResizedSeq)
+		if err != nil {
+			t.Fatalf("Resize: %v", err)
+		}
+		resized, err := cnv.Resize(r, info.ResizedSeq)
+		if err != nil {
+			t.Fatalf("Resize: %v", err)
+		}
+		for i := range 3 {
+			applyPlacements(t, repo, resized, newPlacement(cnv.ID(), i, 0, 1))
+			insertSnapshot(t, repo, resized.Snapshot())
+		}
+
+		for _, keep := range []int{1, 0} {
+			if err := repo.PruneSnapshots(t.Context(), cnv.ID(), keep); err != nil {
+				t.Fatalf("PruneSnapshots: %v", err)
+			}
+
+			snapshot, err := repo.GetSnapshot(t.Context(), cnv.ID(), info.ResizedSeq)
+			if err != nil {
+				t.Fatalf("GetSnapshot: %v", err)
+			}
+			if snapshot.Seq != info.ResizedSeq {
+				t.Errorf("GetSnapshot: got seq %d, want %d", snapshot.Seq, info.ResizedSeq)
+			}
+			got := restoreCanvas(t, cnv.ID(), want.Width(), want.Height(), snapshot)
+			if !slices.Equal(got.Pixels(), want.Pixels()) {
+				t.Errorf("GetSnapshot: got pixels %v, want %v", got.Pixels(), want.Pixels())
+			}
+		}
+
+		_, err = repo.GetSnapshot(t.Context(), cnv.ID(), resized.Seq())
+		if !errors.Is(err, canvas.ErrSnapshotNotFound) {
+			t.Fatalf("GetSnapshot: got error %v, want %v", err, canvas.ErrSnapshotNotFound)
+		}
+	})
+
+	t.Run("PruneSnapshotsIncremental", func(t *testing.T) {
+		repo := newRepo(t)
+		cnv := newCanvas(t, 2*canvas.ChunkSize, canvas.ChunkSize)
+		createCanvas(t, repo, cnv)
+
+		// Only the first snapshot includes the left chunk, so it must outlive
+		// the snapshot itself.
+		applyPlacements(t, repo, cnv, newPlacement(cnv.ID(), 0, 0, 1))
+		insertSnapshot(t, repo, cnv.SnapshotSince(0))
+		for i := range 3 {
+			before := cnv.Seq()
+			applyPlacements(t, repo, cnv, newPlacement(cnv.ID(), canvas.ChunkSize+i, 0, 2))
+			insertSnapshot(t, repo, cnv.SnapshotSince(before))
+		}
+
+		if err := repo.PruneSnapshots(t.Context(), cnv.ID(), 2); err != nil {
+			t.Fatalf("PruneSnapshots: %v", err)
+		}
+
+		latest, err := repo.GetLatestSnapshot(t.Context(), cnv.ID())
+		if err != nil {
+			t.Fatalf("GetLatestSnapshot: %v", err)
+		}
+
+		got := restoreCanvas(t, cnv.ID(), cnv.Width(), cnv.Height(), latest)
+		assertCanvas(t, got, cnv)
+	})
+}
+
+func newCanvas(t *testing.T, width, height int) *canvas.Canvas {
+	t.Helper()
+
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), width, height, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("canvas.New: %v", err)
+	}
+	return cnv
+}
+
+func newPlacement(canvasID idgen.ID[idgen.Canvas], x, y int, color uint8) canvas.Placement {
+	return canvas.Placement{
+		CanvasID: canvasID,
+		X:        x,
+		Y:        y,
+		Color:    color,
+		UserID:   idgen.New[idgen.User](),
+		PlacedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+}
+
+func newProtectedRegion(bounds image.Rectangle, expiresAt time.Time) canvas.ProtectedRegion {
+	return canvas.ProtectedRegion{
+		ID:        idgen.New[idgen.Region](),
+		Bounds:    bounds,
+		Reason:    "logo",
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		ExpiresAt: expiresAt.UTC().Truncate(time.Microsecond),
+	}
+}
+
+func createCanvas(t *testing.T, repo canvas.Repository, cnv *canvas.Canvas) {
+	t.Helper()
+
+	if err := repo.CreateCanvas(t.Context(), cnv); err != nil {
+		t.Fatalf("CreateCanvas: %v", err)
+	}
+}
+
+func archiveCanvas(t *testing.T, repo canvas.Repository, id idgen.ID[idgen.Canvas]) {
+	t.Helper()
+
+	if _, err := repo.ArchiveCanvas(t.Context(), id, time.Now().UTC()); err != nil {
+		t.Fatalf("ArchiveCanvas: %v", err)
+	}
+}
+
+func protectRegion(t *testing.T, repo canvas.Repository, canvasID idgen.ID[idgen.Canvas], r canvas.ProtectedRegion) {
+	t.Helper()
+
+	if err := repo.ProtectRegion(t.Context(), canvasID, r); err != nil {
+		t.Fatalf("ProtectRegion: %v", err)
+	}
+}
+
+func insertPlacement(t *testing.T, repo canvas.Repository, p canvas.Placement) int64 {
+	t.Helper()
+
+	seq, err := repo.InsertPlacement(t.Context(), p, 0)
+	if err != nil {
+		t.Fatalf("InsertPlacement: %v", err)
+	}
+	return seq
+}
+
+// applyPlacements inserts placements into repo and applies them to cnv, so that
+// cnv is what the repository is expected to load.
+func applyPlacements(t *testing.T, repo canvas.Repository, cnv *canvas.Canvas, placements ...canvas.Placement) {
+	t.Helper()
+
+	for _, p := range placements {
+		p.Seq = insertPlacement(t, repo, p)
+		if _, err := cnv.Apply(p); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+}
+
+func restoreCanvas(t *testing.T, id idgen.ID[idgen.Canvas], width, height int, snapshot canvas.Snapshot) *canvas.Canvas {
+	t.Helper()
+
+	cnv, err := canvas.New(id, width, height, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("canvas.New: %v", err)
+	}
+
+	if _, err := cnv.Restore(snapshot); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	return cnv
+}
+
+func insertSnapshot(t *testing.T, repo canvas.Repository, snapshot canvas.Snapshot) {
+	t.Helper()
+
+	if err := repo.InsertSnapshot(t.Context(), snapshot); err != nil {
+		t.Fatalf("InsertSnapshot: %v", err)
+	}
+}
+
+func equalPlacement(a, b canvas.Placement) bool {
+	return a.CanvasID == b.CanvasID && a.X == b.X && a.Y == b.Y && a.Color == b.Color &&
+		a.UserID == b.UserID && a.PlacedAt.Equal(b.PlacedAt) && a.Seq == b.Seq
+}
+
+func getCanvas(t *testing.T, repo canvas.Repository, id idgen.ID[idgen.Canvas]) *canvas.Canvas {
+	t.Helper()
+
+	cnv, err := repo.GetCanvas(t.Context(), id)
+	if err != nil {
+		t.Fatalf("GetCanvas: %v", err)
+	}
+	return cnv
+}
+
+func assertCanvas(t *testing.T, got, want *canvas.Canvas) {
+	t.Helper()
+
+	if got.ID() != want.ID() {
+		t.Errorf("ID: got %s, want %s", got.ID(), want.ID())
+	}
+	if got.Width() != want.Width() || got.Height() != want.Height() {
+		t.Errorf("size: got %dx%d, want %dx%d", got.Width(), got.Height(), want.Width(), want.Height())
+	}
+	if !slices.Equal(got.Palette(), want.Palette()) {
+		t.Errorf("palette: got %v, want %v", got.Palette().Hex(), want.Palette().Hex())
+	}
+	if got.Seq() != want.Seq() {
+		t.Errorf("seq: got %d, want %d", got.Seq(), want.Seq())
+	}
+	if got.Version() != want.Version() || got.ResizedSeq() != want.ResizedSeq() {
+		t.Errorf("version: got %d resized at %d, want %d resized at %d", got.Version(), got.ResizedSeq(), want.Version(), want.ResizedSeq())
+	}
+	if !slices.Equal(got.Pixels(), want.Pixels()) {
+		t.Errorf("pixels: got %v, want %v", got.Pixels(), want.Pixels())
+	}
+}
+
+func assertInfo(t *testing.T, got canvas.Info, want *canvas.Canvas) {
+	t.Helper()
+
+	if got.ID != want.ID() {
+		t.Errorf("ID: got %s, want %s", got.ID, want.ID())
+	}
+	if got.Width != want.Width() || got.Height != want.Height() {
+		t.Errorf("size: got %dx%d, want %dx%d", got.Width, got.Height, want.Width(), want.Height())
+	}
+	if !slices.Equal(got.Palette, want.Palette()) {
+		t.Errorf("palette: got %v, want %v", got.Palette.Hex(), want.Palette().Hex())
+	}
+}
+
+func assertProtectedRegions(t *testing.T, got []canvas.ProtectedRegion, want ...canvas.ProtectedRegion) {
+	t.Helper()
+
+	equal := slices.EqualFunc(got, want, func(a, b canvas.ProtectedRegion) bool {
+		return a.ID == b.ID && a.Bounds == b.Bounds && a.Reason == b.Reason &&
+			a.CreatedAt.Equal(b.CreatedAt) && a.ExpiresAt.Equal(b.ExpiresAt)
+	})
+	if !equal {
+		t.Errorf("protected regions: got %v, want %v", got, want)
+	}
+}
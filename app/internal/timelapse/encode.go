@@ -0,0 +1,193 @@
+package timelapse
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// GIFEncoder encodes frames as an animated GIF, writing each frame as it is
+// encoded rather than holding every frame until the encoder is closed. Every
+// frame must have the same size and palette.
+type GIFEncoder struct {
+	w     io.Writer
+	delay int
+	buf   bytes.Buffer
+
+	frames int
+	err    error
+}
+
+func NewGIFEncoder(w io.Writer, delay time.Duration) *GIFEncoder {
+	return &GIFEncoder{
+		w:     w,
+		delay: max(int(delay/(10*time.Millisecond)), 1),
+	}
+}
+
+var _ Encoder = (*GIFEncoder)(nil)
+
+// Encode encodes frame as a GIF of its own, and writes its image block after
+// a control extension setting its delay. The header and color table of the
+// first frame are written first, and shared by every frame.
+func (e *GIFEncoder) Encode(frame *image.Paletted) error {
+	if e.err != nil {
+		return e.err
+	}
+
+	e.buf.Reset()
+	if err := gif.Encode(&e.buf, frame, nil); err != nil {
+		return fmt.Errorf("encode gif: %w", err)
+	}
+
+	header, block, err := splitGIF(e.buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if e.frames == 0 {
+		e.write(header)
+		// Loop forever, as gif.EncodeAll does by default.
+		e.write([]byte{0x21, 0xff, 0x0b})
+		e.write([]byte("NETSCAPE2.0"))
+		e.write([]byte{0x03, 0x01, 0x00, 0x00, 0x00})
+	}
+	e.write([]byte{0x21, 0xf9, 0x04, 0x00, byte(e.delay), byte(e.delay >> 8), 0x00, 0x00})
+	e.write(block)
+	if e.err != nil {
+		return fmt.Errorf("write gif: %w", e.err)
+	}
+
+	e.frames++
+	return nil
+}
+
+func (e *GIFEncoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	if e.frames == 0 {
+		return errors.New("encode gif: no frames")
+	}
+
+	e.write([]byte{0x3b})
+	if e.err != nil {
+		return fmt.Errorf("write gif: %w", e.err)
+	}
+	return nil
+}
+
+func (e *GIFEncoder) write(p []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(p)
+	}
+}
+
+// splitGIF splits a GIF with a single frame into its header, including the
+// logical screen descriptor and global color table, and the image block of
+// its frame, skipping any extensions and the trailer.
+func splitGIF(b []byte) (header, block []byte, err error) {
+	const screenEnd = 13
+	if len(b) < screenEnd+1 {
+		return nil, nil, errors.New("split gif: truncated header")
+	}
+
+	end := screenEnd
+	if packed := b[10]; packed&0x80 != 0 {
+		end += 3 << ((packed & 0x07) + 1)
+	}
+
+	i := end
+	for i < len(b) && b[i] == 0x21 {
+		// Skip the extension label, then each of its sub-blocks.
+		i += 2
+		for i < len(b) && b[i] != 0 {
+			i += int(b[i]) + 1
+		}
+		i++
+	}
+	if i >= len(b) || b[i] != 0x2c || b[len(b)-1] != 0x3b {
+		return nil, nil, errors.New("split gif: missing image block")
+	}
+
+	return b[:end], b[i : len(b)-1], nil
+}
+
+// ZipEncoder encodes frames as numbered PNG files in a zip archive.
+type ZipEncoder struct {
+	zw     *zip.Writer
+	frames int
+}
+
+func NewZipEncoder(w io.Writer) *ZipEncoder {
+	return &ZipEncoder{
+		zw: zip.NewWriter(w),
+	}
+}
+
+var _ Encoder = (*ZipEncoder)(nil)
+
+func (e *ZipEncoder) Encode(frame *image.Paletted) error {
+	w, err := e.zw.Create(frameName(e.frames))
+	if err != nil {
+		return fmt.Errorf("create zip entry: %w", err)
+	}
+
+	if err := png.Encode(w, frame); err != nil {
+		return fmt.Errorf("encode png: %w", err)
+	}
+
+	e.frames++
+	return nil
+}
+
+func (e *ZipEncoder) Close() error {
+	return e.zw.Close() //nolint:wrapcheck
+}
+
+// DirEncoder encodes frames as numbered PNG files in a directory, which is
+// created if it does not exist.
+type DirEncoder struct {
+	dir    string
+	frames int
+}
+
+func NewDirEncoder(dir string) (*DirEncoder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+	return &DirEncoder{dir: dir}, nil
+}
+
+var _ Encoder = (*DirEncoder)(nil)
+
+func (e *DirEncoder) Encode(frame *image.Paletted) error {
+	f, err := os.Create(filepath.Join(e.dir, frameName(e.frames)))
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	defer f.Close()
+
+	if err := png.Encode(f, frame); err != nil {
+		return fmt.Errorf("encode png: %w", err)
+	}
+
+	e.frames++
+	return f.Close() //nolint:wrapcheck
+}
+
+func (e *DirEncoder) Close() error {
+	return nil
+}
+
+func frameName(i int) string {
+	return fmt.Sprintf("frame-%05d.png", i)
+}
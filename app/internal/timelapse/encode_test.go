@@ -0,0 +1,209 @@
+package timelapse_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/timelapse"
+)
+
+func TestGIFEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		palette color.Palette
+		size    image.Point
+	}{
+		{
+			name:    "TwoColors",
+			palette: color.Palette{color.White, color.Black},
+			size:    image.Pt(3, 2),
+		},
+		{
+			name:    "OddColors",
+			palette: color.Palette{color.White, color.Black, color.RGBA{R: 0xff, A: 0xff}},
+			size:    image.Pt(5, 7),
+		},
+		{
+			name:    "FullPalette",
+			palette: grayPalette(256),
+			size:    image.Pt(16, 16),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frames := make([]*image.Paletted, 3)
+			for i := range frames {
+				frames[i] = image.NewPaletted(image.Rectangle{Max: tt.size}, tt.palette)
+				for j := range frames[i].Pix {
+					frames[i].Pix[j] = uint8((i + j) % len(tt.palette)) //nolint:gosec
+				}
+			}
+
+			var buf bytes.Buffer
+			enc := timelapse.NewGIFEncoder(&buf, 250*time.Millisecond)
+			for _, frame := range frames {
+				if err := enc.Encode(frame); err != nil {
+					t.Fatalf("Encode: %v", err)
+				}
+			}
+			if err := enc.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			decoded, err := gif.DecodeAll(&buf)
+			if err != nil {
+				t.Fatalf("DecodeAll: %v", err)
+			}
+			if len(decoded.Image) != len(frames) {
+				t.Fatalf("DecodeAll: got %d frames, want %d", len(decoded.Image), len(frames))
+			}
+			if decoded.LoopCount != 0 {
+				t.Errorf("DecodeAll: got loop count %d, want 0", decoded.LoopCount)
+			}
+			for i, frame := range frames {
+				if decoded.Delay[i] != 25 {
+					t.Errorf("frame %d: got delay %d, want 25", i, decoded.Delay[i])
+				}
+				assertImage(t, decoded.Image[i], frame)
+			}
+		})
+	}
+}
+
+func TestGIFEncoderNoFrames(t *testing.T) {
+	var buf bytes.Buffer
+	if err := timelapse.NewGIFEncoder(&buf, time.Second).Close(); err == nil {
+		t.Error("Close: got nil error, want error for a gif without frames")
+	}
+}
+
+func TestZipEncoder(t *testing.T) {
+	frames := testFrames(2)
+
+	var buf bytes.Buffer
+	enc := timelapse.NewZipEncoder(&buf)
+	for _, frame := range frames {
+		if err := enc.Encode(frame); err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	if len(zr.File) != len(frames) {
+		t.Fatalf("got %d files, want %d", len(zr.File), len(frames))
+	}
+
+	for i, want := range []string{"frame-00000.png", "frame-00001.png"} {
+		if zr.File[i].Name != want {
+			t.Errorf("file %d: got name %q, want %q", i, zr.File[i].Name, want)
+		}
+
+		f, err := zr.File[i].Open()
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		img, err := png.Decode(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		assertImage(t, img, frames[i])
+	}
+}
+
+func TestDirEncoder(t *testing.T) {
+	frames := testFrames(2)
+	dir := filepath.Join(t.TempDir(), "frames")
+
+	enc, err := timelapse.NewDirEncoder(dir)
+	if err != nil {
+		t.Fatalf("NewDirEncoder: %v", err)
+	}
+	for _, frame := range frames {
+		if err := enc.Encode(frame); err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != len(frames) {
+		t.Fatalf("got %d files, want %d", len(entries), len(frames))
+	}
+
+	for i, want := range []string{"frame-00000.png", "frame-00001.png"} {
+		if entries[i].Name() != want {
+			t.Errorf("file %d: got name %q, want %q", i, entries[i].Name(), want)
+		}
+
+		f, err := os.Open(filepath.Join(dir, want))
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		img, err := png.Decode(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		assertImage(t, img, frames[i])
+	}
+}
+
+func testFrames(n int) []*image.Paletted {
+	palette := color.Palette{color.White, color.Black, color.RGBA{R: 0xff, A: 0xff}}
+
+	frames := make([]*image.Paletted, n)
+	for i := range frames {
+		frames[i] = image.NewPaletted(image.Rect(0, 0, 4, 3), palette)
+		frames[i].SetColorIndex(i, 1, uint8(i%len(palette)+1)) //nolint:gosec
+	}
+	return frames
+}
+
+func grayPalette(n int) color.Palette {
+	palette := make(color.Palette, n)
+	for i := range palette {
+		palette[i] = color.Gray{Y: uint8(i)} //nolint:gosec
+	}
+	return palette
+}
+
+// assertImage checks that got has the same size and colors as want.
+func assertImage(t *testing.T, got, want image.Image) {
+	t.Helper()
+
+	if got.Bounds() != want.Bounds() {
+		t.Fatalf("got image bounds %v, want %v", got.Bounds(), want.Bounds())
+	}
+
+	b := want.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			gr, gg, gb, ga := got.At(x, y).RGBA()
+			wr, wg, wb, wa := want.At(x, y).RGBA()
+			if gr != wr || gg != wg || gb != wb || ga != wa {
+				t.Fatalf("pixel (%d, %d): got color %v, want %v", x, y, got.At(x, y), want.At(x, y))
+			}
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package timelapse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"strconv"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+)
+
+const (
+	MaxFrames = 1000
+	MaxScale  = 16
+	// MaxFrameLength is the maximum width and height of a frame, in pixels.
+	MaxFrameLength = 4096
+	// MaxPixels is the maximum number of pixels across every frame of a
+	// timelapse, which lowers the number of frames allowed as frames get
+	// larger.
+	MaxPixels = 1 << 27
+
+	replayBatchSize = 10000
+)
+
+var (
+	ErrTooManyFrames = errors.New("timelapse would have too many frames")
+	ErrFrameTooLarge = errors.New("timelapse frames would be too large")
+)
+
+// ErrBeforeResize is returned when a timelapse would start before the latest
+// resize of its canvas, after which earlier placements can no longer be
+// replayed as they were made.
+var ErrBeforeResize = errors.New("timelapse starts before the canvas was last resized")
+
+// Range bounds the placements included in a timelapse. Both ends are inclusive,
+// and zero values leave that end unbounded.
+type Range struct {
+	FromSeq int64
+	ToSeq   int64
+	From    time.Time
+	To      time.Time
+}
+
+func (r Range) before(p canvas.Placement) bool {
+	return p.Seq < r.FromSeq || (!r.From.IsZero() && p.PlacedAt.Before(r.From))
+}
+
+func (r Range) after(p canvas.Placement) bool {
+	return (r.ToSeq > 0 && p.Seq > r.ToSeq) || (!r.To.IsZero() && p.PlacedAt.After(r.To))
+}
+
+// Interval is how far apart consecutive frames of a timelapse are, either as a
+// number of placements or as a duration of time on the canvas.
+type Interval struct {
+	Placements int
+	Duration   time.Duration
+}
+
+// ParseInterval parses a number of placements, such as "100", or a duration,
+// such as "5m".
+func ParseInterval(s string) (Interval, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n <= 0 {
+			return Interval{}, errors.New("interval must be positive")
+		}
+		return Interval{Placements: n}, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return Interval{}, fmt.Errorf("interval must be a number of placements or a duration: %w", err)
+	}
+	if d <= 0 {
+		return Interval{}, errors.New("interval must be positive")
+	}
+	return Interval{Duration: d}, nil
+}
+
+func (i Interval) String() string {
+	if i.Duration > 0 {
+		return i.Duration.String()
+	}
+	return strconv.Itoa(i.Placements)
+}
+
+type Options struct {
+	Range    Range
+	Interval Interval
+	Scale    int
+}
+
+func (o Options) Validate() error {
+	switch {
+	case o.Interval.Placements <= 0 && o.Interval.Duration <= 0:
+		return errors.New("interval must be positive")
+	case o.Scale < 1 || o.Scale > MaxScale:
+		return fmt.Errorf("scale must be between 1 and %d", MaxScale)
+	case o.Range.ToSeq > 0 && o.Range.ToSeq < o.Range.FromSeq:
+		return errors.New("range ends before it starts")
+	case !o.Range.From.IsZero() && !o.Range.To.IsZero() && o.Range.To.Before(o.Range.From):
+		return errors.New("range ends before it starts")
+	}
+	return nil
+}
+
+// Encoder writes the frames of a timelapse in order.
+type Encoder interface {
+	Encode(frame *image.Paletted) error
+	Close() error
+}
+
+// Render replays the placements of a canvas and encodes a frame at the start of
+// the range, after every interval within it, and at its end. It returns the
+// number of frames encoded, without closing the encoder.
+//
+// The placements of a resized canvas are replayed from the snapshot of its
+// latest resize, since those before it have had their coordinates shifted, so
+// its timelapses start no earlier than the resize. Ranges starting at a
+// sequence number before it fail with ErrBeforeResize.
+//
+// Frames are limited to MaxFrameLength pixels across, failing with
+// ErrFrameTooLarge, and to MaxPixels between them, failing with
+// ErrTooManyFrames.
+func Render(ctx context.Context, repo canvas.Repository, canvasID idgen.ID[idgen.Canvas], opts Options, enc Encoder) (int, error) {
+	return render(ctx, repo, canvasID, opts, enc)
+}
+
+// Count replays the placements of a canvas like Render, returning the number
+// of frames it would encode or the error it would fail with, without rendering
+// any frames.
+func Count(ctx context.Context, repo canvas.Repository, canvasID idgen.ID[idgen.Canvas], opts Options) (int, error) {
+	return render(ctx, repo, canvasID, opts, nil)
+}
+
+func render(ctx context.Context, repo canvas.Repository, canvasID idgen.ID[idgen.Canvas], opts Options, enc Encoder) (int, error) {
+	if err := opts.Validate(); err != nil {
+		return 0, err
+	}
+
+	src, err := repo.GetCanvas(ctx, canvasID)
+	if err != nil {
+		return 0, fmt.Errorf("get canvas: %w", err)
+	}
+
+	resizedSeq := src.ResizedSeq()
+	if opts.Range.FromSeq > 0 && opts.Range.FromSeq < resizedSeq {
+		return 0, fmt.Errorf("%w at seq %d", ErrBeforeResize, resizedSeq)
+	}
+
+	width, height := src.Width()*opts.Scale, src.Height()*opts.Scale
+	if width > MaxFrameLength || height > MaxFrameLength {
+		return 0, fmt.Errorf("%w, %dx%d pixels is more than %d pixels across, use a smaller scale", ErrFrameTooLarge, width, height, MaxFrameLength)
+	}
+
+	cnv, err := canvas.New(src.ID(), src.Width(), src.Height(), src.Palette())
+	if err != nil {
+		return 0, fmt.Errorf("init canvas: %w", err)
+	}
+
+	if resizedSeq > 0 {
+		snapshot, err := repo.GetSnapshot(ctx, canvasID, resizedSeq)
+		if err != nil {
+			return 0, fmt.Errorf("get resize snapshot: %w", err)
+		}
+		if _, err := cnv.Restore(snapshot); err != nil {
+			return 0, fmt.Errorf("restore resize snapshot: %w", err)
+		}
+	}
+
+	r := &renderer{
+		cnv:       cnv,
+		opts:      opts,
+		enc:       enc,
+		maxFrames: min(MaxFrames, MaxPixels/(width*height)),
+	}
+
+	afterSeq := resizedSeq
+	for {
+		placements, err := repo.ListPlacements(ctx, canvasID, afterSeq, replayBatchSize)
+		if err != nil {
+			return r.frames, fmt.Errorf("list placements: %w", err)
+		}
+
+		for _, p := range placements {
+			if opts.Range.after(p) {
+				return r.frames, r.finish()
+			}
+			if err := r.place(p); err != nil {
+				return r.frames, err
+			}
+			afterSeq = p.Seq
+		}
+
+		if len(placements) < replayBatchSize {
+			return r.frames, r.finish()
+		}
+	}
+}
+
+type renderer struct {
+	cnv       *canvas.Canvas
+	opts      Options
+	enc       Encoder
+	maxFrames int
+
+	started     bool
+	frames      int
+	sinceFrame  int
+	nextFrameAt time.Time
+}
+
+func (r *renderer) place(p canvas.Placement) error {
+	if r.opts.Range.before(p) {
+		return r.apply(p)
+	}
+
+	if !r.started {
+		r.started = true
+		r.nextFrameAt = r.opts.Range.From
+		if r.nextFrameAt.IsZero() {
+			r.nextFrameAt = p.PlacedAt
+		}
+		if err := r.frame(); err != nil {
+			return err
+		}
+		r.nextFrameAt = r.nextFrameAt.Add(r.opts.Interval.Duration)
+	}
+
+	if r.opts.Interval.Duration > 0 {
+		for !p.PlacedAt.Before(r.nextFrameAt) {
+			if err := r.frame(); err != nil {
+				return err
+			}
+			r.nextFrameAt = r.nextFrameAt.Add(r.opts.Interval.Duration)
+		}
+	}
+
+	if err := r.apply(p); err != nil {
+		return err
+	}
+
+	if r.opts.Interval.Placements > 0 && r.sinceFrame == r.opts.Interval.Placements {
+		return r.frame()
+	}
+	return nil
+}
+
+func (r *renderer) apply(p canvas.Placement) error {
+	if _, err := r.cnv.Apply(p); err != nil {
+		return fmt.Errorf("apply placement %d: %w", p.Seq, err)
+	}
+	r.sinceFrame++
+	return nil
+}
+
+// finish encodes the end of the range, unless it is identical to the last
+// frame encoded.
+func (r *renderer) finish() error {
+	if r.frames > 0 && r.sinceFrame == 0 {
+		return nil
+	}
+	return r.frame()
+}
+
+// frame encodes the current state of the canvas, unless the renderer is only
+// counting frames.
+func (r *renderer) frame() error {
+	if r.frames == r.maxFrames {
+		return fmt.Errorf("%w, at most %d frames of %dx%d pixels are allowed, use a longer interval or a smaller scale",
+			ErrTooManyFrames, r.maxFrames, r.cnv.Width()*r.opts.Scale, r.cnv.Height()*r.opts.Scale)
+	}
+
+	if r.enc != nil {
+		img := canvas.Image(r.cnv.Pixels(), r.cnv.Width(), r.cnv.Height(), r.cnv.Palette(), r.opts.Scale)
+		if err := r.enc.Encode(img); err != nil {
+			return fmt.Errorf("encode frame %d: %w", r.frames, err)
+		}
+	}
+
+	r.frames++
+	r.sinceFrame = 0
+	return nil
+}
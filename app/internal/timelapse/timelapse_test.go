@@ -0,0 +1,261 @@
+package timelapse_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/jace-ys/pikcel/internal/canvas"
+	"github.com/jace-ys/pikcel/internal/idgen"
+	"github.com/jace-ys/pikcel/internal/storage/memory"
+	"github.com/jace-ys/pikcel/internal/timelapse"
+)
+
+func TestRender(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Each placement is made a minute after the one before it.
+	points := []image.Point{{0, 0}, {1, 0}, {2, 1}, {0, 0}, {3, 2}}
+
+	tests := []struct {
+		name string
+		opts timelapse.Options
+		// wantFrames are the number of placements applied in each frame.
+		wantFrames []int
+	}{
+		{
+			name:       "PlacementInterval",
+			opts:       timelapse.Options{Interval: timelapse.Interval{Placements: 2}, Scale: 1},
+			wantFrames: []int{0, 2, 4, 5},
+		},
+		{
+			name:       "PlacementIntervalEndingOnFrame",
+			opts:       timelapse.Options{Interval: timelapse.Interval{Placements: 5}, Scale: 2},
+			wantFrames: []int{0, 5},
+		},
+		{
+			name:       "DurationInterval",
+			opts:       timelapse.Options{Interval: timelapse.Interval{Duration: 150 * time.Second}, Scale: 1},
+			wantFrames: []int{0, 3, 5},
+		},
+		{
+			name: "SeqRange",
+			opts: timelapse.Options{
+				Range:    timelapse.Range{FromSeq: 2, ToSeq: 4},
+				Interval: timelapse.Interval{Placements: 1},
+				Scale:    1,
+			},
+			wantFrames: []int{1, 2, 3, 4},
+		},
+		{
+			name: "TimeRange",
+			opts: timelapse.Options{
+				Range:    timelapse.Range{From: start.Add(3 * time.Minute), To: start.Add(4 * time.Minute)},
+				Interval: timelapse.Interval{Placements: 10},
+				Scale:    3,
+			},
+			wantFrames: []int{2, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewStore()
+			cnv := createCanvas(t, repo, 4, 3)
+
+			// states holds the pixels of the canvas after each number of
+			// placements.
+			states := [][]byte{cnv.Pixels()}
+			for i, pt := range points {
+				p := canvas.Placement{
+					CanvasID: cnv.ID(),
+					X:        pt.X,
+					Y:        pt.Y,
+					Color:    uint8(i + 1), //nolint:gosec
+					UserID:   idgen.New[idgen.User](),
+					PlacedAt: start.Add(time.Duration(i+1) * time.Minute),
+				}
+				p.Seq = insertPlacement(t, repo, p)
+				if _, err := cnv.Apply(p); err != nil {
+					t.Fatalf("Apply: %v", err)
+				}
+				states = append(states, cnv.Pixels())
+			}
+
+			count, err := timelapse.Count(t.Context(), repo, cnv.ID(), tt.opts)
+			if err != nil {
+				t.Fatalf("Count: %v", err)
+			}
+
+			var buf bytes.Buffer
+			enc := timelapse.NewGIFEncoder(&buf, time.Second)
+			frames, err := timelapse.Render(t.Context(), repo, cnv.ID(), tt.opts, enc)
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			if err := enc.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			if frames != len(tt.wantFrames) || count != frames {
+				t.Fatalf("Render: got %d frames and counted %d, want %d", frames, count, len(tt.wantFrames))
+			}
+
+			decoded, err := gif.DecodeAll(&buf)
+			if err != nil {
+				t.Fatalf("DecodeAll: %v", err)
+			}
+			if len(decoded.Image) != len(tt.wantFrames) {
+				t.Fatalf("DecodeAll: got %d frames, want %d", len(decoded.Image), len(tt.wantFrames))
+			}
+			for i, applied := range tt.wantFrames {
+				want := canvas.Image(states[applied], cnv.Width(), cnv.Height(), cnv.Palette(), tt.opts.Scale)
+				assertImage(t, decoded.Image[i], want)
+			}
+		})
+	}
+}
+
+func TestRenderLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		placements int
+		opts       timelapse.Options
+		wantErr    error
+	}{
+		{
+			name:       "FrameTooLarge",
+			size:       300,
+			placements: 1,
+			opts:       timelapse.Options{Interval: timelapse.Interval{Placements: 1}, Scale: 16},
+			wantErr:    timelapse.ErrFrameTooLarge,
+		},
+		{
+			// Frames of 4096x4096 pixels are limited to 8 by MaxPixels.
+			name:       "TooManyLargeFrames",
+			size:       256,
+			placements: 8,
+			opts:       timelapse.Options{Interval: timelapse.Interval{Placements: 1}, Scale: 16},
+			wantErr:    timelapse.ErrTooManyFrames,
+		},
+		{
+			name:       "LargeFrames",
+			size:       256,
+			placements: 7,
+			opts:       timelapse.Options{Interval: timelapse.Interval{Placements: 1}, Scale: 16},
+		},
+		{
+			name:       "TooManyFrames",
+			size:       4,
+			placements: timelapse.MaxFrames,
+			opts:       timelapse.Options{Interval: timelapse.Interval{Placements: 1}, Scale: 1},
+			wantErr:    timelapse.ErrTooManyFrames,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewStore()
+			cnv := createCanvas(t, repo, tt.size, tt.size)
+			for i := range tt.placements {
+				insertPlacement(t, repo, canvas.Placement{
+					CanvasID: cnv.ID(),
+					X:        i % tt.size,
+					Y:        i / tt.size % tt.size,
+					Color:    1,
+					UserID:   idgen.New[idgen.User](),
+					PlacedAt: time.Now(),
+				})
+			}
+
+			_, err := timelapse.Count(t.Context(), repo, cnv.ID(), tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Count: got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRenderResized(t *testing.T) {
+	repo := memory.NewStore()
+	cnv := createCanvas(t, repo, 2, 2)
+
+	before := canvas.Placement{CanvasID: cnv.ID(), X: 0, Y: 0, Color: 1, UserID: idgen.New[idgen.User](), PlacedAt: time.Now()}
+	before.Seq = insertPlacement(t, repo, before)
+	if _, err := cnv.Apply(before); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	r := canvas.Resize{Left: 1, Fill: 2}
+	info, err := repo.ResizeCanvas(t.Context(), cnv.ID(), r)
+	if err != nil {
+		t.Fatalf("ResizeCanvas: %v", err)
+	}
+	resized, err := cnv.Resize(r, info.ResizedSeq)
+	if err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+	first := resized.Pixels()
+
+	after := canvas.Placement{CanvasID: cnv.ID(), X: 0, Y: 1, Color: 3, UserID: idgen.New[idgen.User](), PlacedAt: time.Now()}
+	after.Seq = insertPlacement(t, repo, after)
+	if _, err := resized.Apply(after); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	opts := timelapse.Options{Interval: timelapse.Interval{Placements: 1}, Scale: 1}
+
+	var buf bytes.Buffer
+	enc := timelapse.NewGIFEncoder(&buf, time.Second)
+	if _, err := timelapse.Render(t.Context(), repo, cnv.ID(), opts, enc); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	decoded, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("DecodeAll: %v", err)
+	}
+	if len(decoded.Image) != 2 {
+		t.Fatalf("DecodeAll: got %d frames, want 2", len(decoded.Image))
+	}
+
+	// The timelapse starts from the canvas as it was resized, with the
+	// placement before the resize shifted along with its pixels.
+	assertImage(t, decoded.Image[0], canvas.Image(first, resized.Width(), resized.Height(), resized.Palette(), 1))
+	assertImage(t, decoded.Image[1], canvas.Image(resized.Pixels(), resized.Width(), resized.Height(), resized.Palette(), 1))
+
+	opts.Range.FromSeq = before.Seq
+	if _, err := timelapse.Count(t.Context(), repo, cnv.ID(), opts); !errors.Is(err, timelapse.ErrBeforeResize) {
+		t.Errorf("Count: got error %v, want %v", err, timelapse.ErrBeforeResize)
+	}
+}
+
+func createCanvas(t *testing.T, repo canvas.Repository, width, height int) *canvas.Canvas {
+	t.Helper()
+
+	cnv, err := canvas.New(idgen.New[idgen.Canvas](), width, height, canvas.DefaultPalette)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := repo.CreateCanvas(t.Context(), cnv); err != nil {
+		t.Fatalf("CreateCanvas: %v", err)
+	}
+	return cnv
+}
+
+func insertPlacement(t *testing.T, repo canvas.Repository, p canvas.Placement) int64 {
+	t.Helper()
+
+	seq, err := repo.InsertPlacement(t.Context(), p, 0)
+	if err != nil {
+		t.Fatalf("InsertPlacement: %v", err)
+	}
+	return seq
+}
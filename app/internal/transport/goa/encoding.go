@@ -0,0 +1,32 @@
+package goa
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+func responseEncoder(ctx context.Context, w http.ResponseWriter) goahttp.Encoder {
+	if ct, ok := ctx.Value(goahttp.ContentTypeKey).(string); ok {
+		if mt, _, err := mime.ParseMediaType(ct); err == nil && isBinaryMediaType(mt) {
+			goahttp.SetContentType(w, mt)
+			return goahttp.EncodingFunc(func(v any) error {
+				b, ok := v.([]byte)
+				if !ok {
+					return fmt.Errorf("cannot encode %T as %s", v, mt)
+				}
+				_, err := w.Write(b)
+				return err //nolint:wrapcheck
+			})
+		}
+	}
+	return goahttp.ResponseEncoder(ctx, w)
+}
+
+func isBinaryMediaType(mt string) bool {
+	return mt == "application/octet-stream" || strings.HasPrefix(mt, "image/")
+}
@@ -10,7 +10,7 @@ type GRPCAdapter[E endpoint.GoaEndpoints, S any] struct {
 	newFn GRPCNewFunc[E, S]
 }
 
-type GRPCNewFunc[E endpoint.GoaEndpoints, S any] func(e E, uh goagrpc.UnaryHandler) *S
+type GRPCNewFunc[E endpoint.GoaEndpoints, S any] func(e E, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *S
 
 func GRPC[E endpoint.GoaEndpoints, S any](newFn GRPCNewFunc[E, S]) *GRPCAdapter[E, S] {
 	return &GRPCAdapter[E, S]{
@@ -19,5 +19,5 @@ func GRPC[E endpoint.GoaEndpoints, S any](newFn GRPCNewFunc[E, S]) *GRPCAdapter[
 }
 
 func (a *GRPCAdapter[E, S]) Adapt(ep E) *S {
-	return a.newFn(ep, nil)
+	return a.newFn(ep, nil, nil)
 }
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io/fs"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/gorilla/websocket"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -24,33 +27,40 @@ type HTTPServer interface {
 	Use(m func(http.Handler) http.Handler)
 }
 
-type HTTPAdapter[E endpoint.GoaEndpoints, S HTTPServer] struct {
-	newFn   HTTPNewFunc[E, S]
-	mountFn HTTPMountFunc[S]
+type HTTPAdapter[E endpoint.GoaEndpoints, S HTTPServer, C any] struct {
+	newFn          HTTPNewFunc[E, S, C]
+	mountFn        HTTPMountFunc[S]
+	allowedOrigins []string
 }
 
-type HTTPNewFunc[E endpoint.GoaEndpoints, S HTTPServer] func(
+type HTTPNewFunc[E endpoint.GoaEndpoints, S HTTPServer, C any] func(
 	e E,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
 	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
 	errhandler func(context.Context, http.ResponseWriter, error),
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	upgrader goahttp.Upgrader,
+	configurer *C,
 	httpFS http.FileSystem,
 ) S
 
 type HTTPMountFunc[S HTTPServer] func(mux goahttp.Muxer, srv S)
 
-func HTTP[E endpoint.GoaEndpoints, S HTTPServer](newFn HTTPNewFunc[E, S], mountFn HTTPMountFunc[S]) *HTTPAdapter[E, S] {
-	return &HTTPAdapter[E, S]{
-		newFn:   newFn,
-		mountFn: mountFn,
+// HTTP returns an adapter serving endpoints over HTTP. Websocket connections
+// are accepted from browsers on the same origin as the server, or on any of
+// allowedOrigins, where "*" allows every origin.
+func HTTP[E endpoint.GoaEndpoints, S HTTPServer, C any](newFn HTTPNewFunc[E, S, C], mountFn HTTPMountFunc[S], allowedOrigins []string) *HTTPAdapter[E, S, C] {
+	return &HTTPAdapter[E, S, C]{
+		newFn:          newFn,
+		mountFn:        mountFn,
+		allowedOrigins: allowedOrigins,
 	}
 }
 
-func (a *HTTPAdapter[E, S]) Adapt(ep E, fsys fs.FS) goahttp.ResolverMuxer {
+func (a *HTTPAdapter[E, S, C]) Adapt(ep E, fsys fs.FS) goahttp.ResolverMuxer {
 	dec := goahttp.RequestDecoder
-	enc := goahttp.ResponseEncoder
+	enc := responseEncoder
 	formatter := goahttp.NewErrorResponse
 
 	eh := func(ctx context.Context, w http.ResponseWriter, err error) {
@@ -71,8 +81,34 @@ func (a *HTTPAdapter[E, S]) Adapt(ep E, fsys fs.FS) goahttp.ResolverMuxer {
 	}
 
 	mux := goahttp.NewMuxer()
-	srv := a.newFn(ep, mux, dec, enc, eh, formatter, http.FS(fsys))
+	// A nil formatter lets custom error types be encoded using their generated response bodies.
+	upgrader := &websocket.Upgrader{CheckOrigin: a.checkOrigin}
+	srv := a.newFn(ep, mux, dec, enc, eh, nil, upgrader, nil, http.FS(fsys))
 	a.mountFn(mux, srv)
 
 	return mux
 }
+
+func (a *HTTPAdapter[E, S, C]) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		// Only browsers send an Origin header, and other clients can't be
+		// used for cross-site request forgery.
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	for _, allowed := range a.allowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
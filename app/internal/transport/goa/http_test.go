@@ -0,0 +1,103 @@
+package goa
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	httpapi "github.com/jace-ys/pikcel/api/v1/gen/http/api/server"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	tests := []struct {
+		name           string
+		allowedOrigins []string
+		// origin is the Origin header sent, where "self" is replaced by the
+		// origin of the server.
+		origin    string
+		wantAllow bool
+	}{
+		{
+			name:      "NoOrigin",
+			wantAllow: true,
+		},
+		{
+			name:      "SameOrigin",
+			origin:    "self",
+			wantAllow: true,
+		},
+		{
+			name:      "OtherOrigin",
+			origin:    "https://example.com",
+			wantAllow: false,
+		},
+		{
+			name:           "AllowedOrigin",
+			allowedOrigins: []string{"https://pikcel.dev", "https://example.com/"},
+			origin:         "https://Example.com",
+			wantAllow:      true,
+		},
+		{
+			name:           "AllowedOriginOtherScheme",
+			allowedOrigins: []string{"https://example.com"},
+			origin:         "http://example.com",
+			wantAllow:      false,
+		},
+		{
+			name:           "AllowedAll",
+			allowedOrigins: []string{"*"},
+			origin:         "https://example.com",
+			wantAllow:      true,
+		},
+		{
+			name:      "MalformedOrigin",
+			origin:    "://example.com",
+			wantAllow: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := HTTP(httpapi.New, httpapi.Mount, tt.allowedOrigins)
+			upgrader := &websocket.Upgrader{CheckOrigin: a.checkOrigin}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				conn, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+				conn.Close()
+			}))
+			t.Cleanup(srv.Close)
+
+			header := http.Header{}
+			switch tt.origin {
+			case "":
+			case "self":
+				header.Set("Origin", srv.URL)
+			default:
+				header.Set("Origin", tt.origin)
+			}
+
+			conn, res, err := websocket.DefaultDialer.DialContext(t.Context(), "ws"+strings.TrimPrefix(srv.URL, "http"), header)
+			if conn != nil {
+				conn.Close()
+			}
+			if res == nil {
+				t.Fatalf("Dial: %v", err)
+			}
+			defer res.Body.Close()
+
+			want := http.StatusForbidden
+			if tt.wantAllow {
+				want = http.StatusSwitchingProtocols
+			}
+			if res.StatusCode != want {
+				t.Errorf("Dial: got status %d, want %d", res.StatusCode, want)
+			}
+		})
+	}
+}
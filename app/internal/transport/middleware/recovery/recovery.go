@@ -1,11 +1,14 @@
 package recovery
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"net"
 	"net/http"
 	"strings"
 
+	"github.com/felixge/httpsnoop"
 	"goa.design/clue/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -28,9 +31,36 @@ func UnaryServerInterceptor(logCtx context.Context) grpc.UnaryServerInterceptor
 	}
 }
 
+func StreamServerInterceptor(logCtx context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, next grpc.StreamHandler) (err error) {
+		defer func() {
+			if rvr := recover(); rvr != nil {
+				ctx := log.WithContext(stream.Context(), logCtx)
+				instrument.EmitRecoveredPanicTelemetry(ctx, rvr, strings.TrimPrefix(info.FullMethod, "/"))
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		return next(srv, stream)
+	}
+}
+
 func HTTP(logCtx context.Context) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Upgraded connections are hijacked from the server, after which no
+			// response can be written to them.
+			var hijacked bool
+			w = httpsnoop.Wrap(w, httpsnoop.Hooks{
+				Hijack: func(hijack httpsnoop.HijackFunc) httpsnoop.HijackFunc {
+					return func() (net.Conn, *bufio.ReadWriter, error) {
+						conn, rw, err := hijack()
+						hijacked = hijacked || err == nil
+						return conn, rw, err
+					}
+				},
+			})
+
 			defer func() {
 				if rvr := recover(); rvr != nil {
 					if err, ok := rvr.(error); ok && errors.Is(err, http.ErrAbortHandler) {
@@ -41,7 +71,7 @@ func HTTP(logCtx context.Context) func(http.Handler) http.Handler {
 					source := r.Method + " " + r.URL.Path
 					instrument.EmitRecoveredPanicTelemetry(ctx, rvr, source)
 
-					if r.Header.Get("Connection") != "Upgrade" {
+					if !hijacked {
 						w.WriteHeader(http.StatusInternalServerError)
 					}
 				}
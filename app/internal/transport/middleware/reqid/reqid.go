@@ -7,6 +7,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"goa.design/clue/log"
+	"goa.design/goa/v3/grpc/middleware"
 	"google.golang.org/grpc"
 
 	"github.com/jace-ys/pikcel/internal/idgen"
@@ -19,6 +20,13 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		ctx := newRequestID(stream.Context())
+		return next(srv, middleware.NewWrappedServerStream(ctx, stream))
+	}
+}
+
 func HTTP() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {